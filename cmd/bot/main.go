@@ -11,13 +11,17 @@ import (
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot/handlers"
 	exchangeclient "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/clients/exchange"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/clients/telegram"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/grpc"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/http"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/metrics"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/repository"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/apitoken"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/cache"
 	exchangeservice "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/exchange"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/kafka"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/ratelimit"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/reportstatus"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/usercontext"
 )
 
@@ -42,20 +46,38 @@ func main() {
 			Fatal("failed to create tracer")
 	}
 
-	tgClient, err := telegram.NewClient(config.Telegram, logger)
+	tgClient, err := telegram.NewClient(config.Telegram, metrics.NewTelegramOutboxMetrics(), logger)
 	if err != nil {
 		logger.WithError(err).
 			Fatal("failed to connect to telegram")
 	}
 
+	defer func() {
+		if err := tgClient.Close(); err != nil {
+			logger.WithError(err).
+				Warn("failed to close telegram client")
+		}
+	}()
+
+	formatter, err := format.New(tgClient.ParseMode())
+	if err != nil {
+		logger.WithError(err).
+			Fatal("failed to create formatter of messages")
+	}
+
 	tgClientDecorator := metrics.NewTelegramClientTracerDecorator(
 		metrics.NewTelegramClientLatencyDecorator(tgClient), tracerProvider,
 	)
 
-	exchangeClient, err := exchangeclient.NewClient(config.ExchangeClient)
+	exchangeClients, err := exchangeclient.NewProviders(config.ExchangeClient)
 	if err != nil {
 		logger.WithError(err).
-			Fatal("failed to create exchange client")
+			Fatal("failed to create exchange providers")
+	}
+
+	exchangeProviders := make([]exchangeservice.Provider, 0, len(exchangeClients))
+	for _, client := range exchangeClients {
+		exchangeProviders = append(exchangeProviders, client)
 	}
 
 	dbClient, err := startup.DatabaseConnect(config.Database)
@@ -82,6 +104,11 @@ func main() {
 		}
 	}()
 
+	if err := startup.CreateKafkaTopic(config.Kafka); err != nil {
+		logger.WithError(err).
+			Fatal("failed to create kafka topic")
+	}
+
 	kafkaClient := startup.NewKafkaProducer(config.Kafka)
 	defer func() {
 		if err := kafkaClient.Close(); err != nil {
@@ -106,12 +133,35 @@ func main() {
 			),
 		), tracerProvider,
 	)
+	debtRepo := metrics.NewDebtRepositoryTracerDecorator(
+		metrics.NewDebtRepositoryAmountErrorsDecorator(
+			metrics.NewDebtRepositoryLatencyDecorator(
+				repository.NewDebtRepository(dbClient),
+			),
+		), tracerProvider,
+	)
+	goalRepo := metrics.NewGoalRepositoryTracerDecorator(
+		metrics.NewGoalRepositoryAmountErrorsDecorator(
+			metrics.NewGoalRepositoryLatencyDecorator(
+				repository.NewGoalRepository(dbClient),
+			),
+		), tracerProvider,
+	)
+
+	exchangeRateRepo := metrics.NewExchangeRateRepositoryTracerDecorator(
+		metrics.NewExchangeRateRepositoryAmountErrorsDecorator(
+			metrics.NewExchangeRateRepositoryLatencyDecorator(
+				repository.NewExchangeRateRepository(dbClient),
+			),
+		), tracerProvider,
+	)
 
-	exchangeService, err := exchangeservice.NewService(config.Currency, exchangeClient, logger)
+	exchangeService, err := exchangeservice.NewService(config.Currency, exchangeProviders, userRepo, exchangeRateRepo, logger)
 	if err != nil {
 		logger.WithError(err).
 			Fatal("failed to create exchange repository")
 	}
+	metrics.NewExchangeRatesAgeGauge(exchangeService)
 
 	userContextService := metrics.NewUserContextServiceTracerDecorator(
 		metrics.NewUserContextServiceAmountErrorsDecorator(
@@ -129,33 +179,63 @@ func main() {
 		), tracerProvider,
 	)
 
+	reportStatusService := reportstatus.NewService(redisClient, config.ReportStatus)
+	tokenService := apitoken.NewService(userRepo)
+	rateLimiter := ratelimit.NewService(redisClient, config.RateLimit)
+
 	handlers := handlers.NewMessageHandlers(
+		formatter,
 		userRepo,
 		wasteRepo,
+		debtRepo,
+		goalRepo,
 		exchangeService,
 		userContextService,
 		kafkaProducer,
+		reportStatusService,
+		tokenService,
+		tgClientDecorator,
 	)
 
-	commands := []string{"add", "setLimit", "getLimit", "week", "month", "year", "currency"}
-
-	iterationMessage := metrics.NewIterationMessageTracerDecorator(bot.NewIterationMessage(tgClientDecorator), tracerProvider)
-	botComponent := bot.New(tgClientDecorator, iterationMessage, logger, handlers.GetHandlers())
+	commands := []string{"add", "setLimit", "getLimit", "week", "month", "year", "currency", "language", "status", "token", "rates", "convert", "find", "claims", "split", "settle", "goal"}
+
+	iterationMessage := metrics.NewIterationMessageTracerDecorator(bot.NewIterationMessage(tgClientDecorator, formatter.ParseMode()), tracerProvider)
+	botComponent := bot.New(
+		config.Bot,
+		tgClientDecorator,
+		iterationMessage,
+		metrics.NewBotWorkerPoolMetrics(),
+		formatter,
+		logger,
+		handlers.GetHandlers(),
+	)
 	botComponent.UseMiddleware(bot.CheckUserMiddleware(userRepo))
 	botComponent.UseMiddleware(bot.CacheMiddleware(cacheService, logger))
+	botComponent.UseMiddleware(bot.RateLimitMiddleware(rateLimiter, formatter, logger))
+	botComponent.UseMiddleware(bot.LanguageMiddleware(userRepo, logger))
 	botComponent.UseMiddleware(bot.LoggerMiddleware(logger))
 	botComponent.UseMiddleware(metrics.LatencyMetricMiddleware(commands))
 	botComponent.UseMiddleware(metrics.AmountMetricMiddleware(commands))
 	botComponent.UseMiddleware(metrics.TracingMiddleware(tracerProvider, commands))
 
+	wastesService := grpc.NewWastesService(wasteRepo, userRepo, cacheService)
+
 	httpRouter := http.NewHttpRouter(config.Http, logger)
-	grpcServer := grpc.NewServer(config.Grpc, tgClientDecorator, cacheService, logger)
+	httpRouter.Handle(http.WastesAPIPrefix, http.NewWastesHandler(wastesService, tokenService, logger))
+	if config.Telegram.Mode == telegram.ModeWebhook {
+		httpRouter.Handle(tgClient.WebhookPath(), tgClient.WebhookHandler())
+	}
+	grpcServer := grpc.NewServer(config.Grpc, tgClientDecorator, cacheService, wastesService, tokenService, logger)
+	reportWatcher := reportstatus.NewWatcher(config.ReportWatcher, reportStatusService, tgClientDecorator, formatter, logger)
 
+	// the telegram client stops receiving before the bot drains the received messages
 	err = app.New(config.App, logger,
 		exchangeService,
 		botComponent,
+		tgClient,
 		httpRouter,
 		grpcServer,
+		reportWatcher,
 	).Run(context.Background())
 	if err != nil {
 		logger.WithError(err).Fatal("failed during running app")
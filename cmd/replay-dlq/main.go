@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/app/startup"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+// replay-dlq moves the messages from the dead letter topic of the report service
+// back to the topic of report requests.
+func main() {
+	configFile := flag.String("config", "", "path to configuration file of the report service")
+	limit := flag.Int("limit", 0, "maximum amount of replayed messages, 0 means all messages")
+	idleTimeout := flag.Duration("idle-timeout", 10*time.Second, "stop if there are no new messages during this time")
+	dryRun := flag.Bool("dry-run", false, "only print the messages without replaying and committing")
+	flag.Parse()
+
+	config, err := startup.NewReportServiceConfig(*configFile)
+	if err != nil {
+		log.Fatalf("failed to init config: %v", err)
+	}
+
+	if config.DeadLetter.GroupID == "" {
+		log.Fatalf("group id of the dead letter topic is not set")
+	}
+
+	reader := startup.NewKafkaConsumer(config.DeadLetter)
+	defer func() {
+		if err := reader.Close(); err != nil {
+			log.Printf("failed to close kafka reader: %v", err)
+		}
+	}()
+
+	writer := startup.NewKafkaProducer(config.Kafka)
+	defer func() {
+		if err := writer.Close(); err != nil {
+			log.Printf("failed to close kafka writer: %v", err)
+		}
+	}()
+
+	replayed := 0
+	for *limit == 0 || replayed < *limit {
+		ctx, cancel := context.WithTimeout(context.Background(), *idleTimeout)
+		msg, err := reader.FetchMessage(ctx)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("failed to fetch message from the dead letter topic: %v", err)
+		}
+
+		headers := make(map[string]string, len(msg.Headers))
+		for _, header := range msg.Headers {
+			headers[header.Key] = string(header.Value)
+		}
+
+		log.Printf("offset %d: reason %q, error %q, attempts %s, value %s",
+			msg.Offset, headers[models.HeaderFailureReason], headers[models.HeaderFailureError],
+			headers[models.HeaderAttempts], msg.Value)
+
+		if *dryRun {
+			replayed++
+			continue
+		}
+
+		err = writer.WriteMessages(context.Background(), kafka.Message{
+			Key:   msg.Key,
+			Value: msg.Value,
+		})
+		if err != nil {
+			log.Fatalf("failed to replay the message with offset %d: %v", msg.Offset, err)
+		}
+
+		err = reader.CommitMessages(context.Background(), msg)
+		if err != nil {
+			log.Fatalf("failed to commit the message with offset %d: %v", msg.Offset, err)
+		}
+
+		replayed++
+	}
+
+	log.Printf("%d messages have been replayed", replayed)
+}
@@ -8,10 +8,14 @@ import (
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/app"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/app/startup"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/clients/grpc"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/http"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/metrics"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/repository"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/goalnudge"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/kafka"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/reportstatus"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/usercontext"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/wastereport"
 )
 
@@ -48,6 +52,28 @@ func main() {
 		}
 	}()
 
+	redisClient, err := startup.RedisConnect(config.Redis)
+	if err != nil {
+		logger.WithError(err).
+			Fatal("failed to connect to redis")
+	}
+	defer func() {
+		if err := redisClient.Close(); err != nil {
+			logger.WithError(err).
+				Warn("failed to close redis")
+		}
+	}()
+
+	if err := startup.CreateKafkaTopic(config.Kafka); err != nil {
+		logger.WithError(err).
+			Fatal("failed to create kafka topic")
+	}
+
+	if err := startup.CreateKafkaTopic(config.DeadLetter); err != nil {
+		logger.WithError(err).
+			Fatal("failed to create kafka dead letter topic")
+	}
+
 	kafkaClient := startup.NewKafkaConsumer(config.Kafka)
 	defer func() {
 		if err := kafkaClient.Close(); err != nil {
@@ -56,6 +82,16 @@ func main() {
 		}
 	}()
 
+	deadLetterClient := startup.NewKafkaProducer(config.DeadLetter)
+	defer func() {
+		if err := deadLetterClient.Close(); err != nil {
+			logger.WithError(err).
+				Warn("failed to close kafka dead letter producer")
+		}
+	}()
+
+	deadLetterProducer := kafka.NewProducer(deadLetterClient)
+
 	wasteRepo := metrics.NewWasteRepositoryTracerDecorator(
 		metrics.NewWasteRepositoryAmountErrorsDecorator(
 			metrics.NewWasteRepositoryLatencyDecorator(
@@ -63,19 +99,71 @@ func main() {
 			),
 		), tracerProvider,
 	)
+	exchangeRateRepo := metrics.NewExchangeRateRepositoryTracerDecorator(
+		metrics.NewExchangeRateRepositoryAmountErrorsDecorator(
+			metrics.NewExchangeRateRepositoryLatencyDecorator(
+				repository.NewExchangeRateRepository(dbClient),
+			),
+		), tracerProvider,
+	)
+	goalRepo := metrics.NewGoalRepositoryTracerDecorator(
+		metrics.NewGoalRepositoryAmountErrorsDecorator(
+			metrics.NewGoalRepositoryLatencyDecorator(
+				repository.NewGoalRepository(dbClient),
+			),
+		), tracerProvider,
+	)
+
+	userContextService := metrics.NewUserContextServiceTracerDecorator(
+		metrics.NewUserContextServiceAmountErrorsDecorator(
+			metrics.NewUserContextServiceLatencyDecorator(
+				usercontext.NewService(redisClient, config.GoalNudge.DefaultCurrency),
+			),
+		), tracerProvider,
+	)
 
 	consumerComponent := kafka.NewConsumer(kafkaClient, config.Consumer, logger)
 
 	httpRouter := http.NewHttpRouter(config.Http, logger)
 	grpcClient := grpc.NewTelegramBot(config.Grpc, logger)
 
-	reportService := wastereport.NewService(consumerComponent, wasteRepo, grpcClient, logger)
+	reportStatusService := reportstatus.NewService(redisClient, config.ReportStatus)
+
+	formatter, err := format.New(config.Report.ParseMode)
+	if err != nil {
+		logger.WithError(err).
+			Fatal("failed to create formatter of messages")
+	}
+
+	reportService := wastereport.NewService(
+		config.Report,
+		consumerComponent,
+		wasteRepo,
+		exchangeRateRepo,
+		grpcClient,
+		deadLetterProducer,
+		reportStatusService,
+		formatter,
+		logger,
+	)
+
+	goalNudger := goalnudge.NewNudger(
+		config.GoalNudge,
+		goalRepo,
+		wasteRepo,
+		exchangeRateRepo,
+		userContextService,
+		grpcClient,
+		formatter,
+		logger,
+	)
 
 	err = app.New(config.App, logger,
 		consumerComponent,
 		httpRouter,
 		grpcClient,
 		reportService,
+		goalNudger,
 	).Run(context.Background())
 	if err != nil {
 		logger.WithError(err).Fatal("failed during running app")
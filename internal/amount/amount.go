@@ -0,0 +1,277 @@
+package amount
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+)
+
+// Digits is the amount of fractional digits of the parsed amounts, they are converted
+// to minor units of the currency with money.FromDecimal.
+const Digits = 2
+
+var (
+	ErrInvalidAmount   = errors.New("invalid amount")
+	ErrTooLarge        = errors.New("amount is too large")
+	ErrUnknownCurrency = errors.New("unknown currency")
+	ErrMixedCurrencies = errors.New("amount contains different currencies")
+)
+
+// currencyNames are the symbols and the names of the currencies written by users besides the codes,
+// the symbols used by several currencies, like "kr" or "¥", are not recognised.
+var currencyNames = map[string]string{
+	"₽":        "RUB",
+	"р":        "RUB",
+	"руб":      "RUB",
+	"рубль":    "RUB",
+	"рубля":    "RUB",
+	"рублей":   "RUB",
+	"$":        "USD",
+	"долл":     "USD",
+	"доллар":   "USD",
+	"доллара":  "USD",
+	"долларов": "USD",
+	"€":        "EUR",
+	"евро":     "EUR",
+	"£":        "GBP",
+	"₸":        "KZT",
+	"тенге":    "KZT",
+	"₴":        "UAH",
+	"грн":      "UAH",
+	"₾":        "GEL",
+	"лари":     "GEL",
+	"֏":        "AMD",
+	"₼":        "AZN",
+	"₺":        "TRY",
+	"₹":        "INR",
+	"₩":        "KRW",
+	"₪":        "ILS",
+	"฿":        "THB",
+	"₫":        "VND",
+}
+
+// maxAmount limits amounts, so the sums of them do not overflow.
+const maxAmount = math.MaxInt64 / 1024
+
+// Parse returns the amount of money with Digits fractional digits from the text typed by the user,
+// like 150 for "1.50".
+//
+// Both conventions of writing numbers are accepted: comma or dot as the decimal separator
+// and spaces, thin spaces, commas or dots as thousands separators, for example
+// "250,50", "1 500", "1,500.00" and "1.500,00". The separator followed by exactly three digits
+// is treated as the thousands separator, because the amount has only two fractional digits.
+// The numbers can be added and subtracted, for example "120+80" or "1000 руб - 250,50 руб".
+//
+// The code of the currency written around the numbers, like "$", "руб." or "USD", is returned,
+// it is empty if there is no currency. The unknown currencies and the different currencies
+// in one amount are rejected.
+func Parse(text string) (int64, string, error) {
+	var (
+		result   int64
+		currency string
+	)
+	sign := int64(1)
+	for {
+		end := strings.IndexAny(text, "+-")
+		term := text
+		if end >= 0 {
+			term = text[:end]
+		}
+
+		number, code, err := splitCurrency(strings.TrimSpace(term))
+		if err != nil {
+			return 0, "", err
+		}
+		if code != "" {
+			if currency != "" && currency != code {
+				return 0, "", fmt.Errorf("%w: %s and %s", ErrMixedCurrencies, currency, code)
+			}
+			currency = code
+		}
+
+		value, err := parseNumber(normalizeSpaces(number))
+		if err != nil {
+			return 0, "", err
+		}
+
+		result += sign * value
+		if result > maxAmount || result < -maxAmount {
+			return 0, "", ErrTooLarge
+		}
+
+		if end < 0 {
+			return result, currency, nil
+		}
+
+		sign = 1
+		if text[end] == '-' {
+			sign = -1
+		}
+		text = text[end+1:]
+	}
+}
+
+// splitCurrency returns the number without the currency symbols and names like "₽", "руб." or "USD"
+// around it and the code of the currency.
+func splitCurrency(term string) (string, string, error) {
+	number := strings.TrimLeftFunc(term, isCurrency)
+	prefix := term[:len(term)-len(number)]
+
+	// the dot is removed only after the abbreviation, otherwise it is the part of the number
+	withoutDots := strings.TrimRight(number, ".")
+	if trimmed := strings.TrimRightFunc(withoutDots, isCurrency); trimmed != withoutDots {
+		number = trimmed
+	}
+	suffix := term[len(prefix)+len(number):]
+
+	currency := ""
+	for _, name := range []string{prefix, suffix} {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+
+		code, ok := lookupCurrency(name)
+		if !ok {
+			return "", "", fmt.Errorf("%w: %q", ErrUnknownCurrency, strings.TrimSpace(name))
+		}
+		if currency != "" && currency != code {
+			return "", "", fmt.Errorf("%w: %s and %s", ErrMixedCurrencies, currency, code)
+		}
+		currency = code
+	}
+
+	return strings.TrimSpace(number), currency, nil
+}
+
+// lookupCurrency returns the code of the currency by the code of ISO 4217, the symbol or the name in any case.
+func lookupCurrency(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimRight(strings.TrimSpace(name), "."))
+	if code, ok := currencyNames[name]; ok {
+		return code, true
+	}
+
+	currency, ok := money.LookupCurrency(name)
+	if !ok {
+		return "", false
+	}
+
+	return currency.Code, true
+}
+
+func isCurrency(r rune) bool {
+	return unicode.IsLetter(r) || unicode.Is(unicode.Sc, r)
+}
+
+// normalizeSpaces replaces spaces between the groups of digits like
+// no-break and thin spaces with the usual space.
+func normalizeSpaces(term string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return ' '
+		}
+		return r
+	}, term)
+}
+
+func parseNumber(number string) (int64, error) {
+	if number == "" {
+		return 0, fmt.Errorf("%w: empty number", ErrInvalidAmount)
+	}
+
+	integer, fraction, err := splitNumber(number)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(fraction) > Digits {
+		return 0, fmt.Errorf("%w: too many fractional digits in %q", ErrInvalidAmount, number)
+	}
+	fraction += strings.Repeat("0", Digits-len(fraction))
+
+	var value int64
+	for _, r := range integer + fraction {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("%w: unexpected symbol %q in %q", ErrInvalidAmount, r, number)
+		}
+
+		value = value*10 + int64(r-'0')
+		if value > maxAmount {
+			return 0, ErrTooLarge
+		}
+	}
+
+	return value, nil
+}
+
+// splitNumber returns the digits of the integer and fractional parts of the number
+// without thousands separators.
+func splitNumber(number string) (string, string, error) {
+	lastComma := strings.LastIndex(number, ",")
+	lastDot := strings.LastIndex(number, ".")
+
+	decimal := -1
+	switch {
+	case lastComma >= 0 && lastDot >= 0:
+		decimal = lastComma
+		if lastDot > lastComma {
+			decimal = lastDot
+		}
+
+	case lastComma >= 0 || lastDot >= 0:
+		separator := lastComma
+		if lastDot >= 0 {
+			separator = lastDot
+		}
+
+		isSingle := strings.Count(number, number[separator:separator+1]) == 1
+		if isSingle && len(number)-separator-1 != 3 {
+			decimal = separator
+		}
+	}
+
+	integer, fraction := number, ""
+	if decimal >= 0 {
+		integer, fraction = number[:decimal], number[decimal+1:]
+		if fraction == "" {
+			return "", "", fmt.Errorf("%w: no digits after decimal separator in %q", ErrInvalidAmount, number)
+		}
+		if integer == "" {
+			integer = "0"
+		}
+	}
+
+	integer, err := removeGroupSeparators(integer)
+	if err != nil {
+		return "", "", fmt.Errorf("%w in %q", err, number)
+	}
+
+	return integer, fraction, nil
+}
+
+// removeGroupSeparators checks that digits are grouped by three
+// and the same separator (comma, dot or space) is used between groups.
+func removeGroupSeparators(integer string) (string, error) {
+	separator := strings.IndexAny(integer, ",. ")
+	if separator < 0 {
+		if integer == "" {
+			return "", fmt.Errorf("%w: no digits", ErrInvalidAmount)
+		}
+		return integer, nil
+	}
+
+	groups := strings.Split(integer, integer[separator:separator+1])
+	if len(groups[0]) == 0 || len(groups[0]) > 3 || groups[0][0] == '0' {
+		return "", fmt.Errorf("%w: wrong grouping of digits", ErrInvalidAmount)
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return "", fmt.Errorf("%w: wrong grouping of digits", ErrInvalidAmount)
+		}
+	}
+
+	return strings.Join(groups, ""), nil
+}
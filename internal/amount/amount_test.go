@@ -0,0 +1,61 @@
+package amount
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		want         int64
+		wantCurrency string
+		wantErr      error
+	}{
+		{name: "integer", text: "250", want: 25000},
+		{name: "decimal comma", text: "250,50", want: 25050},
+		{name: "decimal dot with one digit", text: "250.5", want: 25050},
+		{name: "no integer part", text: ".5", want: 50},
+		{name: "space groups", text: "1 500", want: 150000},
+		{name: "no-break space groups", text: "1 500", want: 150000},
+		{name: "thin space groups", text: "1 500", want: 150000},
+		{name: "comma groups and decimal dot", text: "1,500.00", want: 150000},
+		{name: "dot groups and decimal comma", text: "1.500,00", want: 150000},
+		{name: "three digits after single separator", text: "1,500", want: 150000},
+		{name: "several dot groups", text: "1.234.567", want: 123456700},
+		{name: "currency symbol", text: "500 ₽", want: 50000, wantCurrency: "RUB"},
+		{name: "currency abbreviation with dot", text: "100 руб.", want: 10000, wantCurrency: "RUB"},
+		{name: "currency code before number", text: "USD 12.99", want: 1299, wantCurrency: "USD"},
+		{name: "currency code in lower case", text: "12 eur", want: 1200, wantCurrency: "EUR"},
+		{name: "symbol without space", text: "$5", want: 500, wantCurrency: "USD"},
+		{name: "currency of one term", text: "120+80 $", want: 20000, wantCurrency: "USD"},
+		{name: "addition", text: "120+80", want: 20000},
+		{name: "subtraction with currencies", text: "1000 руб - 250,50 руб", want: 74950, wantCurrency: "RUB"},
+		{name: "empty", text: "", wantErr: ErrInvalidAmount},
+		{name: "only letters", text: "abc", wantErr: ErrUnknownCurrency},
+		{name: "unknown currency", text: "12 XYZ", wantErr: ErrUnknownCurrency},
+		{name: "ambiguous symbol", text: "100 kr", wantErr: ErrUnknownCurrency},
+		{name: "different currencies", text: "10 USD + 5 EUR", wantErr: ErrMixedCurrencies},
+		{name: "different currencies around number", text: "$10 EUR", wantErr: ErrMixedCurrencies},
+		{name: "leading minus", text: "-5", wantErr: ErrInvalidAmount},
+		{name: "no digits after decimal separator", text: "10.", wantErr: ErrInvalidAmount},
+		{name: "too many fractional digits", text: "1.234,567", wantErr: ErrInvalidAmount},
+		{name: "wrong grouping", text: "1,2,3", wantErr: ErrInvalidAmount},
+		{name: "leading zero in groups", text: "01 000", wantErr: ErrInvalidAmount},
+		{name: "unexpected symbol", text: "12#5", wantErr: ErrInvalidAmount},
+		{name: "too large", text: "99999999999999999", wantErr: ErrTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, currency, err := Parse(tt.text)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse(%q) error = %v, want %v", tt.text, err, tt.wantErr)
+			}
+			if got != tt.want || currency != tt.wantCurrency {
+				t.Errorf("Parse(%q) = %d %q, want %d %q", tt.text, got, currency, tt.want, tt.wantCurrency)
+			}
+		})
+	}
+}
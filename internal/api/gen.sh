@@ -3,5 +3,5 @@
 protoc \
   --go_out=. --go_opt=paths=source_relative \
   --go-grpc_out=. --go-grpc_opt=paths=source_relative \
-  telegram_bot.proto
+  telegram_bot.proto wastes.proto
 
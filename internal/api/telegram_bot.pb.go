@@ -20,33 +20,570 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ParseMode int32
+
+const (
+	ParseMode_PARSE_MODE_MARKDOWN    ParseMode = 0
+	ParseMode_PARSE_MODE_MARKDOWN_V2 ParseMode = 1
+	ParseMode_PARSE_MODE_HTML        ParseMode = 2
+	ParseMode_PARSE_MODE_PLAIN       ParseMode = 3
+)
+
+// Enum value maps for ParseMode.
+var (
+	ParseMode_name = map[int32]string{
+		0: "PARSE_MODE_MARKDOWN",
+		1: "PARSE_MODE_MARKDOWN_V2",
+		2: "PARSE_MODE_HTML",
+		3: "PARSE_MODE_PLAIN",
+	}
+	ParseMode_value = map[string]int32{
+		"PARSE_MODE_MARKDOWN":    0,
+		"PARSE_MODE_MARKDOWN_V2": 1,
+		"PARSE_MODE_HTML":        2,
+		"PARSE_MODE_PLAIN":       3,
+	}
+)
+
+func (x ParseMode) Enum() *ParseMode {
+	p := new(ParseMode)
+	*p = x
+	return p
+}
+
+func (x ParseMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParseMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_telegram_bot_proto_enumTypes[0].Descriptor()
+}
+
+func (ParseMode) Type() protoreflect.EnumType {
+	return &file_telegram_bot_proto_enumTypes[0]
+}
+
+func (x ParseMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParseMode.Descriptor instead.
+func (ParseMode) EnumDescriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{0}
+}
+
+type KeyboardType int32
+
+const (
+	// removes the reply keyboard of the user
+	KeyboardType_KEYBOARD_TYPE_REMOVE KeyboardType = 0
+	// keeps the current reply keyboard of the user
+	KeyboardType_KEYBOARD_TYPE_KEEP   KeyboardType = 1
+	KeyboardType_KEYBOARD_TYPE_REPLY  KeyboardType = 2
+	KeyboardType_KEYBOARD_TYPE_INLINE KeyboardType = 3
+)
+
+// Enum value maps for KeyboardType.
+var (
+	KeyboardType_name = map[int32]string{
+		0: "KEYBOARD_TYPE_REMOVE",
+		1: "KEYBOARD_TYPE_KEEP",
+		2: "KEYBOARD_TYPE_REPLY",
+		3: "KEYBOARD_TYPE_INLINE",
+	}
+	KeyboardType_value = map[string]int32{
+		"KEYBOARD_TYPE_REMOVE": 0,
+		"KEYBOARD_TYPE_KEEP":   1,
+		"KEYBOARD_TYPE_REPLY":  2,
+		"KEYBOARD_TYPE_INLINE": 3,
+	}
+)
+
+func (x KeyboardType) Enum() *KeyboardType {
+	p := new(KeyboardType)
+	*p = x
+	return p
+}
+
+func (x KeyboardType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyboardType) Descriptor() protoreflect.EnumDescriptor {
+	return file_telegram_bot_proto_enumTypes[1].Descriptor()
+}
+
+func (KeyboardType) Type() protoreflect.EnumType {
+	return &file_telegram_bot_proto_enumTypes[1]
+}
+
+func (x KeyboardType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyboardType.Descriptor instead.
+func (KeyboardType) EnumDescriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{1}
+}
+
+type KeyboardButton struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// only for inline keyboard, one of them must be set
+	CallbackData string `protobuf:"bytes,2,opt,name=callback_data,json=callbackData,proto3" json:"callback_data,omitempty"`
+	Url          string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *KeyboardButton) Reset() {
+	*x = KeyboardButton{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telegram_bot_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyboardButton) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyboardButton) ProtoMessage() {}
+
+func (x *KeyboardButton) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyboardButton.ProtoReflect.Descriptor instead.
+func (*KeyboardButton) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyboardButton) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *KeyboardButton) GetCallbackData() string {
+	if x != nil {
+		return x.CallbackData
+	}
+	return ""
+}
+
+func (x *KeyboardButton) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type KeyboardRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buttons []*KeyboardButton `protobuf:"bytes,1,rep,name=buttons,proto3" json:"buttons,omitempty"`
+}
+
+func (x *KeyboardRow) Reset() {
+	*x = KeyboardRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telegram_bot_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyboardRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyboardRow) ProtoMessage() {}
+
+func (x *KeyboardRow) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyboardRow.ProtoReflect.Descriptor instead.
+func (*KeyboardRow) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KeyboardRow) GetButtons() []*KeyboardButton {
+	if x != nil {
+		return x.Buttons
+	}
+	return nil
+}
+
+type Keyboard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type KeyboardType   `protobuf:"varint,1,opt,name=type,proto3,enum=api.KeyboardType" json:"type,omitempty"`
+	Rows []*KeyboardRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	// only for reply keyboard
+	OneTime bool `protobuf:"varint,3,opt,name=one_time,json=oneTime,proto3" json:"one_time,omitempty"`
+}
+
+func (x *Keyboard) Reset() {
+	*x = Keyboard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telegram_bot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Keyboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Keyboard) ProtoMessage() {}
+
+func (x *Keyboard) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Keyboard.ProtoReflect.Descriptor instead.
+func (*Keyboard) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Keyboard) GetType() KeyboardType {
+	if x != nil {
+		return x.Type
+	}
+	return KeyboardType_KEYBOARD_TYPE_REMOVE
+}
+
+func (x *Keyboard) GetRows() []*KeyboardRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *Keyboard) GetOneTime() bool {
+	if x != nil {
+		return x.OneTime
+	}
+	return false
+}
+
 type Message struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Text    string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	Command string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	UserId    int64     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text      string    `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Command   string    `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	ParseMode ParseMode `protobuf:"varint,4,opt,name=parse_mode,json=parseMode,proto3,enum=api.ParseMode" json:"parse_mode,omitempty"`
+	Keyboard  *Keyboard `protobuf:"bytes,5,opt,name=keyboard,proto3" json:"keyboard,omitempty"`
+	// the message with the same key is sent only once, the result of the first
+	// sending is returned for the repeated requests
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// the language of the user for the texts added by the bot, like the caption
+	// of the long message sent as a file
+	Language string `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telegram_bot_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Message) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Message) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Message) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *Message) GetParseMode() ParseMode {
+	if x != nil {
+		return x.ParseMode
+	}
+	return ParseMode_PARSE_MODE_MARKDOWN
+}
+
+func (x *Message) GetKeyboard() *Keyboard {
+	if x != nil {
+		return x.Keyboard
+	}
+	return nil
+}
+
+func (x *Message) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *Message) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type MessageBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *MessageBatch) Reset() {
+	*x = MessageBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telegram_bot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBatch) ProtoMessage() {}
+
+func (x *MessageBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBatch.ProtoReflect.Descriptor instead.
+func (*MessageBatch) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MessageBatch) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type MessageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId int32  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MessageResult) Reset() {
+	*x = MessageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telegram_bot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageResult) ProtoMessage() {}
+
+func (x *MessageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageResult.ProtoReflect.Descriptor instead.
+func (*MessageResult) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MessageResult) GetMessageId() int32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *MessageResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MessageBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results in the same order as messages of the batch
+	Results []*MessageResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MessageBatchResult) Reset() {
+	*x = MessageBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telegram_bot_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBatchResult) ProtoMessage() {}
+
+func (x *MessageBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBatchResult.ProtoReflect.Descriptor instead.
+func (*MessageBatchResult) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MessageBatchResult) GetResults() []*MessageResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Types that are assignable to Source:
+	//	*File_Content
+	//	*File_Url
+	Source         isFile_Source `protobuf_oneof:"source"`
+	Caption        string        `protobuf:"bytes,5,opt,name=caption,proto3" json:"caption,omitempty"`
+	ParseMode      ParseMode     `protobuf:"varint,6,opt,name=parse_mode,json=parseMode,proto3,enum=api.ParseMode" json:"parse_mode,omitempty"`
+	Keyboard       *Keyboard     `protobuf:"bytes,7,opt,name=keyboard,proto3" json:"keyboard,omitempty"`
+	IdempotencyKey string        `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
+func (x *File) Reset() {
+	*x = File{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_telegram_bot_proto_msgTypes[0]
+		mi := &file_telegram_bot_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Message) String() string {
+func (x *File) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*File) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_telegram_bot_proto_msgTypes[0]
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,32 +594,217 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_telegram_bot_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Message) GetUserId() int64 {
+func (x *File) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *Message) GetText() string {
+func (x *File) GetFileName() string {
 	if x != nil {
-		return x.Text
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *Message) GetCommand() string {
+func (m *File) GetSource() isFile_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *File) GetContent() []byte {
+	if x, ok := x.GetSource().(*File_Content); ok {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *File) GetUrl() string {
+	if x, ok := x.GetSource().(*File_Url); ok {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *File) GetCaption() string {
 	if x != nil {
-		return x.Command
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *File) GetParseMode() ParseMode {
+	if x != nil {
+		return x.ParseMode
+	}
+	return ParseMode_PARSE_MODE_MARKDOWN
+}
+
+func (x *File) GetKeyboard() *Keyboard {
+	if x != nil {
+		return x.Keyboard
+	}
+	return nil
+}
+
+func (x *File) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type isFile_Source interface {
+	isFile_Source()
+}
+
+type File_Content struct {
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3,oneof"`
+}
+
+type File_Url struct {
+	Url string `protobuf:"bytes,4,opt,name=url,proto3,oneof"`
+}
+
+func (*File_Content) isFile_Source() {}
+
+func (*File_Url) isFile_Source() {}
+
+type EditedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MessageId int32     `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Text      string    `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	ParseMode ParseMode `protobuf:"varint,4,opt,name=parse_mode,json=parseMode,proto3,enum=api.ParseMode" json:"parse_mode,omitempty"`
+	// only inline keyboard can be set to the edited message
+	Keyboard *Keyboard `protobuf:"bytes,5,opt,name=keyboard,proto3" json:"keyboard,omitempty"`
+}
+
+func (x *EditedMessage) Reset() {
+	*x = EditedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telegram_bot_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditedMessage) ProtoMessage() {}
+
+func (x *EditedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditedMessage.ProtoReflect.Descriptor instead.
+func (*EditedMessage) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EditedMessage) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditedMessage) GetMessageId() int32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *EditedMessage) GetText() string {
+	if x != nil {
+		return x.Text
 	}
 	return ""
 }
 
+func (x *EditedMessage) GetParseMode() ParseMode {
+	if x != nil {
+		return x.ParseMode
+	}
+	return ParseMode_PARSE_MODE_MARKDOWN
+}
+
+func (x *EditedMessage) GetKeyboard() *Keyboard {
+	if x != nil {
+		return x.Keyboard
+	}
+	return nil
+}
+
+type SentMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId int32 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (x *SentMessage) Reset() {
+	*x = SentMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telegram_bot_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SentMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentMessage) ProtoMessage() {}
+
+func (x *SentMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_telegram_bot_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentMessage.ProtoReflect.Descriptor instead.
+func (*SentMessage) Descriptor() ([]byte, []int) {
+	return file_telegram_bot_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SentMessage) GetMessageId() int32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
 type EmptyMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -92,7 +814,7 @@ type EmptyMessage struct {
 func (x *EmptyMessage) Reset() {
 	*x = EmptyMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_telegram_bot_proto_msgTypes[1]
+		mi := &file_telegram_bot_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -105,7 +827,7 @@ func (x *EmptyMessage) String() string {
 func (*EmptyMessage) ProtoMessage() {}
 
 func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_telegram_bot_proto_msgTypes[1]
+	mi := &file_telegram_bot_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -118,28 +840,127 @@ func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyMessage.ProtoReflect.Descriptor instead.
 func (*EmptyMessage) Descriptor() ([]byte, []int) {
-	return file_telegram_bot_proto_rawDescGZIP(), []int{1}
+	return file_telegram_bot_proto_rawDescGZIP(), []int{10}
 }
 
 var File_telegram_bot_proto protoreflect.FileDescriptor
 
 var file_telegram_bot_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x62, 0x6f, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x22, 0x50, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x22, 0x5b, 0x0a, 0x0e, 0x4b, 0x65, 0x79,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x3c, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x6f, 0x77, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x65, 0x79,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x52, 0x07, 0x62, 0x75, 0x74,
+	0x74, 0x6f, 0x6e, 0x73, 0x22, 0x72, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x65, 0x79, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
 	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x3f, 0x0a, 0x0b, 0x54,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x0b, 0x53, 0x65,
-	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38,
-	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x72, 0x75, 0x2f, 0x73,
-	0x74, 0x65, 0x70, 0x61, 0x6e, 0x6f, 0x76, 0x2e, 0x61, 0x6f, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x74,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x62, 0x6f, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x09, 0x70, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x6b, 0x65,
+	0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x08, 0x6b, 0x65, 0x79,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x38, 0x0a, 0x0c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x08, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x12, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x93,
+	0x02, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4b,
+	0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x0d, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x2d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72,
+	0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x29, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x0b,
+	0x53, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x6b, 0x0a, 0x09, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x52, 0x53, 0x45,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d,
+	0x41, 0x52, 0x4b, 0x44, 0x4f, 0x57, 0x4e, 0x5f, 0x56, 0x32, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x48, 0x54, 0x4d, 0x4c, 0x10,
+	0x02, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10, 0x03, 0x2a, 0x73, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x45, 0x59, 0x42, 0x4f,
+	0x41, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x45, 0x59, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4b, 0x45, 0x59,
+	0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59,
+	0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x45, 0x59, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x03, 0x32, 0x8f, 0x02, 0x0a,
+	0x0b, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x6f, 0x74, 0x12, 0x2f, 0x0a, 0x0b,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a,
+	0x0c, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x11, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x09, 0x53,
+	0x65, 0x6e, 0x64, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x42, 0x3a,
+	0x5a, 0x38, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x6f, 0x7a, 0x6f, 0x6e, 0x2e, 0x72, 0x75,
+	0x2f, 0x73, 0x74, 0x65, 0x70, 0x61, 0x6e, 0x6f, 0x76, 0x2e, 0x61, 0x6f, 0x2e, 0x64, 0x65, 0x76,
+	0x2f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x62, 0x6f, 0x74, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -154,19 +975,50 @@ func file_telegram_bot_proto_rawDescGZIP() []byte {
 	return file_telegram_bot_proto_rawDescData
 }
 
-var file_telegram_bot_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_telegram_bot_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_telegram_bot_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_telegram_bot_proto_goTypes = []interface{}{
-	(*Message)(nil),      // 0: api.Message
-	(*EmptyMessage)(nil), // 1: api.EmptyMessage
+	(ParseMode)(0),             // 0: api.ParseMode
+	(KeyboardType)(0),          // 1: api.KeyboardType
+	(*KeyboardButton)(nil),     // 2: api.KeyboardButton
+	(*KeyboardRow)(nil),        // 3: api.KeyboardRow
+	(*Keyboard)(nil),           // 4: api.Keyboard
+	(*Message)(nil),            // 5: api.Message
+	(*MessageBatch)(nil),       // 6: api.MessageBatch
+	(*MessageResult)(nil),      // 7: api.MessageResult
+	(*MessageBatchResult)(nil), // 8: api.MessageBatchResult
+	(*File)(nil),               // 9: api.File
+	(*EditedMessage)(nil),      // 10: api.EditedMessage
+	(*SentMessage)(nil),        // 11: api.SentMessage
+	(*EmptyMessage)(nil),       // 12: api.EmptyMessage
 }
 var file_telegram_bot_proto_depIdxs = []int32{
-	0, // 0: api.TelegramBot.SendMessage:input_type -> api.Message
-	1, // 1: api.TelegramBot.SendMessage:output_type -> api.EmptyMessage
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: api.KeyboardRow.buttons:type_name -> api.KeyboardButton
+	1,  // 1: api.Keyboard.type:type_name -> api.KeyboardType
+	3,  // 2: api.Keyboard.rows:type_name -> api.KeyboardRow
+	0,  // 3: api.Message.parse_mode:type_name -> api.ParseMode
+	4,  // 4: api.Message.keyboard:type_name -> api.Keyboard
+	5,  // 5: api.MessageBatch.messages:type_name -> api.Message
+	7,  // 6: api.MessageBatchResult.results:type_name -> api.MessageResult
+	0,  // 7: api.File.parse_mode:type_name -> api.ParseMode
+	4,  // 8: api.File.keyboard:type_name -> api.Keyboard
+	0,  // 9: api.EditedMessage.parse_mode:type_name -> api.ParseMode
+	4,  // 10: api.EditedMessage.keyboard:type_name -> api.Keyboard
+	5,  // 11: api.TelegramBot.SendMessage:input_type -> api.Message
+	6,  // 12: api.TelegramBot.SendMessages:input_type -> api.MessageBatch
+	9,  // 13: api.TelegramBot.SendPhoto:input_type -> api.File
+	9,  // 14: api.TelegramBot.SendDocument:input_type -> api.File
+	10, // 15: api.TelegramBot.EditMessage:input_type -> api.EditedMessage
+	11, // 16: api.TelegramBot.SendMessage:output_type -> api.SentMessage
+	8,  // 17: api.TelegramBot.SendMessages:output_type -> api.MessageBatchResult
+	11, // 18: api.TelegramBot.SendPhoto:output_type -> api.SentMessage
+	11, // 19: api.TelegramBot.SendDocument:output_type -> api.SentMessage
+	12, // 20: api.TelegramBot.EditMessage:output_type -> api.EmptyMessage
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_telegram_bot_proto_init() }
@@ -176,7 +1028,7 @@ func file_telegram_bot_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_telegram_bot_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+			switch v := v.(*KeyboardButton); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -188,6 +1040,114 @@ func file_telegram_bot_proto_init() {
 			}
 		}
 		file_telegram_bot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyboardRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telegram_bot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Keyboard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telegram_bot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telegram_bot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telegram_bot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telegram_bot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telegram_bot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telegram_bot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telegram_bot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SentMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telegram_bot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyMessage); i {
 			case 0:
 				return &v.state
@@ -200,18 +1160,23 @@ func file_telegram_bot_proto_init() {
 			}
 		}
 	}
+	file_telegram_bot_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*File_Content)(nil),
+		(*File_Url)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_telegram_bot_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_telegram_bot_proto_goTypes,
 		DependencyIndexes: file_telegram_bot_proto_depIdxs,
+		EnumInfos:         file_telegram_bot_proto_enumTypes,
 		MessageInfos:      file_telegram_bot_proto_msgTypes,
 	}.Build()
 	File_telegram_bot_proto = out.File
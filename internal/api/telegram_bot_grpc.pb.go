@@ -18,7 +18,11 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TelegramBotClient interface {
-	SendMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*EmptyMessage, error)
+	SendMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*SentMessage, error)
+	SendMessages(ctx context.Context, in *MessageBatch, opts ...grpc.CallOption) (*MessageBatchResult, error)
+	SendPhoto(ctx context.Context, in *File, opts ...grpc.CallOption) (*SentMessage, error)
+	SendDocument(ctx context.Context, in *File, opts ...grpc.CallOption) (*SentMessage, error)
+	EditMessage(ctx context.Context, in *EditedMessage, opts ...grpc.CallOption) (*EmptyMessage, error)
 }
 
 type telegramBotClient struct {
@@ -29,8 +33,8 @@ func NewTelegramBotClient(cc grpc.ClientConnInterface) TelegramBotClient {
 	return &telegramBotClient{cc}
 }
 
-func (c *telegramBotClient) SendMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*EmptyMessage, error) {
-	out := new(EmptyMessage)
+func (c *telegramBotClient) SendMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*SentMessage, error) {
+	out := new(SentMessage)
 	err := c.cc.Invoke(ctx, "/api.TelegramBot/SendMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -38,11 +42,51 @@ func (c *telegramBotClient) SendMessage(ctx context.Context, in *Message, opts .
 	return out, nil
 }
 
+func (c *telegramBotClient) SendMessages(ctx context.Context, in *MessageBatch, opts ...grpc.CallOption) (*MessageBatchResult, error) {
+	out := new(MessageBatchResult)
+	err := c.cc.Invoke(ctx, "/api.TelegramBot/SendMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telegramBotClient) SendPhoto(ctx context.Context, in *File, opts ...grpc.CallOption) (*SentMessage, error) {
+	out := new(SentMessage)
+	err := c.cc.Invoke(ctx, "/api.TelegramBot/SendPhoto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telegramBotClient) SendDocument(ctx context.Context, in *File, opts ...grpc.CallOption) (*SentMessage, error) {
+	out := new(SentMessage)
+	err := c.cc.Invoke(ctx, "/api.TelegramBot/SendDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telegramBotClient) EditMessage(ctx context.Context, in *EditedMessage, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, "/api.TelegramBot/EditMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TelegramBotServer is the server API for TelegramBot service.
 // All implementations must embed UnimplementedTelegramBotServer
 // for forward compatibility
 type TelegramBotServer interface {
-	SendMessage(context.Context, *Message) (*EmptyMessage, error)
+	SendMessage(context.Context, *Message) (*SentMessage, error)
+	SendMessages(context.Context, *MessageBatch) (*MessageBatchResult, error)
+	SendPhoto(context.Context, *File) (*SentMessage, error)
+	SendDocument(context.Context, *File) (*SentMessage, error)
+	EditMessage(context.Context, *EditedMessage) (*EmptyMessage, error)
 	mustEmbedUnimplementedTelegramBotServer()
 }
 
@@ -50,9 +94,21 @@ type TelegramBotServer interface {
 type UnimplementedTelegramBotServer struct {
 }
 
-func (UnimplementedTelegramBotServer) SendMessage(context.Context, *Message) (*EmptyMessage, error) {
+func (UnimplementedTelegramBotServer) SendMessage(context.Context, *Message) (*SentMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedTelegramBotServer) SendMessages(context.Context, *MessageBatch) (*MessageBatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessages not implemented")
+}
+func (UnimplementedTelegramBotServer) SendPhoto(context.Context, *File) (*SentMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPhoto not implemented")
+}
+func (UnimplementedTelegramBotServer) SendDocument(context.Context, *File) (*SentMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDocument not implemented")
+}
+func (UnimplementedTelegramBotServer) EditMessage(context.Context, *EditedMessage) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMessage not implemented")
+}
 func (UnimplementedTelegramBotServer) mustEmbedUnimplementedTelegramBotServer() {}
 
 // UnsafeTelegramBotServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +140,78 @@ func _TelegramBot_SendMessage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TelegramBot_SendMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramBotServer).SendMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelegramBot/SendMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramBotServer).SendMessages(ctx, req.(*MessageBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelegramBot_SendPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(File)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramBotServer).SendPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelegramBot/SendPhoto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramBotServer).SendPhoto(ctx, req.(*File))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelegramBot_SendDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(File)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramBotServer).SendDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelegramBot/SendDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramBotServer).SendDocument(ctx, req.(*File))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelegramBot_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramBotServer).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelegramBot/EditMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramBotServer).EditMessage(ctx, req.(*EditedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TelegramBot_ServiceDesc is the grpc.ServiceDesc for TelegramBot service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -95,6 +223,22 @@ var TelegramBot_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _TelegramBot_SendMessage_Handler,
 		},
+		{
+			MethodName: "SendMessages",
+			Handler:    _TelegramBot_SendMessages_Handler,
+		},
+		{
+			MethodName: "SendPhoto",
+			Handler:    _TelegramBot_SendPhoto_Handler,
+		},
+		{
+			MethodName: "SendDocument",
+			Handler:    _TelegramBot_SendDocument_Handler,
+		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _TelegramBot_EditMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "telegram_bot.proto",
@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// WastesServiceClient is the client API for WastesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WastesServiceClient interface {
+	ListWastes(ctx context.Context, in *ListWastesRequest, opts ...grpc.CallOption) (*ListWastesResponse, error)
+	AddWaste(ctx context.Context, in *AddWasteRequest, opts ...grpc.CallOption) (*Waste, error)
+	UpdateWaste(ctx context.Context, in *UpdateWasteRequest, opts ...grpc.CallOption) (*Waste, error)
+	DeleteWaste(ctx context.Context, in *DeleteWasteRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	GetLimit(ctx context.Context, in *GetLimitRequest, opts ...grpc.CallOption) (*Limit, error)
+	SetLimit(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*Limit, error)
+	GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*Report, error)
+}
+
+type wastesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWastesServiceClient(cc grpc.ClientConnInterface) WastesServiceClient {
+	return &wastesServiceClient{cc}
+}
+
+func (c *wastesServiceClient) ListWastes(ctx context.Context, in *ListWastesRequest, opts ...grpc.CallOption) (*ListWastesResponse, error) {
+	out := new(ListWastesResponse)
+	err := c.cc.Invoke(ctx, "/api.WastesService/ListWastes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wastesServiceClient) AddWaste(ctx context.Context, in *AddWasteRequest, opts ...grpc.CallOption) (*Waste, error) {
+	out := new(Waste)
+	err := c.cc.Invoke(ctx, "/api.WastesService/AddWaste", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wastesServiceClient) UpdateWaste(ctx context.Context, in *UpdateWasteRequest, opts ...grpc.CallOption) (*Waste, error) {
+	out := new(Waste)
+	err := c.cc.Invoke(ctx, "/api.WastesService/UpdateWaste", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wastesServiceClient) DeleteWaste(ctx context.Context, in *DeleteWasteRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, "/api.WastesService/DeleteWaste", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wastesServiceClient) GetLimit(ctx context.Context, in *GetLimitRequest, opts ...grpc.CallOption) (*Limit, error) {
+	out := new(Limit)
+	err := c.cc.Invoke(ctx, "/api.WastesService/GetLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wastesServiceClient) SetLimit(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*Limit, error) {
+	out := new(Limit)
+	err := c.cc.Invoke(ctx, "/api.WastesService/SetLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wastesServiceClient) GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*Report, error) {
+	out := new(Report)
+	err := c.cc.Invoke(ctx, "/api.WastesService/GetReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WastesServiceServer is the server API for WastesService service.
+// All implementations must embed UnimplementedWastesServiceServer
+// for forward compatibility
+type WastesServiceServer interface {
+	ListWastes(context.Context, *ListWastesRequest) (*ListWastesResponse, error)
+	AddWaste(context.Context, *AddWasteRequest) (*Waste, error)
+	UpdateWaste(context.Context, *UpdateWasteRequest) (*Waste, error)
+	DeleteWaste(context.Context, *DeleteWasteRequest) (*EmptyMessage, error)
+	GetLimit(context.Context, *GetLimitRequest) (*Limit, error)
+	SetLimit(context.Context, *SetLimitRequest) (*Limit, error)
+	GetReport(context.Context, *GetReportRequest) (*Report, error)
+	mustEmbedUnimplementedWastesServiceServer()
+}
+
+// UnimplementedWastesServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWastesServiceServer struct {
+}
+
+func (UnimplementedWastesServiceServer) ListWastes(context.Context, *ListWastesRequest) (*ListWastesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWastes not implemented")
+}
+func (UnimplementedWastesServiceServer) AddWaste(context.Context, *AddWasteRequest) (*Waste, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWaste not implemented")
+}
+func (UnimplementedWastesServiceServer) UpdateWaste(context.Context, *UpdateWasteRequest) (*Waste, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWaste not implemented")
+}
+func (UnimplementedWastesServiceServer) DeleteWaste(context.Context, *DeleteWasteRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWaste not implemented")
+}
+func (UnimplementedWastesServiceServer) GetLimit(context.Context, *GetLimitRequest) (*Limit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimit not implemented")
+}
+func (UnimplementedWastesServiceServer) SetLimit(context.Context, *SetLimitRequest) (*Limit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLimit not implemented")
+}
+func (UnimplementedWastesServiceServer) GetReport(context.Context, *GetReportRequest) (*Report, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedWastesServiceServer) mustEmbedUnimplementedWastesServiceServer() {}
+
+// UnsafeWastesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WastesServiceServer will
+// result in compilation errors.
+type UnsafeWastesServiceServer interface {
+	mustEmbedUnimplementedWastesServiceServer()
+}
+
+func RegisterWastesServiceServer(s grpc.ServiceRegistrar, srv WastesServiceServer) {
+	s.RegisterService(&WastesService_ServiceDesc, srv)
+}
+
+func _WastesService_ListWastes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWastesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WastesServiceServer).ListWastes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WastesService/ListWastes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WastesServiceServer).ListWastes(ctx, req.(*ListWastesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WastesService_AddWaste_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWasteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WastesServiceServer).AddWaste(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WastesService/AddWaste",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WastesServiceServer).AddWaste(ctx, req.(*AddWasteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WastesService_UpdateWaste_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWasteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WastesServiceServer).UpdateWaste(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WastesService/UpdateWaste",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WastesServiceServer).UpdateWaste(ctx, req.(*UpdateWasteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WastesService_DeleteWaste_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWasteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WastesServiceServer).DeleteWaste(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WastesService/DeleteWaste",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WastesServiceServer).DeleteWaste(ctx, req.(*DeleteWasteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WastesService_GetLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WastesServiceServer).GetLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WastesService/GetLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WastesServiceServer).GetLimit(ctx, req.(*GetLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WastesService_SetLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WastesServiceServer).SetLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WastesService/SetLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WastesServiceServer).SetLimit(ctx, req.(*SetLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WastesService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WastesServiceServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WastesService/GetReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WastesServiceServer).GetReport(ctx, req.(*GetReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WastesService_ServiceDesc is the grpc.ServiceDesc for WastesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WastesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.WastesService",
+	HandlerType: (*WastesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListWastes",
+			Handler:    _WastesService_ListWastes_Handler,
+		},
+		{
+			MethodName: "AddWaste",
+			Handler:    _WastesService_AddWaste_Handler,
+		},
+		{
+			MethodName: "UpdateWaste",
+			Handler:    _WastesService_UpdateWaste_Handler,
+		},
+		{
+			MethodName: "DeleteWaste",
+			Handler:    _WastesService_DeleteWaste_Handler,
+		},
+		{
+			MethodName: "GetLimit",
+			Handler:    _WastesService_GetLimit_Handler,
+		},
+		{
+			MethodName: "SetLimit",
+			Handler:    _WastesService_SetLimit_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _WastesService_GetReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wastes.proto",
+}
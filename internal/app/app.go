@@ -64,19 +64,21 @@ func (a *App) Run(ctx context.Context) error {
 	return nil
 }
 
+// stop stops the components one by one in the reverse order of their registration,
+// so the component is stopped after the components which depend on it.
 func (a *App) stop() error {
 	ctx, cancel := context.WithTimeout(context.Background(), a.config.GracefulTimeout)
 	defer cancel()
 
-	g := errgroup.Group{}
-
-	for _, c := range a.components {
-		func(c component) {
-			g.Go(func() error {
-				return c.Stop(ctx)
-			})
-		}(c)
+	var result error
+	for i := len(a.components) - 1; i >= 0; i-- {
+		if err := a.components[i].Stop(ctx); err != nil {
+			a.logger.WithError(err).Warn("failed to stop component")
+			if result == nil {
+				result = err
+			}
+		}
 	}
 
-	return g.Wait()
+	return result
 }
@@ -8,6 +8,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/app"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
 	exchangeclient "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/clients/exchange"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/clients/telegram"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/grpc"
@@ -15,20 +16,26 @@ import (
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/metrics"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/cache"
 	exchangeservice "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/exchange"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/ratelimit"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/reportstatus"
 )
 
 type Config struct {
-	App            app.Config             `yaml:"app"`
-	Telegram       telegram.Config        `yaml:"telegram"`
-	ExchangeClient exchangeclient.Config  `yaml:"exchange_client"`
-	Currency       exchangeservice.Config `yaml:"currency"`
-	Database       DatabaseConfig         `yaml:"database"`
-	Redis          RedisConfig            `yaml:"redis"`
-	Kafka          KafkaConfig            `yaml:"kafka"`
-	Cache          cache.Config           `yaml:"cache"`
-	Http           http.Config            `yaml:"http"`
-	Grpc           grpc.Config            `yaml:"grpc"`
-	Metrics        metrics.Config         `yaml:"metrics"`
+	App            app.Config                 `yaml:"app"`
+	Telegram       telegram.Config            `yaml:"telegram"`
+	Bot            bot.Config                 `yaml:"bot"`
+	ExchangeClient exchangeclient.Config      `yaml:"exchange_client"`
+	Currency       exchangeservice.Config     `yaml:"currency"`
+	Database       DatabaseConfig             `yaml:"database"`
+	Redis          RedisConfig                `yaml:"redis"`
+	Kafka          KafkaConfig                `yaml:"kafka"`
+	Cache          cache.Config               `yaml:"cache"`
+	RateLimit      ratelimit.Config           `yaml:"rate_limit"`
+	ReportStatus   reportstatus.Config        `yaml:"report_status"`
+	ReportWatcher  reportstatus.WatcherConfig `yaml:"report_watcher"`
+	Http           http.Config                `yaml:"http"`
+	Grpc           grpc.Config                `yaml:"grpc"`
+	Metrics        metrics.Config             `yaml:"metrics"`
 
 	LogLevel zapcore.Level `yaml:"log_level"`
 }
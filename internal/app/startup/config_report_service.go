@@ -11,17 +11,25 @@ import (
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/clients/grpc"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/http"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/metrics"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/goalnudge"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/kafka"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/reportstatus"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/wastereport"
 )
 
 type ReportServiceConfig struct {
-	App      app.Config           `yaml:"app"`
-	Database DatabaseConfig       `yaml:"database"`
-	Kafka    KafkaConfig          `yaml:"kafka"`
-	Consumer kafka.ConsumerConfig `yaml:"consumer"`
-	Http     http.Config          `yaml:"http"`
-	Grpc     grpc.Config          `yaml:"grpc_client"`
-	Metrics  metrics.Config       `yaml:"metrics"`
+	App          app.Config           `yaml:"app"`
+	Database     DatabaseConfig       `yaml:"database"`
+	Redis        RedisConfig          `yaml:"redis"`
+	Kafka        KafkaConfig          `yaml:"kafka"`
+	DeadLetter   KafkaConfig          `yaml:"dead_letter"`
+	Consumer     kafka.ConsumerConfig `yaml:"consumer"`
+	Report       wastereport.Config   `yaml:"report"`
+	ReportStatus reportstatus.Config  `yaml:"report_status"`
+	GoalNudge    goalnudge.Config     `yaml:"goal_nudge"`
+	Http         http.Config          `yaml:"http"`
+	Grpc         grpc.Config          `yaml:"grpc_client"`
+	Metrics      metrics.Config       `yaml:"metrics"`
 
 	LogLevel zapcore.Level `yaml:"log_level"`
 }
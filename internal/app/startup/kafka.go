@@ -1,26 +1,93 @@
 package startup
 
-import "github.com/segmentio/kafka-go"
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/segmentio/kafka-go"
+)
 
 type KafkaConfig struct {
 	Brockers []string `yaml:"brockers"`
 	Topic    string   `yaml:"topic"`
+
+	// Partitions and ReplicationFactor are used to create the topic if it does not exist.
+	Partitions        int `yaml:"partitions"`
+	ReplicationFactor int `yaml:"replication_factor"`
+
+	// GroupID is a consumer group of the reader, the partitions are balanced between
+	// all readers with the same group. Partition is used only if GroupID is empty.
+	GroupID   string `yaml:"group_id"`
+	Partition int    `yaml:"partition"`
 }
 
 func NewKafkaProducer(config KafkaConfig) *kafka.Writer {
 	return &kafka.Writer{
 		Addr:     kafka.TCP(config.Brockers...),
 		Topic:    config.Topic,
-		Balancer: &kafka.LeastBytes{},
+		Balancer: &kafka.Hash{},
 	}
 }
 
 func NewKafkaConsumer(config KafkaConfig) *kafka.Reader {
-	return kafka.NewReader(kafka.ReaderConfig{
-		Brokers:   config.Brockers,
-		Topic:     config.Topic,
-		Partition: 0,
-		MinBytes:  10e3,
-		MaxBytes:  10e6,
+	readerConfig := kafka.ReaderConfig{
+		Brokers:  config.Brockers,
+		Topic:    config.Topic,
+		GroupID:  config.GroupID,
+		MinBytes: 10e3,
+		MaxBytes: 10e6,
+	}
+
+	if config.GroupID == "" {
+		readerConfig.Partition = config.Partition
+	}
+
+	return kafka.NewReader(readerConfig)
+}
+
+// CreateKafkaTopic creates the topic with configured amount of partitions,
+// does nothing if the topic already exists or the amount of partitions is not set.
+func CreateKafkaTopic(config KafkaConfig) error {
+	if config.Partitions <= 0 {
+		return nil
+	}
+
+	if len(config.Brockers) == 0 {
+		return errors.New("kafka brockers are not set")
+	}
+
+	conn, err := kafka.Dial("tcp", config.Brockers[0])
+	if err != nil {
+		return fmt.Errorf("failed to connect to kafka: %w", err)
+	}
+	defer conn.Close()
+
+	controller, err := conn.Controller()
+	if err != nil {
+		return fmt.Errorf("failed to get kafka controller: %w", err)
+	}
+
+	controllerConn, err := kafka.Dial("tcp", net.JoinHostPort(controller.Host, strconv.Itoa(controller.Port)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to kafka controller: %w", err)
+	}
+	defer controllerConn.Close()
+
+	replicationFactor := config.ReplicationFactor
+	if replicationFactor <= 0 {
+		replicationFactor = 1
+	}
+
+	err = controllerConn.CreateTopics(kafka.TopicConfig{
+		Topic:             config.Topic,
+		NumPartitions:     config.Partitions,
+		ReplicationFactor: replicationFactor,
 	})
+	if err != nil && !errors.Is(err, kafka.TopicAlreadyExists) {
+		return fmt.Errorf("failed to create topic %s: %w", config.Topic, err)
+	}
+
+	return nil
 }
@@ -2,19 +2,28 @@ package bot
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
 )
 
-const messageInternalError = "Внутренняя ошибка"
+// dropReplyTimeout limits sending the reply to the dropped message, so it does not stop receiving updates.
+const dropReplyTimeout = 5 * time.Second
 
 //go:generate mockery --name=telegramClient --dir . --output ./mocks --exported
 type telegramClient interface {
 	SendMessage(ctx context.Context, userID int64, text string) error
 	SendMessageWithoutRemovingKeyboard(ctx context.Context, userID int64, text string) error
 	SendKeyboard(ctx context.Context, userID int64, text string, rows [][]string) error
+	SendFormattedMessage(ctx context.Context, message *models.OutgoingMessage) (int, error)
+	EditMessage(ctx context.Context, messageID int, message *models.OutgoingMessage) error
+	AnswerCallback(ctx context.Context, callbackID string) error
 	GetUpdatesChan() <-chan *models.Message
+	MarkHandled(message *models.Message)
 }
 
 //go:generate mockery --name=iterationMessage --dir . --output ./mocks --exported
@@ -23,9 +32,13 @@ type iterationMessage interface {
 }
 
 // MessageResponse is a result of working bot handlers.
+//
+// The response with InlineKeyboard replaces the message with the pressed button
+// if it is the response to the button, otherwise it is sent as the new message.
 type MessageResponse struct {
 	Message             string
 	Keyboard            [][]string
+	InlineKeyboard      [][]models.KeyboardButton
 	DoNotRemoveKeyboard bool
 }
 
@@ -33,24 +46,44 @@ type MessageResponse struct {
 type MessageHandler func(ctx context.Context, message *models.Message) (*MessageResponse, error)
 
 // Bot is a router which choose which handler to run and run middlewares before the handlers.
+//
+// Messages are handled concurrently by the pool of workers, messages of one user
+// are always handled by the same worker to keep their order.
+//
+// On stopping the bot handles all messages until the channel of updates is closed
+// by the telegram client, so the client must be stopped before the bot.
 type Bot struct {
 	tgClient         telegramClient
 	iterationMessage iterationMessage
 	handlers         map[string]MessageHandler
+	pool             *workerPool
+	formatter        *format.Formatter
 
 	logger log.Logger
 	cancel context.CancelFunc
 	done   chan struct{}
 }
 
-func New(tg telegramClient, iterationMessage iterationMessage, logger log.Logger, handlers map[string]MessageHandler) *Bot {
-	return &Bot{
+func New(
+	config Config,
+	tg telegramClient,
+	iterationMessage iterationMessage,
+	poolMetrics poolMetrics,
+	formatter *format.Formatter,
+	logger log.Logger,
+	handlers map[string]MessageHandler,
+) *Bot {
+	b := &Bot{
 		tgClient:         tg,
 		iterationMessage: iterationMessage,
 		handlers:         handlers,
+		formatter:        formatter,
 
 		logger: logger.With(log.ComponentKey, "Bot"),
 	}
+	b.pool = newWorkerPool(config, poolMetrics, b.handle)
+
+	return b
 }
 
 func (b *Bot) Start() error {
@@ -59,39 +92,75 @@ func (b *Bot) Start() error {
 	b.cancel = cancel
 	b.done = make(chan struct{})
 
-	go b.run(ctx)
+	b.pool.start(ctx)
+	go b.run()
 
 	return nil
 }
 
+// Stop waits until the buffered and in-flight messages are handled,
+// the handlers are canceled if they are not finished before the context.
 func (b *Bot) Stop(ctx context.Context) error {
-	b.cancel()
+	b.logger.Info("bot is draining messages")
 
 	select {
 	case <-b.done:
+		b.cancel()
 		return nil
 	case <-ctx.Done():
+		b.cancel()
 		return ctx.Err()
 	}
 }
 
-func (b *Bot) run(ctx context.Context) {
-	for {
-		select {
-		case message := <-b.tgClient.GetUpdatesChan():
-			handler, ok := b.handlers[message.Text]
-			if !ok {
-				handler = b.handlers["default"]
-			}
-			b.iterationMessage.Iterate(ctx, message, handler, b.logger)
-
-		case <-ctx.Done():
-			b.logger.WithError(ctx.Err()).Info("bot has been stopped")
-			close(b.done)
-
-			return
+func (b *Bot) run() {
+	for message := range b.tgClient.GetUpdatesChan() {
+		if !b.pool.submit(message) {
+			b.logger.
+				With("message", message).
+				Warn("queue of the user is full, the message has been dropped")
+			b.replyDropped(message)
+			b.tgClient.MarkHandled(message)
+		}
+	}
+
+	b.pool.close()
+	b.pool.wait()
+
+	b.logger.Info("bot has been stopped")
+	close(b.done)
+}
+
+// replyDropped asks the user to send the dropped message again.
+func (b *Bot) replyDropped(message *models.Message) {
+	ctx, cancel := context.WithTimeout(context.Background(), dropReplyTimeout)
+	defer cancel()
+
+	localizer := i18n.New(message.From.GetLanguage())
+	err := b.tgClient.SendMessageWithoutRemovingKeyboard(ctx, message.From.ID, b.formatter.Text(localizer.Get(i18n.KeyBusy)))
+	if err != nil {
+		b.logger.WithError(err).
+			Warn("failed to reply to the dropped message")
+	}
+}
+
+func (b *Bot) handle(ctx context.Context, message *models.Message) {
+	handler, ok := b.handlers[message.Text]
+	if !ok {
+		handler = b.handlers["default"]
+
+		// the command can be followed by arguments, like "/convert 50 USD EUR"
+		command, _, hasArguments := strings.Cut(message.Text, " ")
+		if commandHandler, ok := b.handlers[command]; ok && hasArguments && strings.HasPrefix(command, "/") {
+			handler = commandHandler
 		}
 	}
+	b.iterationMessage.Iterate(ctx, message, handler, b.logger)
+
+	// the message interrupted by canceling is received again after restart
+	if ctx.Err() == nil {
+		b.tgClient.MarkHandled(message)
+	}
 }
 
 // UseMiddleware adds a function which will be runned before all previous added middlewares
@@ -2,31 +2,34 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/amount"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	exchangeservice "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/exchange"
 )
 
-const warningLimitCoeff = 0.9
+// the user is warned if the sum of wastes is more than warningLimitPercent of the limit
+const warningLimitPercent = 90
 
 const userDateLayout = "02.01.2006"
 
-const (
-	messageAddResponse = `Для добавления траты введите сообщение в формате:
+// claimMarker in the note marks the waste as reimbursable, it is not saved to the note
+const claimMarker = "!claim"
 
-<Название категории>
-<Сумма траты>
-<Дата траты в формате DD.MM.YYYY> (необязательно)`
-
-	messageSuccessfulAddWaste = "Трата успешно добавлена"
-	messageWarningLimit       = "До превышения лимита за текущий месяц осталось:"
-	messageLimitExceeded      = "Лимит на текущий месяц превышен на"
-)
+// addDetails are the optional details of the added waste.
+type addDetails struct {
+	date      time.Time
+	note      string
+	tags      []string
+	claimable bool
+}
 
 func (h *MessageHandlers) addHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
 	err := h.userContextService.SetContext(ctx, message.From.ID, enums.AddWaste)
@@ -35,7 +38,7 @@ func (h *MessageHandlers) addHandler(ctx context.Context, message *models.Messag
 	}
 
 	return &bot.MessageResponse{
-		Message: messageAddResponse,
+		Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyAddResponse)),
 	}, nil
 }
 
@@ -43,35 +46,70 @@ func (h *MessageHandlers) addWaste(ctx context.Context, message *models.Message)
 	text := message.Text
 	lines := strings.Split(text, "\n")
 
-	if len(lines) < 2 || len(lines) > 3 {
+	if len(lines) < 2 || len(lines) > 4 {
 		return &bot.MessageResponse{
-			Message: messageIncorrectFormat,
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectFormat)),
 		}, nil
 	}
 
-	cost, err := strconv.ParseFloat(lines[1], 64)
-	if err != nil {
+	cost, code, err := amount.Parse(lines[1])
+	if err != nil || cost <= 0 {
 		return &bot.MessageResponse{
-			Message: messageIncorrectFormat,
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectFormat)),
 		}, nil
 	}
 
-	date := message.Date
-	if len(lines) == 3 {
-		date, err = time.Parse(userDateLayout, lines[2])
-		if err != nil {
+	details, ok := parseWasteDetails(lines[2:], message.Date)
+	if !ok {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectFormat)),
+		}, nil
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchage and designation for user: %w", err)
+	}
+
+	// the waste written in another currency is converted by its rate, the entered amount is kept
+	costCurrency := currency
+	if code != "" && code != currency.code {
+		err = h.exchangeService.AddCurrency(ctx, code)
+		if errors.Is(err, exchangeservice.ErrCurrencyNotSupported) {
 			return &bot.MessageResponse{
-				Message: messageIncorrectFormat,
+				Message: h.formatter.Text(h.localizer(message).Getf(i18n.KeyRateNotAvailable, code)),
 			}, nil
 		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to add currency: %w", err)
+		}
+
+		costCurrency, err = h.getCurrency(code)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get exchange and designation of %s: %w", code, err)
+		}
 	}
 
-	exchange, designation, err := h.getExchangeOfUser(ctx, message.From.ID)
+	entered, err := enteredAmount(cost, costCurrency.code)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get exchage and designation for user: %w", err)
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectFormat)),
+		}, nil
+	}
+
+	defaultCost, err := h.toDefaultCurrency(entered.Amount, costCurrency)
+	if err != nil {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectFormat)),
+		}, nil
 	}
 
-	waste := models.NewWaste(lines[0], int64(cost/exchange*convertToMainCurrency), date)
+	waste := models.NewWaste(lines[0], defaultCost.Amount, details.date).
+		WithOriginal(entered.Amount, costCurrency.code).
+		WithNote(details.note, details.tags)
+	if details.claimable {
+		waste.WithClaimStatus(enums.ClaimStatusPending)
+	}
 	_, err = h.wasteRepo.AddWasteToUser(ctx, message.From.ID, waste)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add waste: %w", err)
@@ -82,7 +120,8 @@ func (h *MessageHandlers) addWaste(ctx context.Context, message *models.Message)
 		return nil, fmt.Errorf("failed to set context for user: %w", err)
 	}
 
-	msg := messageSuccessfulAddWaste + "\n"
+	localizer := h.localizer(message)
+	msg := h.formatter.NewMessage().Text(localizer.Get(i18n.KeySuccessfulAddWaste)).Line()
 
 	sum, err := h.wasteRepo.SumOfWastesAfterDate(ctx, message.From.ID, getFirstDayOfMonth())
 	if err != nil {
@@ -95,23 +134,79 @@ func (h *MessageHandlers) addWaste(ctx context.Context, message *models.Message)
 	}
 
 	if limit != nil {
-		fsum := float64(sum)
-		flimit := float64(*limit)
-
-		if fsum > flimit {
-			diff := h.convertFromDefaultCurrency(uint64(sum)-(*limit), exchange)
-			msg += fmt.Sprintf("%s %.2f %s", messageLimitExceeded, diff, designation)
-		} else if fsum > warningLimitCoeff*flimit {
-			diff := h.convertFromDefaultCurrency((*limit)-uint64(sum), exchange)
-			msg += fmt.Sprintf("%s %.2f %s", messageWarningLimit, diff, designation)
+		left := int64(*limit) - sum
+
+		if left < 0 {
+			diff, err := h.fromDefaultCurrency(-left, currency)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert the exceeding of limit: %w", err)
+			}
+			msg.Text(localizer.Getf(i18n.KeyLimitExceeded, currency.format(diff)))
+		} else if sum*100 > int64(*limit)*warningLimitPercent {
+			diff, err := h.fromDefaultCurrency(left, currency)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert the rest of limit: %w", err)
+			}
+			msg.Text(localizer.Getf(i18n.KeyWarningLimit, currency.format(diff)))
 		}
 	}
 
+	staleAge := currency.staleAge
+	if costCurrency.staleAge > staleAge {
+		staleAge = costCurrency.staleAge
+	}
+
 	return &bot.MessageResponse{
-		Message: msg,
+		Message: addRatesWarning(msg, localizer, staleAge).String(),
 	}, nil
 }
 
+// parseWasteDetails parses the optional lines after the cost: the date and the note with tags,
+// the note with the claim marker marks the waste as reimbursable.
+func parseWasteDetails(lines []string, messageDate time.Time) (*addDetails, bool) {
+	details := &addDetails{
+		date: messageDate,
+		tags: make([]string, 0),
+	}
+	hasDate := false
+	words := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if parsed, err := time.Parse(userDateLayout, line); err == nil && !hasDate {
+			details.date = parsed
+			hasDate = true
+			continue
+		}
+
+		for _, word := range strings.Fields(line) {
+			if strings.EqualFold(word, claimMarker) {
+				details.claimable = true
+				continue
+			}
+
+			if !strings.HasPrefix(word, "#") {
+				words = append(words, word)
+				continue
+			}
+
+			tag, ok := parseTag(word)
+			if !ok {
+				return nil, false
+			}
+			if !seen[tag] {
+				seen[tag] = true
+				details.tags = append(details.tags, tag)
+			}
+		}
+	}
+
+	details.note = strings.Join(words, " ")
+
+	return details, true
+}
+
 func getFirstDayOfMonth() time.Time {
 	now := time.Now()
 	currentYear, currentMonth, _ := now.Date()
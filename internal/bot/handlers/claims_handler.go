@@ -0,0 +1,308 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/requests"
+)
+
+// claimsListSize is the amount of the latest wastes shown for each status
+const claimsListSize = 5
+
+const (
+	claimsClaimedArgument    = "claimed"
+	claimsReimbursedArgument = "reimbursed"
+	claimsReportArgument     = "report"
+)
+
+// claimsSection is the outstanding status shown by the claims command,
+// the argument moves the waste to the next status by the button.
+type claimsSection struct {
+	status   enums.ClaimStatus
+	header   i18n.Key
+	argument string
+	button   i18n.Key
+}
+
+var claimsSections = []claimsSection{
+	{
+		status:   enums.ClaimStatusPending,
+		header:   i18n.KeyClaimsPending,
+		argument: claimsClaimedArgument,
+		button:   i18n.KeyClaimsMarkClaimed,
+	},
+	{
+		status:   enums.ClaimStatusClaimed,
+		header:   i18n.KeyClaimsClaimed,
+		argument: claimsReimbursedArgument,
+		button:   i18n.KeyClaimsMarkReimbursed,
+	},
+}
+
+// claimsHandler shows the outstanding reimbursable wastes of the user.
+//
+// "/claims claimed <id>" marks the pending waste as claimed, "/claims reimbursed <id>" marks the claimed waste
+// as reimbursed, they are sent by the inline buttons of the listed wastes. "/claims report from:01.03.2024 to:31.03.2024"
+// requests the document with the reimbursable wastes for the period.
+func (h *MessageHandlers) claimsHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+
+	fields := strings.Fields(message.Text)[1:]
+	if len(fields) == 0 {
+		return h.listClaims(ctx, message, "")
+	}
+
+	switch strings.ToLower(fields[0]) {
+	case claimsClaimedArgument:
+		return h.changeClaimStatus(ctx, message, fields[1:],
+			enums.ClaimStatusPending, enums.ClaimStatusClaimed, i18n.KeyClaimsMarkedClaimed)
+	case claimsReimbursedArgument:
+		return h.changeClaimStatus(ctx, message, fields[1:],
+			enums.ClaimStatusClaimed, enums.ClaimStatusReimbursed, i18n.KeyClaimsMarkedReimbursed)
+	case claimsReportArgument:
+		return h.requestClaimReport(ctx, message, fields[1:])
+	default:
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Get(i18n.KeyClaimsUsage)),
+		}, nil
+	}
+}
+
+// changeClaimStatus moves the waste with the identifier from the argument to the next status,
+// the waste already moved by the previous press of the button is not changed.
+func (h *MessageHandlers) changeClaimStatus(
+	ctx context.Context,
+	message *models.Message,
+	arguments []string,
+	from enums.ClaimStatus,
+	to enums.ClaimStatus,
+	result i18n.Key,
+) (*bot.MessageResponse, error) {
+	usage := &bot.MessageResponse{
+		Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyClaimsUsage)),
+	}
+	if len(arguments) != 1 {
+		return usage, nil
+	}
+
+	id, err := uuid.Parse(arguments[0])
+	if err != nil {
+		return usage, nil
+	}
+
+	changed, err := h.wasteRepo.UpdateClaimStatus(ctx, message.From.ID, id, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to change claim status from %s to %s: %w", from, to, err)
+	}
+
+	return h.listClaims(ctx, message, h.localizer(message).Getf(result, changed))
+}
+
+// claimsSummary is the sum and the latest wastes with the status.
+type claimsSummary struct {
+	section claimsSection
+	wastes  []*models.Waste
+	total   int
+	sum     int64
+}
+
+// listClaims returns the sums and the latest wastes of the outstanding statuses,
+// the note is shown before them, like the result of the changed status.
+func (h *MessageHandlers) listClaims(ctx context.Context, message *models.Message, note string) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange and designation of user: %w", err)
+	}
+
+	msg := h.formatter.NewMessage()
+	if note != "" {
+		msg.Italic(note).Line().Line()
+	}
+
+	summaries := make([]*claimsSummary, 0, len(claimsSections))
+	written := false
+	for _, section := range claimsSections {
+		summary, err := h.getClaimsSummary(ctx, message.From.ID, section)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, summary)
+		if summary.total == 0 {
+			continue
+		}
+
+		if written {
+			msg.Line().Line()
+		}
+		err = h.writeClaimsSummary(msg, localizer, currency, summary)
+		if err != nil {
+			return nil, err
+		}
+		written = true
+	}
+
+	if !written {
+		msg.Text(localizer.Get(i18n.KeyClaimsNothing))
+	}
+	msg.Line().Line().Italic(localizer.Get(i18n.KeyClaimsReportHint))
+
+	return &bot.MessageResponse{
+		Message:        addRatesWarning(msg, localizer, currency.staleAge).String(),
+		InlineKeyboard: claimsButtons(localizer, summaries),
+	}, nil
+}
+
+func (h *MessageHandlers) getClaimsSummary(ctx context.Context, userID int64, section claimsSection) (*claimsSummary, error) {
+	filter := models.WasteFilter{
+		ClaimStatuses: []enums.ClaimStatus{section.status},
+		Limit:         claimsListSize,
+	}
+
+	wastes, total, err := h.wasteRepo.ListWastes(ctx, userID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s wastes: %w", section.status, err)
+	}
+
+	summary := &claimsSummary{
+		section: section,
+		wastes:  wastes,
+		total:   total,
+	}
+	if total == 0 {
+		return summary, nil
+	}
+
+	summary.sum, err = h.wasteRepo.SumOfFilteredWastes(ctx, userID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sum of %s wastes: %w", section.status, err)
+	}
+
+	return summary, nil
+}
+
+// writeClaimsSummary writes the sum and the latest wastes in the currency of the user.
+func (h *MessageHandlers) writeClaimsSummary(
+	msg *format.Message,
+	localizer *i18n.Localizer,
+	currency *userCurrency,
+	summary *claimsSummary,
+) error {
+	sum, err := h.fromDefaultCurrency(summary.sum, currency)
+	if err != nil {
+		return fmt.Errorf("failed to convert sum of %s wastes: %w", summary.section.status, err)
+	}
+
+	msg.Bold(localizer.Getf(summary.section.header, summary.total, currency.format(sum)))
+	for _, waste := range summary.wastes {
+		cost, err := h.fromDefaultCurrency(waste.Cost, currency)
+		if err != nil {
+			return fmt.Errorf("failed to convert cost of waste: %w", err)
+		}
+
+		msg.Line().Textf("%s %s — %s", waste.Date.Format(userDateLayout), waste.Category, currency.format(cost))
+		if details := wasteDetails(waste); details != "" {
+			msg.Text(" ").Italic(details)
+		}
+	}
+	if summary.total > len(summary.wastes) {
+		msg.Line().Italic(localizer.Getf(i18n.KeyClaimsMore, summary.total-len(summary.wastes)))
+	}
+
+	return nil
+}
+
+// claimsButtons returns the buttons to move the listed wastes to the next status, nil if there are no wastes.
+// The buttons carry the identifiers of the wastes, so only the wastes seen by the user are moved.
+func claimsButtons(localizer *i18n.Localizer, summaries []*claimsSummary) [][]models.KeyboardButton {
+	var buttons [][]models.KeyboardButton
+	for _, summary := range summaries {
+		for _, waste := range summary.wastes {
+			buttons = append(buttons, []models.KeyboardButton{{
+				Text: localizer.Getf(summary.section.button, waste.Date.Format(userDateLayout), waste.Category),
+				CallbackData: fmt.Sprintf("%s %s %s",
+					enums.CommandTypeClaims, summary.section.argument, waste.ID),
+			}})
+		}
+	}
+
+	return buttons
+}
+
+// requestClaimReport requests the document with the reimbursable wastes,
+// the period starts from the date after "from:" and ends with the date after "to:" or today.
+func (h *MessageHandlers) requestClaimReport(ctx context.Context, message *models.Message, arguments []string) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+	usage := &bot.MessageResponse{
+		Message: h.formatter.Text(localizer.Get(i18n.KeyClaimsUsage)),
+	}
+
+	var from, to time.Time
+	for _, argument := range arguments {
+		var err error
+		lower := strings.ToLower(argument)
+		switch {
+		case strings.HasPrefix(lower, findFromPrefix) && from.IsZero():
+			from, _, err = parseFindDate(argument[len(findFromPrefix):])
+		case strings.HasPrefix(lower, findToPrefix) && to.IsZero():
+			_, to, err = parseFindDate(argument[len(findToPrefix):])
+		default:
+			return usage, nil
+		}
+
+		if err != nil {
+			return usage, nil
+		}
+	}
+
+	if from.IsZero() {
+		return usage, nil
+	}
+	if to.IsZero() {
+		year, month, day := message.Date.Date()
+		to = time.Date(year, month, day, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	if to.Before(from) {
+		return usage, nil
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchage and designation for the user: %w", err)
+	}
+
+	err = h.requestReport(ctx, message, &requests.GetReport{
+		UserID:              message.From.ID,
+		Period:              requests.PeriodClaims,
+		From:                from,
+		To:                  to,
+		CurrencyExchange:    currency.rate.Float64(),
+		CurrencyRate:        currency.rate.String(),
+		Currency:            currency.code,
+		CurrencyDesignation: currency.designation,
+		BaseCurrency:        h.exchangeService.GetDefaultCurrency(),
+		NotCached:           true,
+		Language:            message.From.GetLanguage(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg := h.formatter.NewMessage().Text(localizer.Get(i18n.KeyGeneratingReport))
+
+	return &bot.MessageResponse{
+		Message: addRatesWarning(msg, localizer, currency.staleAge).String(),
+	}, nil
+}
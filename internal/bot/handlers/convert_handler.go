@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/amount"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+	exchangeservice "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/exchange"
+)
+
+// convertHandler converts the amount of the arguments, like "/convert 50 USD EUR",
+// without arguments it asks the user to send them in the next message.
+func (h *MessageHandlers) convertHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	arguments := strings.TrimSpace(strings.TrimPrefix(message.Text, string(enums.CommandTypeConvert)))
+	if arguments != "" {
+		resp, _, err := h.convertAmount(ctx, message, arguments)
+		return resp, err
+	}
+
+	err := h.userContextService.SetContext(ctx, message.From.ID, enums.ConvertCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set user context: %w", err)
+	}
+
+	return &bot.MessageResponse{
+		Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyConvertResponse)),
+	}, nil
+}
+
+func (h *MessageHandlers) convert(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	resp, converted, err := h.convertAmount(ctx, message, message.Text)
+	if err != nil || !converted {
+		return resp, err
+	}
+
+	err = h.userContextService.SetContext(ctx, message.From.ID, enums.NoContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set context for user: %w", err)
+	}
+
+	return resp, nil
+}
+
+// convertAmount converts the amount from the first currency to the second one,
+// the currency chosen by the user is used if the second currency is not set.
+// It reports whether the amount is converted or the user is asked to fix the input.
+func (h *MessageHandlers) convertAmount(
+	ctx context.Context,
+	message *models.Message,
+	text string,
+) (*bot.MessageResponse, bool, error) {
+	localizer := h.localizer(message)
+	incorrectInput := func(text string) *bot.MessageResponse {
+		return &bot.MessageResponse{
+			Message:             h.formatter.Text(text),
+			DoNotRemoveKeyboard: true,
+		}
+	}
+
+	fields := strings.Fields(text)
+	codes := make([]string, 0, 2)
+	for len(fields) > 1 && len(codes) < 2 && isCurrencyCode(fields[len(fields)-1]) {
+		codes = append([]string{fields[len(fields)-1]}, codes...)
+		fields = fields[:len(fields)-1]
+	}
+
+	// the amount can not contain more currencies, like "50 USD EUR RUB"
+	if len(codes) == 0 || len(fields) > 0 && isCurrencyCode(fields[len(fields)-1]) {
+		return incorrectInput(localizer.Get(i18n.KeyConvertResponse)), false, nil
+	}
+
+	if len(codes) == 1 {
+		userCurrency, err := h.userContextService.GetCurrency(ctx, message.From.ID)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get user currency: %w", err)
+		}
+		codes = append(codes, userCurrency)
+	}
+
+	// the currency written with the amount, like "$50 EUR", must be the currency converted from
+	value, valueCurrency, err := amount.Parse(strings.Join(fields, " "))
+	if err != nil || value <= 0 || valueCurrency != "" && !strings.EqualFold(valueCurrency, codes[0]) {
+		return incorrectInput(localizer.Get(i18n.KeyConvertResponse)), false, nil
+	}
+
+	currencies := make([]money.Currency, len(codes))
+	rates := make([]money.Rate, len(codes))
+	for i, code := range codes {
+		currency, ok := money.LookupCurrency(code)
+		if !ok {
+			return incorrectInput(localizer.Getf(i18n.KeyUnknownCurrency, strings.ToUpper(code))), false, nil
+		}
+
+		err := h.exchangeService.AddCurrency(ctx, currency.Code)
+		if errors.Is(err, exchangeservice.ErrCurrencyNotSupported) {
+			return incorrectInput(localizer.Getf(i18n.KeyRateNotAvailable, currency.Code)), false, nil
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to add currency: %w", err)
+		}
+
+		rate, err := h.exchangeService.GetExchange(currency.Code)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get exchange: %w", err)
+		}
+
+		currencies[i] = currency
+		rates[i] = rate
+	}
+
+	from, to := currencies[0], currencies[1]
+
+	crossRate, err := money.CrossRate(rates[0], rates[1])
+	if err != nil {
+		return incorrectInput(localizer.Getf(i18n.KeyRateNotAvailable, to.Code)), false, nil
+	}
+
+	entered, err := enteredAmount(value, from.Code)
+	if err != nil {
+		return incorrectInput(localizer.Get(i18n.KeyIncorrectFormat)), false, nil
+	}
+
+	converted, err := entered.Exchange(crossRate, to.Code, money.RoundHalfEven)
+	if err != nil {
+		return incorrectInput(localizer.Get(i18n.KeyIncorrectFormat)), false, nil
+	}
+
+	msg := h.formatter.NewMessage().
+		Bold(fmt.Sprintf("%s %s = %s %s",
+			entered.Decimal(), from.Symbol, converted.Decimal(), to.Symbol)).Line().
+		Textf("1 %s = %s %s", from.Code, crossRate.String(), to.Code)
+
+	return &bot.MessageResponse{
+		Message: addRatesWarning(msg, localizer, h.staleRatesAge()).String(),
+	}, true, nil
+}
+
+// isCurrencyCode checks that the word looks like the code of ISO 4217, like "USD" or "usd".
+func isCurrencyCode(word string) bool {
+	if len(word) != 3 {
+		return false
+	}
+
+	for _, r := range word {
+		if !unicode.IsLetter(r) || r > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
+}
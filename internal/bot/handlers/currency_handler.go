@@ -2,16 +2,15 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
-)
-
-const (
-	messageChooseCurrency           = "Выберите валюту из предложенных на клавиатуре"
-	messageSuccessfulChangeCurrency = "Валюта успешно изменена на "
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+	exchangeservice "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/exchange"
 )
 
 func (h *MessageHandlers) currencyHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
@@ -20,41 +19,112 @@ func (h *MessageHandlers) currencyHandler(ctx context.Context, message *models.M
 		return nil, fmt.Errorf("failed to set context for user: %w", err)
 	}
 
-	defaultCurrency := h.exchangeService.GetDefaultCurrency()
-	usedCurrencies := h.exchangeService.GetUsedCurrencies()
+	currencies, err := h.getOfferedCurrencies(ctx, message.From.ID)
+	if err != nil {
+		return nil, err
+	}
 
-	usedCurrenciesKeyboardButtons := make([][]string, len(usedCurrencies)+1)
-	usedCurrenciesKeyboardButtons[0] = []string{defaultCurrency}
-	for i := range usedCurrencies {
-		usedCurrenciesKeyboardButtons[i+1] = []string{usedCurrencies[i]}
+	currenciesKeyboardButtons := make([][]string, len(currencies))
+	for i := range currencies {
+		currenciesKeyboardButtons[i] = []string{currencies[i]}
 	}
 
 	return &bot.MessageResponse{
-		Message:  messageChooseCurrency,
-		Keyboard: usedCurrenciesKeyboardButtons,
+		Message:  h.formatter.Text(h.localizer(message).Get(i18n.KeyChooseCurrency)),
+		Keyboard: currenciesKeyboardButtons,
 	}, nil
 }
 
 func (h *MessageHandlers) changeCurrency(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
-	_, err := h.exchangeService.GetExchange(message.Text)
-	if err != nil {
+	localizer := h.localizer(message)
+
+	currency, ok := money.LookupCurrency(message.Text)
+	if !ok {
+		return &bot.MessageResponse{
+			Message:             h.formatter.Text(localizer.Get(i18n.KeyChooseCurrency)),
+			DoNotRemoveKeyboard: true,
+		}, nil
+	}
+
+	err := h.exchangeService.AddCurrency(ctx, currency.Code)
+	if errors.Is(err, exchangeservice.ErrCurrencyNotSupported) {
 		return &bot.MessageResponse{
-			Message:             messageChooseCurrency,
+			Message:             h.formatter.Text(localizer.Getf(i18n.KeyCurrencyNotSupported, currency.Code)),
 			DoNotRemoveKeyboard: true,
 		}, nil
 	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to add currency: %w", err)
+	}
+
+	err = h.addCurrencyToUser(ctx, message.From.ID, currency.Code)
+	if err != nil {
+		return nil, err
+	}
 
 	err = h.userContextService.SetContext(ctx, message.From.ID, enums.NoContext)
 	if err != nil {
 		return nil, fmt.Errorf("failed to set context for user: %w", err)
 	}
 
-	err = h.userContextService.SetCurrency(ctx, message.From.ID, message.Text)
+	err = h.userContextService.SetCurrency(ctx, message.From.ID, currency.Code)
 	if err != nil {
 		return nil, fmt.Errorf("failed to set currency for user: %w", err)
 	}
 
 	return &bot.MessageResponse{
-		Message: messageSuccessfulChangeCurrency + message.Text,
+		Message: h.formatter.Text(localizer.Getf(i18n.KeySuccessfulChangeCurrency, currency.Code)),
 	}, nil
 }
+
+// getOfferedCurrencies returns the default currency, the currencies offered to all users
+// and the currencies added by the user.
+func (h *MessageHandlers) getOfferedCurrencies(ctx context.Context, userID int64) ([]string, error) {
+	added, err := h.userRepo.GetCurrencies(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get currencies of user: %w", err)
+	}
+
+	currencies := append([]string{h.exchangeService.GetDefaultCurrency()}, h.exchangeService.GetUsedCurrencies()...)
+	for _, currency := range added {
+		if !containsCurrency(currencies, currency) {
+			currencies = append(currencies, currency)
+		}
+	}
+
+	return currencies, nil
+}
+
+// addCurrencyToUser adds the currency to the list of the user if it is not offered yet.
+func (h *MessageHandlers) addCurrencyToUser(ctx context.Context, userID int64, currency string) error {
+	if currency == h.exchangeService.GetDefaultCurrency() ||
+		containsCurrency(h.exchangeService.GetUsedCurrencies(), currency) {
+		return nil
+	}
+
+	added, err := h.userRepo.GetCurrencies(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get currencies of user: %w", err)
+	}
+
+	if containsCurrency(added, currency) {
+		return nil
+	}
+
+	err = h.userRepo.SetCurrencies(ctx, userID, append(added, currency))
+	if err != nil {
+		return fmt.Errorf("failed to set currencies of user: %w", err)
+	}
+
+	return nil
+}
+
+func containsCurrency(currencies []string, currency string) bool {
+	for _, c := range currencies {
+		if c == currency {
+			return true
+		}
+	}
+
+	return false
+}
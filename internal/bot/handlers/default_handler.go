@@ -5,24 +5,11 @@ import (
 	"fmt"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
-const (
-	messageHelp = `**Данный бот предназначен для ведения трат по категориям**
-
-/add - для добавления новой траты
-/setLimit - установить лимит на месяц
-/getLimit - узнать текущий лимит на месяц
-/week - отчет по тратам за последнюю неделю
-/month - отчет по тратам за последний месяц
-/year - отчет по тратам за последний год
-/currency - сменить валюту`
-
-	messageIncorrectContext = "Неизвестное состояние пользователя, состояние сброшено до стандартного"
-)
-
 func (h *MessageHandlers) defaultHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
 	userContext, err := h.userContextService.GetContext(ctx, message.From.ID)
 	if err != nil {
@@ -31,8 +18,14 @@ func (h *MessageHandlers) defaultHandler(ctx context.Context, message *models.Me
 
 	switch userContext {
 	case enums.NoContext:
+		localizer := h.localizer(message)
+
 		return &bot.MessageResponse{
-			Message: messageHelp,
+			Message: h.formatter.NewMessage().
+				Bold(localizer.Get(i18n.KeyHelpTitle)).Line().
+				Line().
+				Text(localizer.Get(i18n.KeyHelpCommands)).
+				String(),
 		}, nil
 
 	case enums.AddWaste:
@@ -44,13 +37,22 @@ func (h *MessageHandlers) defaultHandler(ctx context.Context, message *models.Me
 	case enums.SetLimit:
 		return h.setLimit(ctx, message)
 
+	case enums.ChangeLanguage:
+		return h.changeLanguage(ctx, message)
+
+	case enums.ConvertCurrency:
+		return h.convert(ctx, message)
+
+	case enums.SplitWaste:
+		return h.splitWaste(ctx, message)
+
 	default:
 		err := h.userContextService.SetContext(ctx, message.From.ID, enums.NoContext)
 		if err != nil {
 			return nil, fmt.Errorf("failed to set user context: %w", err)
 		}
 		return &bot.MessageResponse{
-			Message: messageIncorrectContext,
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectContext)),
 		}, nil
 	}
 }
@@ -0,0 +1,339 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/amount"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+const findPageSize = 10
+
+const (
+	findCategoryPrefix = "cat:"
+	findFromPrefix     = "from:"
+	findToPrefix       = "to:"
+	findMinPrefix      = "min:"
+	findMaxPrefix      = "max:"
+	findPagePrefix     = "page:"
+)
+
+const userMonthLayout = "01.2006"
+
+var errIncorrectQuery = errors.New("incorrect search query")
+
+// findQuery is the parsed search query, amounts are in the currency of the user.
+type findQuery struct {
+	from     time.Time
+	to       time.Time
+	category string
+	minCost  int64
+	maxCost  int64
+	// costCurrency is the code of the currency written with the amounts, empty if there is no currency
+	costCurrency string
+	text         string
+	tag          string
+	page         int
+	// onlyPage is true if the query has only the page, then the last query of the user is used
+	onlyPage bool
+}
+
+// findHandler searches the wastes of the user by the query, like "/find такси from:03.2024 to:03.2024".
+//
+// The query with only the page, like "/find page:2", shows the page of the last search,
+// it is sent by the inline buttons of the found wastes.
+func (h *MessageHandlers) findHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+	usage := &bot.MessageResponse{
+		Message: h.formatter.Text(localizer.Get(i18n.KeyFindUsage)),
+	}
+
+	text := strings.TrimSpace(strings.TrimPrefix(message.Text, string(enums.CommandTypeFind)))
+	if text == "" {
+		return usage, nil
+	}
+
+	query, err := parseFindQuery(text)
+	if err != nil {
+		return usage, nil
+	}
+
+	if query.onlyPage {
+		text, err = h.userContextService.GetSearchQuery(ctx, message.From.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get last search query: %w", err)
+		}
+
+		page := query.page
+		query, err = parseFindQuery(text)
+		if text == "" || err != nil {
+			return usage, nil
+		}
+		query.page = page
+	} else {
+		err = h.userContextService.SetSearchQuery(ctx, message.From.ID, withoutPage(text))
+		if err != nil {
+			return nil, fmt.Errorf("failed to save search query: %w", err)
+		}
+	}
+
+	return h.findWastes(ctx, message, query)
+}
+
+func (h *MessageHandlers) findWastes(ctx context.Context, message *models.Message, query *findQuery) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange and designation of user: %w", err)
+	}
+	if response := h.checkAmountCurrency(localizer, currency, query.costCurrency); response != nil {
+		return response, nil
+	}
+
+	filter := models.WasteFilter{
+		From:     query.from,
+		To:       query.to,
+		Category: query.category,
+		Text:     query.text,
+		Tag:      query.tag,
+		Limit:    findPageSize,
+		Offset:   (query.page - 1) * findPageSize,
+	}
+	if query.minCost > 0 {
+		enteredMin, err := enteredAmount(query.minCost, currency.code)
+		if err != nil {
+			return &bot.MessageResponse{
+				Message: h.formatter.Text(localizer.Get(i18n.KeyIncorrectFormat)),
+			}, nil
+		}
+
+		minCost, err := h.toDefaultCurrency(enteredMin.Amount, currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert minimal cost: %w", err)
+		}
+		filter.MinCost = minCost.Amount
+	}
+	if query.maxCost > 0 {
+		enteredMax, err := enteredAmount(query.maxCost, currency.code)
+		if err != nil {
+			return &bot.MessageResponse{
+				Message: h.formatter.Text(localizer.Get(i18n.KeyIncorrectFormat)),
+			}, nil
+		}
+
+		maxCost, err := h.toDefaultCurrency(enteredMax.Amount, currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert maximal cost: %w", err)
+		}
+		filter.MaxCost = maxCost.Amount
+	}
+
+	wastes, total, err := h.wasteRepo.ListWastes(ctx, message.From.ID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wastes: %w", err)
+	}
+
+	if total == 0 {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Get(i18n.KeyFindNothing)),
+		}, nil
+	}
+
+	pages := (total + findPageSize - 1) / findPageSize
+	if query.page > pages {
+		// the page after the end shows the last page
+		query.page = pages
+		filter.Offset = (pages - 1) * findPageSize
+
+		wastes, _, err = h.wasteRepo.ListWastes(ctx, message.From.ID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find wastes: %w", err)
+		}
+	}
+
+	sum, err := h.wasteRepo.SumOfFilteredWastes(ctx, message.From.ID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sum of found wastes: %w", err)
+	}
+
+	convertedSum, err := h.fromDefaultCurrency(sum, currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert sum of found wastes: %w", err)
+	}
+
+	msg := h.formatter.NewMessage().
+		Bold(localizer.Getf(i18n.KeyFindTotal, total, currency.format(convertedSum))).Line()
+	for _, waste := range wastes {
+		cost, err := h.fromDefaultCurrency(waste.Cost, currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert cost of waste: %w", err)
+		}
+
+		msg.Line().Textf("%s %s — %s", waste.Date.Format(userDateLayout), waste.Category, currency.format(cost))
+		if details := wasteDetails(waste); details != "" {
+			msg.Text(" ").Italic(details)
+		}
+	}
+
+	if pages > 1 {
+		msg.Line().Line().Italic(localizer.Getf(i18n.KeyFindPage, query.page, pages))
+	}
+
+	return &bot.MessageResponse{
+		Message:        addRatesWarning(msg, localizer, currency.staleAge).String(),
+		InlineKeyboard: findPageButtons(localizer, query.page, pages),
+	}, nil
+}
+
+// findPageButtons returns the buttons to the previous and the next pages, nil if there are no other pages.
+func findPageButtons(localizer *i18n.Localizer, page int, pages int) [][]models.KeyboardButton {
+	if pages <= 1 {
+		return nil
+	}
+
+	buttons := make([]models.KeyboardButton, 0, 2)
+	if page > 1 {
+		buttons = append(buttons, models.KeyboardButton{
+			Text:         localizer.Get(i18n.KeyFindPrevious),
+			CallbackData: findPageCommand(page - 1),
+		})
+	}
+	if page < pages {
+		buttons = append(buttons, models.KeyboardButton{
+			Text:         localizer.Get(i18n.KeyFindNext),
+			CallbackData: findPageCommand(page + 1),
+		})
+	}
+
+	return [][]models.KeyboardButton{buttons}
+}
+
+func findPageCommand(page int) string {
+	return fmt.Sprintf("%s %s%d", enums.CommandTypeFind, findPagePrefix, page)
+}
+
+// parseFindQuery parses the query, the words without prefixes are searched in the category and the note.
+// The dates are in the format DD.MM.YYYY or MM.YYYY for the whole month.
+func parseFindQuery(text string) (*findQuery, error) {
+	query := &findQuery{page: 1}
+
+	words := make([]string, 0)
+	hasPage := false
+	for _, field := range strings.Fields(text) {
+		lower := strings.ToLower(field)
+
+		var err error
+		switch {
+		case strings.HasPrefix(lower, findCategoryPrefix):
+			query.category = field[len(findCategoryPrefix):]
+			if query.category == "" {
+				return nil, errIncorrectQuery
+			}
+		case strings.HasPrefix(lower, findFromPrefix):
+			query.from, _, err = parseFindDate(field[len(findFromPrefix):])
+		case strings.HasPrefix(lower, findToPrefix):
+			_, query.to, err = parseFindDate(field[len(findToPrefix):])
+		case strings.HasPrefix(lower, findMinPrefix):
+			query.minCost, err = query.parseCost(field[len(findMinPrefix):])
+		case strings.HasPrefix(lower, findMaxPrefix):
+			query.maxCost, err = query.parseCost(field[len(findMaxPrefix):])
+		case strings.HasPrefix(field, "#"):
+			var ok bool
+			query.tag, ok = parseTag(field)
+			if !ok {
+				return nil, errIncorrectQuery
+			}
+		case strings.HasPrefix(lower, findPagePrefix):
+			query.page, err = strconv.Atoi(field[len(findPagePrefix):])
+			if err == nil && query.page < 1 {
+				err = errIncorrectQuery
+			}
+			hasPage = true
+		default:
+			words = append(words, field)
+		}
+
+		if err != nil {
+			return nil, errIncorrectQuery
+		}
+	}
+
+	if query.minCost > 0 && query.maxCost > 0 && query.minCost > query.maxCost {
+		return nil, errIncorrectQuery
+	}
+
+	query.text = strings.Join(words, " ")
+	query.onlyPage = hasPage && query.text == "" && query.category == "" && query.tag == "" &&
+		query.from.IsZero() && query.to.IsZero() && query.minCost == 0 && query.maxCost == 0
+
+	return query, nil
+}
+
+// parseFindDate returns the start and the end of the day or the month.
+func parseFindDate(text string) (time.Time, time.Time, error) {
+	if date, err := time.Parse(userDateLayout, text); err == nil {
+		return date, date.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+
+	month, err := time.Parse(userMonthLayout, text)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return month, month.AddDate(0, 1, 0).Add(-time.Nanosecond), nil
+}
+
+// parseCost parses the bound of the cost, the bounds can not have different currencies.
+func (q *findQuery) parseCost(text string) (int64, error) {
+	cost, currency, err := amount.Parse(text)
+	if err != nil {
+		return 0, err
+	}
+	if cost <= 0 {
+		return 0, errIncorrectQuery
+	}
+
+	if currency != "" {
+		if q.costCurrency != "" && q.costCurrency != currency {
+			return 0, errIncorrectQuery
+		}
+		q.costCurrency = currency
+	}
+
+	return cost, nil
+}
+
+// withoutPage removes the page from the query, so the saved query starts from the first page.
+func withoutPage(text string) string {
+	fields := strings.Fields(text)
+	result := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !strings.HasPrefix(strings.ToLower(field), findPagePrefix) {
+			result = append(result, field)
+		}
+	}
+
+	return strings.Join(result, " ")
+}
+
+// wasteDetails returns the note and the tags of the waste, like "обед #work".
+func wasteDetails(waste *models.Waste) string {
+	details := make([]string, 0, len(waste.Edges.Tags)+1)
+	if waste.Note != "" {
+		details = append(details, waste.Note)
+	}
+	for _, tag := range waste.TagNames() {
+		details = append(details, "#"+tag)
+	}
+
+	return strings.Join(details, " ")
+}
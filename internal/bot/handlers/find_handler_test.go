@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseFindQuery(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	endOf := func(start time.Time) time.Time {
+		return start.Add(-time.Nanosecond)
+	}
+
+	tests := []struct {
+		name    string
+		text    string
+		want    *findQuery
+		wantErr bool
+	}{
+		{
+			name: "words",
+			text: "такси  аэропорт",
+			want: &findQuery{text: "такси аэропорт", page: 1},
+		},
+		{
+			name: "category and tag",
+			text: "cat:Food #Trip",
+			want: &findQuery{category: "Food", tag: "trip", page: 1},
+		},
+		{
+			name: "prefixes ignore case",
+			text: "CAT:Food Min:100",
+			want: &findQuery{category: "Food", minCost: 10000, page: 1},
+		},
+		{
+			name: "days",
+			text: "from:01.03.2024 to:15.03.2024",
+			want: &findQuery{from: date(2024, 3, 1), to: endOf(date(2024, 3, 16)), page: 1},
+		},
+		{
+			name: "months",
+			text: "from:02.2024 to:02.2024",
+			want: &findQuery{from: date(2024, 2, 1), to: endOf(date(2024, 3, 1)), page: 1},
+		},
+		{
+			name: "amounts",
+			text: "min:100 max:1500,50",
+			want: &findQuery{minCost: 10000, maxCost: 150050, page: 1},
+		},
+		{
+			name: "page with filters",
+			text: "такси page:3",
+			want: &findQuery{text: "такси", page: 3},
+		},
+		{
+			name: "only page",
+			text: "page:2",
+			want: &findQuery{page: 2, onlyPage: true},
+		},
+		{name: "empty category", text: "cat:", wantErr: true},
+		{name: "wrong date", text: "from:31.02.2024", wantErr: true},
+		{name: "wrong month", text: "to:13.2024", wantErr: true},
+		{name: "zero amount", text: "min:0", wantErr: true},
+		{name: "wrong amount", text: "max:abc", wantErr: true},
+		{name: "min greater than max", text: "min:200 max:100", wantErr: true},
+		{name: "wrong tag", text: "#a-b", wantErr: true},
+		{name: "zero page", text: "page:0", wantErr: true},
+		{name: "wrong page", text: "page:next", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFindQuery(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFindQuery(%q) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseFindQuery(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithoutPage(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "такси page:2", want: "такси"},
+		{text: "PAGE:3 cat:Food", want: "cat:Food"},
+		{text: "такси", want: "такси"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := withoutPage(tt.text); got != tt.want {
+				t.Errorf("withoutPage(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
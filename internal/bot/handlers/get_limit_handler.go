@@ -5,14 +5,10 @@ import (
 	"fmt"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 )
 
-const (
-	messageGetLimit  = "Текущий лимит на месяц:"
-	messageNullLimit = "Лимит на месяц не установлен"
-)
-
 func (h *MessageHandlers) getLimitHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
 	limit, err := h.userRepo.GetWasteLimit(ctx, message.From.ID)
 	if err != nil {
@@ -21,17 +17,24 @@ func (h *MessageHandlers) getLimitHandler(ctx context.Context, message *models.M
 
 	if limit == nil {
 		return &bot.MessageResponse{
-			Message: messageNullLimit,
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyNullLimit)),
 		}, nil
 	}
 
-	exchange, designation, err := h.getExchangeOfUser(ctx, message.From.ID)
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get exchange and designation of user: %w", err)
 	}
 
+	userLimit, err := h.fromDefaultCurrency(int64(*limit), currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert the limit: %w", err)
+	}
+
+	localizer := h.localizer(message)
+	msg := h.formatter.NewMessage().Text(localizer.Getf(i18n.KeyGetLimit, currency.format(userLimit)))
+
 	return &bot.MessageResponse{
-		Message: fmt.Sprintf("%s %.2f %s",
-			messageGetLimit, h.convertFromDefaultCurrency(*limit, exchange), designation),
+		Message: addRatesWarning(msg, localizer, currency.staleAge).String(),
 	}, nil
 }
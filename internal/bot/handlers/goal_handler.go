@@ -0,0 +1,349 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/amount"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/repository"
+)
+
+const maxGoalNameLength = 64
+
+const (
+	goalNewArgument    = "new"
+	goalAddArgument    = "add"
+	goalIncomeArgument = "income"
+	goalDeleteArgument = "delete"
+)
+
+// goalHandler shows the savings goals of the user with their progress.
+//
+// "/goal new Отпуск 100000 01.06.2027" creates the goal, "/goal add Отпуск 5000" contributes to the goal,
+// "/goal income 80000" sets the monthly income and "/goal delete Отпуск" deletes the goal.
+// The amounts are in the currency of the user.
+func (h *MessageHandlers) goalHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	usage := &bot.MessageResponse{
+		Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyGoalUsage)),
+	}
+
+	fields := strings.Fields(message.Text)[1:]
+	if len(fields) == 0 {
+		return h.listGoals(ctx, message)
+	}
+
+	arguments := fields[1:]
+	switch strings.ToLower(fields[0]) {
+	case goalNewArgument:
+		if len(arguments) < 3 {
+			return usage, nil
+		}
+		return h.createGoal(ctx, message, strings.Join(arguments[:len(arguments)-2], " "),
+			arguments[len(arguments)-2], arguments[len(arguments)-1])
+	case goalAddArgument:
+		if len(arguments) < 2 {
+			return usage, nil
+		}
+		return h.contributeToGoal(ctx, message, strings.Join(arguments[:len(arguments)-1], " "), arguments[len(arguments)-1])
+	case goalIncomeArgument:
+		if len(arguments) != 1 {
+			return usage, nil
+		}
+		return h.setIncome(ctx, message, arguments[0])
+	case goalDeleteArgument:
+		if len(arguments) == 0 {
+			return usage, nil
+		}
+		return h.deleteGoal(ctx, message, strings.Join(arguments, " "))
+	default:
+		return usage, nil
+	}
+}
+
+func (h *MessageHandlers) createGoal(
+	ctx context.Context,
+	message *models.Message,
+	name string,
+	targetText string,
+	deadlineText string,
+) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+	incorrect := &bot.MessageResponse{
+		Message: h.formatter.Text(localizer.Get(i18n.KeyIncorrectFormat)),
+	}
+
+	target, targetCurrency, err := amount.Parse(targetText)
+	if err != nil || target <= 0 || utf8.RuneCountInString(name) > maxGoalNameLength {
+		return incorrect, nil
+	}
+
+	deadline, err := time.Parse(userDateLayout, deadlineText)
+	if err != nil {
+		return incorrect, nil
+	}
+	if !deadline.After(message.Date) {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Get(i18n.KeyGoalDeadlinePassed)),
+		}, nil
+	}
+
+	_, err = h.goalRepo.GetGoalByName(ctx, message.From.ID, name)
+	if err == nil {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Getf(i18n.KeyGoalExists, name)),
+		}, nil
+	}
+	if !errors.Is(err, repository.ErrGoalNotFound) {
+		return nil, fmt.Errorf("failed to get goal by name: %w", err)
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchage and designation for user: %w", err)
+	}
+	if response := h.checkAmountCurrency(localizer, currency, targetCurrency); response != nil {
+		return response, nil
+	}
+
+	enteredTarget, err := enteredAmount(target, currency.code)
+	if err != nil {
+		return incorrect, nil
+	}
+
+	defaultTarget, err := h.toDefaultCurrency(enteredTarget.Amount, currency)
+	if err != nil {
+		return incorrect, nil
+	}
+
+	goal, err := h.goalRepo.AddGoal(ctx, models.NewGoal(message.From.ID, name, defaultTarget.Amount, deadline))
+	if err != nil {
+		return nil, fmt.Errorf("failed to add goal: %w", err)
+	}
+
+	msg := h.formatter.NewMessage().Text(localizer.Getf(i18n.KeyGoalCreated, goal.Name)).Line().Line()
+	if err := h.writeGoal(msg, localizer, currency, goal, message.Date); err != nil {
+		return nil, err
+	}
+
+	return &bot.MessageResponse{
+		Message: addRatesWarning(msg, localizer, currency.staleAge).String(),
+	}, nil
+}
+
+func (h *MessageHandlers) contributeToGoal(
+	ctx context.Context,
+	message *models.Message,
+	name string,
+	contributionText string,
+) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+	incorrect := &bot.MessageResponse{
+		Message: h.formatter.Text(localizer.Get(i18n.KeyIncorrectFormat)),
+	}
+
+	contribution, contributionCurrency, err := amount.Parse(contributionText)
+	if err != nil || contribution <= 0 {
+		return incorrect, nil
+	}
+
+	goal, err := h.goalRepo.GetGoalByName(ctx, message.From.ID, name)
+	if errors.Is(err, repository.ErrGoalNotFound) {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Getf(i18n.KeyGoalNotFound, name)),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goal by name: %w", err)
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchage and designation for user: %w", err)
+	}
+	if response := h.checkAmountCurrency(localizer, currency, contributionCurrency); response != nil {
+		return response, nil
+	}
+
+	enteredContribution, err := enteredAmount(contribution, currency.code)
+	if err != nil {
+		return incorrect, nil
+	}
+
+	defaultContribution, err := h.toDefaultCurrency(enteredContribution.Amount, currency)
+	if err != nil {
+		return incorrect, nil
+	}
+
+	goal, err = h.goalRepo.AddToGoal(ctx, message.From.ID, goal.ID, defaultContribution.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to contribute to goal: %w", err)
+	}
+
+	msg := h.formatter.NewMessage().
+		Text(localizer.Getf(i18n.KeyGoalContributed, currency.format(enteredContribution), goal.Name)).
+		Line().Line()
+	if err := h.writeGoal(msg, localizer, currency, goal, message.Date); err != nil {
+		return nil, err
+	}
+
+	return &bot.MessageResponse{
+		Message: addRatesWarning(msg, localizer, currency.staleAge).String(),
+	}, nil
+}
+
+func (h *MessageHandlers) setIncome(ctx context.Context, message *models.Message, incomeText string) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+	incorrect := &bot.MessageResponse{
+		Message: h.formatter.Text(localizer.Get(i18n.KeyIncorrectFormat)),
+	}
+
+	income, incomeCurrency, err := amount.Parse(incomeText)
+	if err != nil || income <= 0 {
+		return incorrect, nil
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchage and designation for user: %w", err)
+	}
+	if response := h.checkAmountCurrency(localizer, currency, incomeCurrency); response != nil {
+		return response, nil
+	}
+
+	enteredIncome, err := enteredAmount(income, currency.code)
+	if err != nil {
+		return incorrect, nil
+	}
+
+	defaultIncome, err := h.toDefaultCurrency(enteredIncome.Amount, currency)
+	if err != nil {
+		return incorrect, nil
+	}
+
+	err = h.userRepo.SetIncome(ctx, message.From.ID, defaultIncome.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set income: %w", err)
+	}
+
+	return &bot.MessageResponse{
+		Message: h.formatter.Text(localizer.Getf(i18n.KeyGoalIncomeSet, currency.format(enteredIncome))),
+	}, nil
+}
+
+func (h *MessageHandlers) deleteGoal(ctx context.Context, message *models.Message, name string) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+
+	goal, err := h.goalRepo.GetGoalByName(ctx, message.From.ID, name)
+	if errors.Is(err, repository.ErrGoalNotFound) {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Getf(i18n.KeyGoalNotFound, name)),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goal by name: %w", err)
+	}
+
+	err = h.goalRepo.DeleteGoal(ctx, message.From.ID, goal.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete goal: %w", err)
+	}
+
+	return &bot.MessageResponse{
+		Message: h.formatter.Text(localizer.Getf(i18n.KeyGoalDeleted, goal.Name)),
+	}, nil
+}
+
+func (h *MessageHandlers) listGoals(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+
+	goals, err := h.goalRepo.GetGoals(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals: %w", err)
+	}
+
+	if len(goals) == 0 {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Get(i18n.KeyGoalUsage)),
+		}, nil
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchage and designation for user: %w", err)
+	}
+
+	msg := h.formatter.NewMessage().Bold(localizer.Get(i18n.KeyGoalsHeader))
+	for _, goal := range goals {
+		msg.Line().Line()
+		if err := h.writeGoal(msg, localizer, currency, goal, message.Date); err != nil {
+			return nil, err
+		}
+	}
+
+	income, err := h.userRepo.GetIncome(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get income: %w", err)
+	}
+
+	msg.Line().Line()
+	if income == nil {
+		msg.Italic(localizer.Get(i18n.KeyGoalNoIncome))
+	} else {
+		convertedIncome, err := h.fromDefaultCurrency(*income, currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert income: %w", err)
+		}
+		msg.Italic(localizer.Getf(i18n.KeyGoalIncome, currency.format(convertedIncome)))
+	}
+
+	return &bot.MessageResponse{
+		Message: addRatesWarning(msg, localizer, currency.staleAge).String(),
+	}, nil
+}
+
+// writeGoal writes the name, the progress bar and the amount to save every month until the deadline.
+func (h *MessageHandlers) writeGoal(
+	msg *format.Message,
+	localizer *i18n.Localizer,
+	currency *userCurrency,
+	goal *models.Goal,
+	now time.Time,
+) error {
+	saved, err := h.fromDefaultCurrency(goal.Saved, currency)
+	if err != nil {
+		return fmt.Errorf("failed to convert saved amount: %w", err)
+	}
+
+	target, err := h.fromDefaultCurrency(goal.Target, currency)
+	if err != nil {
+		return fmt.Errorf("failed to convert target: %w", err)
+	}
+
+	msg.Bold(goal.Name).Line().
+		Textf("%s %d%%", format.ProgressBar(goal.Percent()), goal.Percent()).Line().
+		Textf("%s / %s", currency.format(saved), currency.format(target)).Line()
+
+	deadline := goal.Deadline.Format(userDateLayout)
+	switch {
+	case goal.Reached():
+		msg.Italic(localizer.Get(i18n.KeyGoalReached))
+	case goal.MonthsLeft(now) == 0:
+		msg.Italic(localizer.Getf(i18n.KeyGoalOverdue, deadline))
+	default:
+		monthly, err := h.fromDefaultCurrency(goal.MonthlySaving(now), currency)
+		if err != nil {
+			return fmt.Errorf("failed to convert monthly saving: %w", err)
+		}
+		msg.Text(localizer.Getf(i18n.KeyGoalMonthly, currency.format(monthly), deadline))
+	}
+
+	return nil
+}
@@ -3,37 +3,169 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/amount"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
 )
 
-const (
-	messageIncorrectFormat = "Неправильный формат"
+const maxTagLength = 64
 
-	convertToMainCurrency = 100.0
-)
+// localizer returns the texts in the language of the user of the message.
+func (h *MessageHandlers) localizer(message *models.Message) *i18n.Localizer {
+	return i18n.New(message.From.GetLanguage())
+}
 
-func (h *MessageHandlers) convertFromDefaultCurrency(money uint64, exchange float64) float64 {
-	return float64(money) * exchange / convertToMainCurrency
+// userCurrency is the currency of the amounts of the user with its rate to the default currency.
+type userCurrency struct {
+	code        string
+	rate        money.Rate
+	designation string
+	// staleAge is the age of the outdated rate, zero if the rate is fresh
+	staleAge time.Duration
 }
 
-func (h *MessageHandlers) convertToDefaultCurrency(money float64, exchange float64) uint64 {
-	return uint64(float64(money) / exchange * convertToMainCurrency)
+// format returns the amount with the designation of the currency, like "1500.50 $".
+func (c *userCurrency) format(amount money.Money) string {
+	return amount.Decimal() + " " + c.designation
 }
 
-func (h *MessageHandlers) getExchangeOfUser(ctx context.Context, userID int64) (float64, string, error) {
+// enteredAmount converts the amount parsed by amount.Parse to minor units of the currency,
+// the fraction is rejected for the currencies without minor units, like JPY.
+func enteredAmount(value int64, currency string) (money.Money, error) {
+	return money.FromDecimal(value, amount.Digits, currency)
+}
+
+// toDefaultCurrency converts the amount in minor units of the currency of user to the default currency
+// in which the amounts are stored.
+func (h *MessageHandlers) toDefaultCurrency(amount int64, currency *userCurrency) (money.Money, error) {
+	return money.New(amount, currency.code).
+		ExchangeBack(currency.rate, h.exchangeService.GetDefaultCurrency(), money.RoundHalfEven)
+}
+
+// fromDefaultCurrency converts the stored amount in the default currency to the currency of user.
+func (h *MessageHandlers) fromDefaultCurrency(amount int64, currency *userCurrency) (money.Money, error) {
+	return money.New(amount, h.exchangeService.GetDefaultCurrency()).
+		Exchange(currency.rate, currency.code, money.RoundHalfEven)
+}
+
+func (h *MessageHandlers) getCurrencyOfUser(ctx context.Context, userID int64) (*userCurrency, error) {
 	currency, err := h.userContextService.GetCurrency(ctx, userID)
 	if err != nil {
-		return 0, "", fmt.Errorf("failed to get user currency: %w", err)
+		return nil, fmt.Errorf("failed to get user currency: %w", err)
 	}
 
-	exchange, err := h.exchangeService.GetExchange(currency)
+	return h.getCurrency(currency)
+}
+
+// getCurrency returns the tracked currency with its rate to the default currency.
+func (h *MessageHandlers) getCurrency(currency string) (*userCurrency, error) {
+	rate, err := h.exchangeService.GetExchange(currency)
 	if err != nil {
-		return 0, "", fmt.Errorf("failed to get exchange of user: %w", err)
+		return nil, fmt.Errorf("failed to get exchange of user: %w", err)
 	}
 
 	designation, err := h.exchangeService.GetDesignation(currency)
 	if err != nil {
-		return 0, "", fmt.Errorf("failed to get designation os user: %w", err)
+		return nil, fmt.Errorf("failed to get designation os user: %w", err)
+	}
+
+	var staleAge time.Duration
+	if currency != h.exchangeService.GetDefaultCurrency() {
+		staleAge = h.staleRatesAge()
+	}
+
+	return &userCurrency{
+		code:        currency,
+		rate:        rate,
+		designation: designation,
+		staleAge:    staleAge,
+	}, nil
+}
+
+// checkAmountCurrency returns the reply if the amount is written in the currency
+// other than the currency of the user, nil if the amount has no currency or has the currency of the user.
+func (h *MessageHandlers) checkAmountCurrency(
+	localizer *i18n.Localizer, currency *userCurrency, code string,
+) *bot.MessageResponse {
+	if code == "" || code == currency.code {
+		return nil
+	}
+
+	return &bot.MessageResponse{
+		Message: h.formatter.Text(localizer.Getf(i18n.KeyOtherCurrency, currency.code)),
+	}
+}
+
+// staleRatesAge returns the age of exchange rates if they are outdated, zero otherwise.
+func (h *MessageHandlers) staleRatesAge() time.Duration {
+	age, stale := h.exchangeService.RatesAge()
+	if !stale {
+		return 0
+	}
+
+	return age
+}
+
+// addRatesWarning adds the warning to the message if rates are outdated, the age is zero for fresh rates.
+func addRatesWarning(msg *format.Message, localizer *i18n.Localizer, staleAge time.Duration) *format.Message {
+	if staleAge == 0 {
+		return msg
+	}
+
+	return msg.Line().Italic(localizer.Getf(i18n.KeyStaleRates, formatAge(localizer, staleAge)))
+}
+
+// formatAge returns the age in the largest whole units, like "3 часа".
+func formatAge(localizer *i18n.Localizer, age time.Duration) string {
+	switch {
+	case age >= 24*time.Hour:
+		return localizer.Plural(i18n.KeyDaysAgo, int(age/(24*time.Hour)))
+	case age >= time.Hour:
+		return localizer.Plural(i18n.KeyHoursAgo, int(age/time.Hour))
+	default:
+		return localizer.Plural(i18n.KeyMinutesAgo, int(age/time.Minute))
+	}
+}
+
+// parseTag returns the lowercase name of the tag without "#", like "vacation2024" for "#Vacation2024".
+// The name can contain only letters, digits and underscores.
+func parseTag(word string) (string, bool) {
+	name := strings.ToLower(strings.TrimPrefix(word, "#"))
+	if name == "" || len(name) > maxTagLength {
+		return "", false
+	}
+
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return "", false
+		}
+	}
+
+	return name, true
+}
+
+// notifyUser sends the message to another user, the notification is sent once
+// if the handled message is received again.
+func (h *MessageHandlers) notifyUser(ctx context.Context, userID int64, text string) error {
+	if key := models.IdempotencyKeyFromContext(ctx); key != "" {
+		ctx = models.ContextWithIdempotencyKey(ctx, fmt.Sprintf("%s_notify_%d", key, userID))
+	}
+
+	return h.tgClient.SendMessageWithoutRemovingKeyboard(ctx, userID, text)
+}
+
+// displayName returns the username with "@" or the first name if the user has no username.
+func displayName(userName string, firstName string) string {
+	if userName != "" {
+		return "@" + userName
 	}
 
-	return exchange, designation, nil
+	return firstName
 }
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+func (h *MessageHandlers) languageHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	err := h.userContextService.SetContext(ctx, message.From.ID, enums.ChangeLanguage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set context for user: %w", err)
+	}
+
+	languagesKeyboardButtons := make([][]string, len(enums.Languages))
+	for i, language := range enums.Languages {
+		languagesKeyboardButtons[i] = []string{i18n.LanguageName(language)}
+	}
+
+	return &bot.MessageResponse{
+		Message:  h.formatter.Text(h.localizer(message).Get(i18n.KeyChooseLanguage)),
+		Keyboard: languagesKeyboardButtons,
+	}, nil
+}
+
+func (h *MessageHandlers) changeLanguage(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	language, ok := i18n.ParseLanguageName(message.Text)
+	if !ok {
+		return &bot.MessageResponse{
+			Message:             h.formatter.Text(h.localizer(message).Get(i18n.KeyChooseLanguage)),
+			DoNotRemoveKeyboard: true,
+		}, nil
+	}
+
+	err := h.userContextService.SetContext(ctx, message.From.ID, enums.NoContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set context for user: %w", err)
+	}
+
+	err = h.userRepo.SetLanguage(ctx, message.From.ID, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set language for user: %w", err)
+	}
+
+	return &bot.MessageResponse{
+		Message: h.formatter.Text(i18n.New(language).Get(i18n.KeySuccessfulChangeLanguage)),
+	}, nil
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
+
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
 )
 
 //go:generate mockery --name=userRepository --dir . --output ./mocks --exported
@@ -16,20 +20,48 @@ type userRepository interface {
 
 	SetWasteLimit(ctx context.Context, id int64, limit uint64) (*models.User, error)
 	GetWasteLimit(ctx context.Context, id int64) (*uint64, error)
+	SetLanguage(ctx context.Context, id int64, language enums.Language) error
+	GetCurrencies(ctx context.Context, id int64) ([]string, error)
+	SetCurrencies(ctx context.Context, id int64, currencies []string) error
+	GetUserByUserName(ctx context.Context, userName string) (*models.User, error)
+	SetIncome(ctx context.Context, id int64, income int64) error
+	GetIncome(ctx context.Context, id int64) (*int64, error)
 }
 
 //go:generate mockery --name=wasteRepository --dir . --output ./mocks --exported
 type wasteRepository interface {
 	SumOfWastesAfterDate(ctx context.Context, userID int64, date time.Time) (int64, error)
 	AddWasteToUser(ctx context.Context, userID int64, waste *models.Waste) (*models.Waste, error)
+	ListWastes(ctx context.Context, userID int64, filter models.WasteFilter) ([]*models.Waste, int, error)
+	SumOfFilteredWastes(ctx context.Context, userID int64, filter models.WasteFilter) (int64, error)
+	UpdateClaimStatus(ctx context.Context, userID int64, id uuid.UUID, from enums.ClaimStatus, to enums.ClaimStatus) (int, error)
+}
+
+//go:generate mockery --name=debtRepository --dir . --output ./mocks --exported
+type debtRepository interface {
+	AddSplitWaste(ctx context.Context, userID int64, waste *models.Waste, debts []*models.Debt) error
+	GetBalances(ctx context.Context, userID int64) ([]*models.Balance, error)
+	SettleDebts(ctx context.Context, debtIDs []uuid.UUID) (int, error)
+}
+
+//go:generate mockery --name=goalRepository --dir . --output ./mocks --exported
+type goalRepository interface {
+	AddGoal(ctx context.Context, goal *models.Goal) (*models.Goal, error)
+	GetGoals(ctx context.Context, userID int64) ([]*models.Goal, error)
+	GetGoalByName(ctx context.Context, userID int64, name string) (*models.Goal, error)
+	AddToGoal(ctx context.Context, userID int64, id int, amount int64) (*models.Goal, error)
+	DeleteGoal(ctx context.Context, userID int64, id int) error
 }
 
 //go:generate mockery --name=exchangeService --dir . --output ./mocks --exported
 type exchangeService interface {
 	GetDefaultCurrency() string
 	GetUsedCurrencies() []string
-	GetExchange(currency string) (float64, error)
+	GetExchange(currency string) (money.Rate, error)
 	GetDesignation(currency string) (string, error)
+	AddCurrency(ctx context.Context, currency string) error
+	UpdatedAt() time.Time
+	RatesAge() (time.Duration, bool)
 }
 
 //go:generate mockery --name=userContextService --dir . --output ./mocks --exported
@@ -38,6 +70,8 @@ type userContextService interface {
 	GetContext(ctx context.Context, userID int64) (enums.UserContext, error)
 	SetCurrency(ctx context.Context, userID int64, currency string) error
 	GetCurrency(ctx context.Context, userID int64) (string, error)
+	SetSearchQuery(ctx context.Context, userID int64, query string) error
+	GetSearchQuery(ctx context.Context, userID int64) (string, error)
 }
 
 //go:generate mockery --name=kafkaProducer --dir . --output ./mocks --exported
@@ -45,27 +79,63 @@ type kafkaProducer interface {
 	SendMessage(ctx context.Context, key []byte, value []byte) error
 }
 
+//go:generate mockery --name=reportStatusService --dir . --output ./mocks --exported
+type reportStatusService interface {
+	Create(ctx context.Context, request *models.ReportRequest) error
+	GetUserRequests(ctx context.Context, userID int64) ([]*models.ReportRequest, error)
+}
+
+//go:generate mockery --name=tokenService --dir . --output ./mocks --exported
+type tokenService interface {
+	Issue(ctx context.Context, userID int64) (string, error)
+}
+
+//go:generate mockery --name=telegramClient --dir . --output ./mocks --exported
+type telegramClient interface {
+	SendMessageWithoutRemovingKeyboard(ctx context.Context, userID int64, text string) error
+}
+
 type MessageHandlers struct {
-	userRepo           userRepository
-	wasteRepo          wasteRepository
-	exchangeService    exchangeService
-	userContextService userContextService
-	kafkaProducer      kafkaProducer
+	formatter *format.Formatter
+
+	userRepo            userRepository
+	wasteRepo           wasteRepository
+	debtRepo            debtRepository
+	goalRepo            goalRepository
+	exchangeService     exchangeService
+	userContextService  userContextService
+	kafkaProducer       kafkaProducer
+	reportStatusService reportStatusService
+	tokenService        tokenService
+	tgClient            telegramClient
 }
 
 func NewMessageHandlers(
+	formatter *format.Formatter,
 	userRepo userRepository,
 	wasteRepo wasteRepository,
+	debtRepo debtRepository,
+	goalRepo goalRepository,
 	exchangeService exchangeService,
 	userContextService userContextService,
 	kafkaProducer kafkaProducer,
+	reportStatusService reportStatusService,
+	tokenService tokenService,
+	tgClient telegramClient,
 ) *MessageHandlers {
 	return &MessageHandlers{
-		userRepo:           userRepo,
-		wasteRepo:          wasteRepo,
-		exchangeService:    exchangeService,
-		userContextService: userContextService,
-		kafkaProducer:      kafkaProducer,
+		formatter: formatter,
+
+		userRepo:            userRepo,
+		wasteRepo:           wasteRepo,
+		debtRepo:            debtRepo,
+		goalRepo:            goalRepo,
+		exchangeService:     exchangeService,
+		userContextService:  userContextService,
+		kafkaProducer:       kafkaProducer,
+		reportStatusService: reportStatusService,
+		tokenService:        tokenService,
+		tgClient:            tgClient,
 	}
 }
 
@@ -78,6 +148,16 @@ func (h *MessageHandlers) GetHandlers() map[string]bot.MessageHandler {
 		"/month":    h.monthHandler,
 		"/year":     h.yearHandler,
 		"/currency": h.currencyHandler,
+		"/language": h.languageHandler,
+		"/status":   h.statusHandler,
+		"/token":    h.tokenHandler,
+		"/rates":    h.ratesHandler,
+		"/convert":  h.convertHandler,
+		"/find":     h.findHandler,
+		"/claims":   h.claimsHandler,
+		"/split":    h.splitHandler,
+		"/settle":   h.settleHandler,
+		"/goal":     h.goalHandler,
 		"default":   h.defaultHandler,
 	}
 }
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+)
+
+const ratesTimeLayout = "02.01.2006 15:04 MST"
+
+// ratesHandler shows the rates of the offered currencies to the currency chosen by the user.
+func (h *MessageHandlers) ratesHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+
+	updatedAt := h.exchangeService.UpdatedAt()
+	if updatedAt.IsZero() {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Get(i18n.KeyRatesNotReceived)),
+		}, nil
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange and designation of user: %w", err)
+	}
+
+	currencies, err := h.getOfferedCurrencies(ctx, message.From.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := h.formatter.NewMessage().Bold(localizer.Getf(i18n.KeyRatesHeader, currency.code)).Line()
+	for _, code := range currencies {
+		if code == currency.code {
+			continue
+		}
+
+		// the rate of the currency added by the user can be not received yet
+		rate, err := h.exchangeService.GetExchange(code)
+		if err != nil {
+			continue
+		}
+
+		crossRate, err := money.CrossRate(rate, currency.rate)
+		if err != nil {
+			continue
+		}
+
+		msg.Line().Textf("1 %s = %s %s", code, crossRate.String(), currency.designation)
+	}
+
+	msg.Line().Line().Italic(localizer.Getf(i18n.KeyRatesUpdatedAt, updatedAt.Format(ratesTimeLayout)))
+
+	return &bot.MessageResponse{
+		Message: addRatesWarning(msg, localizer, h.staleRatesAge()).String(),
+	}, nil
+}
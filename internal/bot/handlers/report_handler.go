@@ -2,15 +2,21 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/requests"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+	exchangeservice "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/exchange"
 )
 
-const generatingReportMessage = "Отчет генерируется..."
-
 func (h *MessageHandlers) weekHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
 	return h.generateReportForUser(ctx, message, requests.PeriodWeek)
 }
@@ -23,31 +29,160 @@ func (h *MessageHandlers) yearHandler(ctx context.Context, message *models.Messa
 	return h.generateReportForUser(ctx, message, requests.PeriodYear)
 }
 
+// groupByTagArgument is the argument of the report command to group the report by tags.
+const groupByTagArgument = "by:tag"
+
+// reportArguments are the optional arguments of the report command, like "/week EUR #vacation".
+type reportArguments struct {
+	currency   string
+	tag        string
+	groupByTag bool
+}
+
+// parseReportArguments parses the currency, the tag or the grouping by tags,
+// the tag and the grouping can not be set together.
+func parseReportArguments(text string) (*reportArguments, bool) {
+	arguments := &reportArguments{}
+	for _, argument := range strings.Fields(text)[1:] {
+		switch {
+		case strings.HasPrefix(argument, "#") && arguments.tag == "":
+			tag, ok := parseTag(argument)
+			if !ok {
+				return nil, false
+			}
+			arguments.tag = tag
+		case strings.EqualFold(argument, groupByTagArgument) && !arguments.groupByTag:
+			arguments.groupByTag = true
+		case isCurrencyCode(argument) && arguments.currency == "":
+			arguments.currency = argument
+		default:
+			return nil, false
+		}
+	}
+
+	if arguments.tag != "" && arguments.groupByTag {
+		return nil, false
+	}
+
+	return arguments, true
+}
+
+// generateReportForUser requests the report in the currency of the user
+// or in the currency set after the command, like "/week EUR".
+// The report can be limited by the tag, like "/week #vacation", or grouped by tags, like "/week by:tag".
 func (h *MessageHandlers) generateReportForUser(ctx context.Context, message *models.Message, period requests.Period) (*bot.MessageResponse, error) {
-	exchange, designation, err := h.getExchangeOfUser(ctx, message.From.ID)
+	localizer := h.localizer(message)
+
+	arguments, ok := parseReportArguments(message.Text)
+	if !ok {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Get(i18n.KeyIncorrectFormat)),
+		}, nil
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get exchage and designation for the user: %w", err)
 	}
 
-	req := requests.GetReport{
+	if arguments.currency != "" {
+		resp, err := h.chooseReportCurrency(ctx, localizer, arguments.currency, currency)
+		if resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	err = h.requestReport(ctx, message, &requests.GetReport{
 		UserID:              message.From.ID,
 		Period:              period,
-		CurrencyExchange:    exchange,
-		CurrencyDesignation: designation,
+		CurrencyExchange:    currency.rate.Float64(),
+		CurrencyRate:        currency.rate.String(),
+		Currency:            currency.code,
+		CurrencyDesignation: currency.designation,
+		BaseCurrency:        h.exchangeService.GetDefaultCurrency(),
+		NotCached:           arguments.currency != "" || arguments.tag != "" || arguments.groupByTag,
+		Tag:                 arguments.tag,
+		GroupByTag:          arguments.groupByTag,
+		Language:            message.From.GetLanguage(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg := h.formatter.NewMessage().Text(localizer.Get(i18n.KeyGeneratingReport))
+
+	return &bot.MessageResponse{
+		Message: addRatesWarning(msg, localizer, currency.staleAge).String(),
+	}, nil
+}
+
+// requestReport creates the status of the report request and sends the request to the report service.
+func (h *MessageHandlers) requestReport(ctx context.Context, message *models.Message, req *requests.GetReport) error {
+	req.RequestID = uuid.NewString()
+	err := h.reportStatusService.Create(ctx, &models.ReportRequest{
+		ID:       req.RequestID,
+		UserID:   message.From.ID,
+		Period:   req.Period,
+		Language: message.From.GetLanguage(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create the report request: %w", err)
 	}
 
-	key := []byte{}
+	// the key is the user to keep the order of the requests of one user in the same partition
+	key := []byte(strconv.FormatInt(message.From.ID, 10))
 	value, err := req.MarshalJSON()
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal the request: %w", err)
+		return fmt.Errorf("failed to marshal the request: %w", err)
 	}
 
 	err = h.kafkaProducer.SendMessage(ctx, key, value)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send the message to the kafka: %w", err)
+		return fmt.Errorf("failed to send the message to the kafka: %w", err)
 	}
 
-	return &bot.MessageResponse{
-		Message: generatingReportMessage,
-	}, nil
+	return nil
+}
+
+// chooseReportCurrency replaces the currency of the user with the currency of the report,
+// the response is returned if the currency can not be used.
+func (h *MessageHandlers) chooseReportCurrency(
+	ctx context.Context,
+	localizer *i18n.Localizer,
+	code string,
+	currency *userCurrency,
+) (*bot.MessageResponse, error) {
+	found, ok := money.LookupCurrency(code)
+	if !ok {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Getf(i18n.KeyUnknownCurrency, strings.ToUpper(code))),
+		}, nil
+	}
+
+	// the rate is stored by the service, so the report service finds it
+	err := h.exchangeService.AddCurrency(ctx, found.Code)
+	if errors.Is(err, exchangeservice.ErrCurrencyNotSupported) {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Getf(i18n.KeyRateNotAvailable, found.Code)),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to add currency: %w", err)
+	}
+
+	rate, err := h.exchangeService.GetExchange(found.Code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange: %w", err)
+	}
+
+	currency.code = found.Code
+	currency.rate = rate
+	currency.designation = found.Symbol
+	if found.Code != h.exchangeService.GetDefaultCurrency() {
+		currency.staleAge = h.staleRatesAge()
+	} else {
+		currency.staleAge = 0
+	}
+
+	return nil, nil
 }
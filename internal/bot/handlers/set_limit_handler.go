@@ -3,18 +3,14 @@ package handlers
 import (
 	"context"
 	"fmt"
-	"strconv"
 
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/amount"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
-const (
-	messageSetLimitResponse   = "Введите желаемый лимит в виде положительного вещественного числа в текущей валюте"
-	messageSuccessfulSetLimit = "Лимит трат за месяц успешно установлен"
-)
-
 func (h *MessageHandlers) setLimitHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
 	err := h.userContextService.SetContext(ctx, message.From.ID, enums.SetLimit)
 	if err != nil {
@@ -22,30 +18,47 @@ func (h *MessageHandlers) setLimitHandler(ctx context.Context, message *models.M
 	}
 
 	return &bot.MessageResponse{
-		Message: messageSetLimitResponse,
+		Message: h.formatter.Text(h.localizer(message).Get(i18n.KeySetLimitResponse)),
 	}, nil
 }
 
 func (h *MessageHandlers) setLimit(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
-	limit, err := strconv.ParseFloat(message.Text, 64)
+	limit, limitCurrency, err := amount.Parse(message.Text)
 	if err != nil {
 		return &bot.MessageResponse{
-			Message: messageIncorrectFormat,
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectFormat)),
 		}, nil
 	}
 
 	if limit < 0 {
 		return &bot.MessageResponse{
-			Message: messageIncorrectFormat,
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectFormat)),
 		}, nil
 	}
 
-	exchange, _, err := h.getExchangeOfUser(ctx, message.From.ID)
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get exchange and designation for user: %w", err)
 	}
+	if response := h.checkAmountCurrency(h.localizer(message), currency, limitCurrency); response != nil {
+		return response, nil
+	}
+
+	enteredLimit, err := enteredAmount(limit, currency.code)
+	if err != nil {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectFormat)),
+		}, nil
+	}
 
-	_, err = h.userRepo.SetWasteLimit(ctx, message.From.ID, h.convertToDefaultCurrency(limit, exchange))
+	defaultLimit, err := h.toDefaultCurrency(enteredLimit.Amount, currency)
+	if err != nil {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(h.localizer(message).Get(i18n.KeyIncorrectFormat)),
+		}, nil
+	}
+
+	_, err = h.userRepo.SetWasteLimit(ctx, message.From.ID, uint64(defaultLimit.Amount))
 	if err != nil {
 		return nil, fmt.Errorf("failed to set waste for user: %w", err)
 	}
@@ -55,7 +68,10 @@ func (h *MessageHandlers) setLimit(ctx context.Context, message *models.Message)
 		return nil, fmt.Errorf("failed to set context for user: %w", err)
 	}
 
+	localizer := h.localizer(message)
+	msg := h.formatter.NewMessage().Text(localizer.Get(i18n.KeySuccessfulSetLimit))
+
 	return &bot.MessageResponse{
-		Message: messageSuccessfulSetLimit,
+		Message: addRatesWarning(msg, localizer, currency.staleAge).String(),
 	}, nil
 }
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/repository"
+)
+
+// settleHandler shows the balances of the user with other users, "/settle @anna" settles the debts with the user.
+//
+// The debts are settled by the user who is owed, because the user confirms that the money is received.
+// The debts in both directions are settled at once, only the debts read for the balance are settled,
+// so the settled amount is the same as the reported one.
+func (h *MessageHandlers) settleHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+
+	fields := strings.Fields(message.Text)[1:]
+	switch {
+	case len(fields) == 0:
+		return h.listBalances(ctx, message, "")
+	case len(fields) == 1 && strings.HasPrefix(fields[0], "@") && len(fields[0]) > 1:
+		return h.settleWith(ctx, message, fields[0][1:])
+	default:
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Get(i18n.KeySettleUsage)),
+		}, nil
+	}
+}
+
+func (h *MessageHandlers) settleWith(ctx context.Context, message *models.Message, userName string) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+
+	other, err := h.userRepo.GetUserByUserName(ctx, userName)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Getf(i18n.KeySplitUnknownUser, userName)),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by username: %w", err)
+	}
+
+	name := displayName(other.UserName, other.FirstName)
+
+	balances, err := h.debtRepo.GetBalances(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	var balance *models.Balance
+	for _, b := range balances {
+		if b.UserID == other.ID {
+			balance = b
+		}
+	}
+
+	if balance == nil {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Getf(i18n.KeySettleNoDebts, name)),
+		}, nil
+	}
+	if balance.Amount < 0 {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Getf(i18n.KeySettleOnlyCreditor, name)),
+		}, nil
+	}
+
+	_, err = h.debtRepo.SettleDebts(ctx, balance.DebtIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to settle debts: %w", err)
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange and designation of user: %w", err)
+	}
+
+	settled, err := h.fromDefaultCurrency(balance.Amount, currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert settled amount: %w", err)
+	}
+
+	note := localizer.Getf(i18n.KeySettleSuccess, name, currency.format(settled))
+	if err := h.notifySettled(ctx, message.From, other, balance.Amount); err != nil {
+		note += "\n" + localizer.Getf(i18n.KeySplitNotNotified, name)
+	}
+
+	return h.listBalances(ctx, message, note)
+}
+
+// notifySettled sends the settled amount to the debtor in the currency and the language of the debtor.
+func (h *MessageHandlers) notifySettled(ctx context.Context, creditor *models.User, debtor *models.User, debt int64) error {
+	currency, err := h.getCurrencyOfUser(ctx, debtor.ID)
+	if err != nil {
+		return err
+	}
+
+	converted, err := h.fromDefaultCurrency(debt, currency)
+	if err != nil {
+		return err
+	}
+
+	localizer := i18n.New(debtor.GetLanguage())
+	text := h.formatter.Text(localizer.Getf(i18n.KeySettleNotification,
+		displayName(creditor.UserName, creditor.FirstName), currency.format(converted)))
+
+	return h.notifyUser(ctx, debtor.ID, text)
+}
+
+// listBalances returns the balances of the user in the currency of the user with the buttons
+// to settle the debts owed to the user, the note is shown before them, like the result of the settlement.
+func (h *MessageHandlers) listBalances(ctx context.Context, message *models.Message, note string) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+
+	balances, err := h.debtRepo.GetBalances(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange and designation of user: %w", err)
+	}
+
+	msg := h.formatter.NewMessage()
+	if note != "" {
+		msg.Italic(note).Line().Line()
+	}
+
+	if len(balances) == 0 {
+		msg.Text(localizer.Get(i18n.KeySettleNothing))
+
+		return &bot.MessageResponse{
+			Message: msg.String(),
+		}, nil
+	}
+
+	msg.Bold(localizer.Get(i18n.KeySettleBalances))
+	buttons := make([][]models.KeyboardButton, 0, len(balances))
+	for _, balance := range balances {
+		name := displayName(balance.UserName, balance.FirstName)
+		if balance.Amount < 0 {
+			amount, err := h.fromDefaultCurrency(-balance.Amount, currency)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert balance: %w", err)
+			}
+			msg.Line().Text(localizer.Getf(i18n.KeySettleYouOwe, name, currency.format(amount)))
+			continue
+		}
+
+		amount, err := h.fromDefaultCurrency(balance.Amount, currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert balance: %w", err)
+		}
+		msg.Line().Text(localizer.Getf(i18n.KeySettleOwesYou, name, currency.format(amount)))
+
+		// the debtors always have the username, because the shares are assigned by usernames
+		if balance.UserName != "" {
+			buttons = append(buttons, []models.KeyboardButton{{
+				Text:         localizer.Getf(i18n.KeySettleButton, name),
+				CallbackData: fmt.Sprintf("%s @%s", enums.CommandTypeSettle, balance.UserName),
+			}})
+		}
+	}
+
+	response := &bot.MessageResponse{
+		Message: addRatesWarning(msg, localizer, currency.staleAge).String(),
+	}
+	if len(buttons) > 0 {
+		response.InlineKeyboard = buttons
+	}
+
+	return response, nil
+}
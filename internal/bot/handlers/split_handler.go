@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/amount"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/repository"
+)
+
+const maxSplitParticipants = 20
+
+// splitShare is the share of the participant in the currency of the payer,
+// the share is zero until the rest of the cost is split equally.
+type splitShare struct {
+	userName string
+	amount   int64
+	// currency is the code of the currency written with the amount, empty if there is no currency
+	currency string
+	user     *models.User
+}
+
+func (h *MessageHandlers) splitHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	err := h.userContextService.SetContext(ctx, message.From.ID, enums.SplitWaste)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set user context: %w", err)
+	}
+
+	return &bot.MessageResponse{
+		Message: h.formatter.Text(h.localizer(message).Get(i18n.KeySplitResponse)),
+	}, nil
+}
+
+// splitWaste adds the share of the payer to the wastes and the shares of the participants to the debts,
+// the participants are notified about their debts.
+//
+// The message has the category, the cost paid by the user, the participants, like "@anna @bob:500",
+// and the optional date and note. The participants without the amount split the rest equally with the payer.
+func (h *MessageHandlers) splitWaste(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	localizer := h.localizer(message)
+	incorrect := &bot.MessageResponse{
+		Message: h.formatter.Text(localizer.Get(i18n.KeyIncorrectFormat)),
+	}
+
+	lines := strings.Split(message.Text, "\n")
+	if len(lines) < 3 || len(lines) > 5 {
+		return incorrect, nil
+	}
+
+	cost, costCurrency, err := amount.Parse(lines[1])
+	if err != nil || cost <= 0 {
+		return incorrect, nil
+	}
+
+	shares, ok := parseSplitShares(lines[2])
+	if !ok {
+		return incorrect, nil
+	}
+
+	details, ok := parseWasteDetails(lines[3:], message.Date)
+	if !ok {
+		return incorrect, nil
+	}
+
+	for _, share := range shares {
+		share.user, err = h.userRepo.GetUserByUserName(ctx, share.userName)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return &bot.MessageResponse{
+				Message: h.formatter.Text(localizer.Getf(i18n.KeySplitUnknownUser, share.userName)),
+			}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user by username: %w", err)
+		}
+
+		if share.user.ID == message.From.ID {
+			return &bot.MessageResponse{
+				Message: h.formatter.Text(localizer.Get(i18n.KeySplitYourself)),
+			}, nil
+		}
+	}
+
+	currency, err := h.getCurrencyOfUser(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchage and designation for user: %w", err)
+	}
+
+	// the shares are computed in the currency of the user
+	if response := h.checkAmountCurrency(localizer, currency, costCurrency); response != nil {
+		return response, nil
+	}
+	for _, share := range shares {
+		if response := h.checkAmountCurrency(localizer, currency, share.currency); response != nil {
+			return response, nil
+		}
+	}
+
+	// the cost is split in minor units of the currency
+	entered, err := enteredAmount(cost, currency.code)
+	if err != nil {
+		return incorrect, nil
+	}
+	for _, share := range shares {
+		if share.amount == 0 {
+			continue
+		}
+
+		enteredShare, err := enteredAmount(share.amount, currency.code)
+		if err != nil {
+			return incorrect, nil
+		}
+		share.amount = enteredShare.Amount
+	}
+
+	own, ok := splitEqually(entered.Amount, shares)
+	if !ok {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Get(i18n.KeySplitExceeded)),
+		}, nil
+	}
+
+	debts := make([]*models.Debt, 0, len(shares))
+	for _, share := range shares {
+		converted, err := h.toDefaultCurrency(share.amount, currency)
+		if err != nil {
+			return incorrect, nil
+		}
+		debts = append(debts, models.NewDebt(message.From.ID, share.user.ID, converted.Amount, lines[0]))
+	}
+
+	// the share of the payer is zero if the payer has paid only for the others
+	var waste *models.Waste
+	if own > 0 {
+		ownDefault, err := h.toDefaultCurrency(own, currency)
+		if err != nil {
+			return incorrect, nil
+		}
+
+		waste = models.NewWaste(lines[0], ownDefault.Amount, details.date).
+			WithOriginal(own, currency.code).
+			WithNote(details.note, details.tags)
+	}
+
+	err = h.debtRepo.AddSplitWaste(ctx, message.From.ID, waste, debts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add split waste: %w", err)
+	}
+
+	err = h.userContextService.SetContext(ctx, message.From.ID, enums.NoContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set context for user: %w", err)
+	}
+
+	msg := h.formatter.NewMessage().
+		Text(localizer.Getf(i18n.KeySplitSuccess, currency.format(money.New(own, currency.code)))).Line()
+	notNotified := make([]string, 0)
+	for i, share := range shares {
+		msg.Line().Textf("@%s — %s", share.user.UserName, currency.format(money.New(share.amount, currency.code)))
+
+		err := h.notifyDebtor(ctx, message.From, share.user, lines[0], debts[i].Amount)
+		if err != nil {
+			notNotified = append(notNotified, "@"+share.user.UserName)
+		}
+	}
+
+	if len(notNotified) > 0 {
+		msg.Line().Line().Italic(localizer.Getf(i18n.KeySplitNotNotified, strings.Join(notNotified, ", ")))
+	}
+
+	return &bot.MessageResponse{
+		Message: addRatesWarning(msg, localizer, currency.staleAge).String(),
+	}, nil
+}
+
+// notifyDebtor sends the share of the split waste to the debtor in the currency and the language of the debtor.
+func (h *MessageHandlers) notifyDebtor(
+	ctx context.Context,
+	payer *models.User,
+	debtor *models.User,
+	category string,
+	debt int64,
+) error {
+	currency, err := h.getCurrencyOfUser(ctx, debtor.ID)
+	if err != nil {
+		return err
+	}
+
+	converted, err := h.fromDefaultCurrency(debt, currency)
+	if err != nil {
+		return err
+	}
+
+	localizer := i18n.New(debtor.GetLanguage())
+	text := h.formatter.Text(localizer.Getf(i18n.KeySplitNotification,
+		displayName(payer.UserName, payer.FirstName), category, currency.format(converted)))
+
+	return h.notifyUser(ctx, debtor.ID, text)
+}
+
+// parseSplitShares parses the participants, like "@anna @bob:500", the usernames can not be repeated.
+func parseSplitShares(line string) ([]*splitShare, bool) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || len(fields) > maxSplitParticipants {
+		return nil, false
+	}
+
+	shares := make([]*splitShare, 0, len(fields))
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		if !strings.HasPrefix(field, "@") {
+			return nil, false
+		}
+
+		userName, shareText, hasAmount := strings.Cut(field[1:], ":")
+		if userName == "" || seen[strings.ToLower(userName)] {
+			return nil, false
+		}
+		seen[strings.ToLower(userName)] = true
+
+		share := &splitShare{userName: userName}
+		if hasAmount {
+			cost, currency, err := amount.Parse(shareText)
+			if err != nil || cost <= 0 {
+				return nil, false
+			}
+			share.amount, share.currency = cost, currency
+		}
+		shares = append(shares, share)
+	}
+
+	return shares, true
+}
+
+// splitEqually sets the equal shares of the participants without the amount and returns the share of the payer,
+// the payer gets the remainder of the division. False is returned if the shares exceed the cost.
+func splitEqually(cost int64, shares []*splitShare) (int64, bool) {
+	rest := cost
+	equal := make([]*splitShare, 0, len(shares))
+	for _, share := range shares {
+		if share.amount == 0 {
+			equal = append(equal, share)
+			continue
+		}
+		rest -= share.amount
+	}
+
+	if rest < 0 {
+		return 0, false
+	}
+	if len(equal) == 0 {
+		return rest, true
+	}
+
+	each := rest / int64(len(equal)+1)
+	if each == 0 {
+		return 0, false
+	}
+	for _, share := range equal {
+		share.amount = each
+	}
+
+	return rest - each*int64(len(equal)), true
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/requests"
+)
+
+var reportStatusDescriptions = map[enums.ReportStatus]i18n.Key{
+	enums.ReportStatusQueued:     i18n.KeyReportStatusQueued,
+	enums.ReportStatusProcessing: i18n.KeyReportStatusProcessing,
+	enums.ReportStatusFailed:     i18n.KeyReportStatusFailed,
+}
+
+var reportPeriodDescriptions = map[requests.Period]i18n.Key{
+	requests.PeriodWeek:   i18n.KeyPeriodWeek,
+	requests.PeriodMonth:  i18n.KeyPeriodMonth,
+	requests.PeriodYear:   i18n.KeyPeriodYear,
+	requests.PeriodClaims: i18n.KeyPeriodClaims,
+}
+
+func (h *MessageHandlers) statusHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	reportRequests, err := h.reportStatusService.GetUserRequests(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report requests of user: %w", err)
+	}
+
+	localizer := h.localizer(message)
+	msg := h.formatter.NewMessage().Text(localizer.Get(i18n.KeyPendingReports)).Line()
+	pending := 0
+	for _, request := range reportRequests {
+		if request.Status == enums.ReportStatusDelivered {
+			continue
+		}
+
+		msg.Line().Text(localizer.Getf(i18n.KeyPendingReport,
+			localizer.Get(reportPeriodDescriptions[request.Period]),
+			localizer.Get(reportStatusDescriptions[request.Status]),
+			localizer.Plural(i18n.KeyMinutesAgo, int(time.Since(request.CreatedAt).Minutes()))))
+		pending++
+	}
+
+	if pending == 0 {
+		return &bot.MessageResponse{
+			Message: h.formatter.Text(localizer.Get(i18n.KeyNoPendingReports)),
+		}, nil
+	}
+
+	return &bot.MessageResponse{
+		Message: msg.String(),
+	}, nil
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/bot"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+func (h *MessageHandlers) tokenHandler(ctx context.Context, message *models.Message) (*bot.MessageResponse, error) {
+	token, err := h.tokenService.Issue(ctx, message.From.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue api token: %w", err)
+	}
+
+	localizer := h.localizer(message)
+
+	return &bot.MessageResponse{
+		Message: h.formatter.NewMessage().
+			Text(localizer.Get(i18n.KeyToken)).Code(token).Line().
+			Line().
+			Text(localizer.Get(i18n.KeyTokenUsage)).
+			String(),
+	}, nil
+}
@@ -2,8 +2,11 @@ package bot
 
 import (
 	"context"
+	"fmt"
 
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
 )
 
@@ -11,23 +14,40 @@ import (
 //
 // Separate from Bot for metrics and tracer.
 type IterationMessage struct {
-	tgClient telegramClient
+	tgClient  telegramClient
+	parseMode enums.ParseMode
 }
 
-func NewIterationMessage(tgClient telegramClient) *IterationMessage {
+// NewIterationMessage creates the iteration, the parse mode is used for responses with inline keyboard.
+func NewIterationMessage(tgClient telegramClient, parseMode enums.ParseMode) *IterationMessage {
 	return &IterationMessage{
-		tgClient: tgClient,
+		tgClient:  tgClient,
+		parseMode: parseMode,
 	}
 }
 
-// Iterate runs the message handler.
+// Iterate runs the message handler, the response to the message is sent only once.
 func (i *IterationMessage) Iterate(ctx context.Context, message *models.Message, handler MessageHandler, logger log.Logger) {
+	key := fmt.Sprintf("reply_%d_%d", message.From.ID, message.ID)
+	if message.CallbackID != "" {
+		// the button of the same message can be pressed many times
+		key = fmt.Sprintf("callback_%d_%s", message.From.ID, message.CallbackID)
+
+		if err := i.tgClient.AnswerCallback(ctx, message.CallbackID); err != nil {
+			logger.WithError(err).
+				With("message", message).
+				Warn("failed to answer the callback")
+		}
+	}
+	ctx = models.ContextWithIdempotencyKey(ctx, key)
+	ctx = models.ContextWithLanguage(ctx, message.From.GetLanguage())
+
 	response, err := handler(ctx, message)
 	if err != nil {
 		logger.WithError(err).
 			With("message", message).
 			Error("failed to respond the message")
-		err := i.tgClient.SendMessage(ctx, message.From.ID, messageInternalError)
+		err := i.tgClient.SendMessage(ctx, message.From.ID, i18n.New(message.From.GetLanguage()).Get(i18n.KeyInternalError))
 		if err != nil {
 			logger.WithError(err).
 				With("response", response).
@@ -37,7 +57,9 @@ func (i *IterationMessage) Iterate(ctx context.Context, message *models.Message,
 		return
 	}
 
-	if response.Keyboard != nil {
+	if response.InlineKeyboard != nil {
+		err = i.sendInline(ctx, message, response)
+	} else if response.Keyboard != nil {
 		err = i.tgClient.SendKeyboard(ctx, message.From.ID, response.Message, response.Keyboard)
 	} else if response.DoNotRemoveKeyboard {
 		err = i.tgClient.SendMessageWithoutRemovingKeyboard(ctx, message.From.ID, response.Message)
@@ -52,3 +74,23 @@ func (i *IterationMessage) Iterate(ctx context.Context, message *models.Message,
 			Error("failed to send the message")
 	}
 }
+
+// sendInline edits the message with the pressed button or sends the new message with the inline keyboard.
+func (i *IterationMessage) sendInline(ctx context.Context, message *models.Message, response *MessageResponse) error {
+	outgoing := &models.OutgoingMessage{
+		UserID:    message.From.ID,
+		Text:      response.Message,
+		ParseMode: i.parseMode,
+		Keyboard: &models.Keyboard{
+			Type: enums.KeyboardInline,
+			Rows: response.InlineKeyboard,
+		},
+	}
+
+	if message.CallbackID != "" {
+		return i.tgClient.EditMessage(ctx, message.ID, outgoing)
+	}
+
+	_, err := i.tgClient.SendFormattedMessage(ctx, outgoing)
+	return err
+}
@@ -3,7 +3,12 @@ package bot
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
@@ -28,10 +33,12 @@ func LoggerMiddleware(l log.Logger) MessageMiddleware {
 type userRepository interface {
 	UserExists(ctx context.Context, id int64) (bool, error)
 	AddUser(ctx context.Context, user *models.User) (*models.User, error)
+	UpdateNames(ctx context.Context, user *models.User) error
 }
 
 // CheckUserMiddleware middleware for adding new users
 // and make sure that user exists during the running message handler.
+// The names of existing users are updated, so they can be found by the new username.
 func CheckUserMiddleware(userRepo userRepository) MessageMiddleware {
 	middleware := func(next MessageHandler) MessageHandler {
 		return func(ctx context.Context, message *models.Message) (*MessageResponse, error) {
@@ -45,6 +52,41 @@ func CheckUserMiddleware(userRepo userRepository) MessageMiddleware {
 				if err != nil {
 					return nil, fmt.Errorf("failed to adding user: %w", err)
 				}
+			} else {
+				err := userRepo.UpdateNames(ctx, message.From)
+				if err != nil {
+					return nil, fmt.Errorf("failed to update names of user: %w", err)
+				}
+			}
+
+			return next(ctx, message)
+		}
+	}
+
+	return middleware
+}
+
+//go:generate mockery --name=languageRepository --dir . --output ./mocks --exported
+type languageRepository interface {
+	GetLanguage(ctx context.Context, id int64) (enums.Language, error)
+}
+
+// LanguageMiddleware sets the language chosen by the user to the user of the message,
+// the language of telegram client is used if the language can not be got.
+func LanguageMiddleware(userRepo languageRepository, logger log.Logger) MessageMiddleware {
+	logger = logger.With(log.ComponentKey, "Language middleware")
+	middleware := func(next MessageHandler) MessageHandler {
+		return func(ctx context.Context, message *models.Message) (*MessageResponse, error) {
+			language, err := userRepo.GetLanguage(ctx, message.From.ID)
+			if err != nil {
+				logger.WithError(err).
+					Warn("failed to get language of the user")
+				return next(ctx, message)
+			}
+
+			if language != "" {
+				value := string(language)
+				message.From.Language = &value
 			}
 
 			return next(ctx, message)
@@ -72,7 +114,7 @@ func CacheMiddleware(cacheService cacheService, logger log.Logger) MessageMiddle
 			}
 
 			switch command {
-			case enums.CommandTypeCurrency:
+			case enums.CommandTypeCurrency, enums.CommandTypeLanguage:
 				err = cacheService.Clear(ctx, message.From.ID, enums.CommandTypeGetLimit)
 				if err != nil {
 					logger.WithError(err).
@@ -80,7 +122,7 @@ func CacheMiddleware(cacheService cacheService, logger log.Logger) MessageMiddle
 				}
 				fallthrough
 
-			case enums.CommandTypeAdd:
+			case enums.CommandTypeAdd, enums.CommandTypeSplit:
 				err = cacheService.ClearKeys(ctx, message.From.ID,
 					enums.CommandTypeWeekReport,
 					enums.CommandTypeMonthReport,
@@ -92,6 +134,11 @@ func CacheMiddleware(cacheService cacheService, logger log.Logger) MessageMiddle
 				}
 				return next(ctx, message)
 
+			case enums.CommandTypeStatus, enums.CommandTypeToken, enums.CommandTypeRates, enums.CommandTypeConvert,
+				enums.CommandTypeFind, enums.CommandTypeClaims, enums.CommandTypeSettle,
+				enums.CommandTypeGoal:
+				return next(ctx, message)
+
 			case enums.CommandTypeSetLimit:
 				err = cacheService.Clear(ctx, message.From.ID, enums.CommandTypeGetLimit)
 				if err != nil {
@@ -126,3 +173,46 @@ func CacheMiddleware(cacheService cacheService, logger log.Logger) MessageMiddle
 
 	return middleware
 }
+
+//go:generate mockery --name=rateLimiter --dir . --output ./mocks --exported
+type rateLimiter interface {
+	Allow(ctx context.Context, userID int64, class enums.CommandClass) (bool, time.Duration, error)
+}
+
+// RateLimitMiddleware answers with the throttling message instead of running the handler
+// if the user sends too many commands, messages are not limited if the limiter fails.
+func RateLimitMiddleware(limiter rateLimiter, formatter *format.Formatter, logger log.Logger) MessageMiddleware {
+	logger = logger.With(log.ComponentKey, "Rate limit middleware")
+	middleware := func(next MessageHandler) MessageHandler {
+		return func(ctx context.Context, message *models.Message) (*MessageResponse, error) {
+			// commands with arguments, like "/week EUR", are limited as the command itself
+			name, _, _ := strings.Cut(message.Text, " ")
+			command, _ := enums.ParseCommandType(name)
+
+			allowed, retryAfter, err := limiter.Allow(ctx, message.From.ID, enums.GetCommandClass(command))
+			if err != nil {
+				logger.WithError(err).
+					Warn("failed to check rate limit of the user")
+				return next(ctx, message)
+			}
+
+			if !allowed {
+				logger.With("user_id", message.From.ID).
+					With("command", command).
+					Info("user has been throttled")
+
+				localizer := i18n.New(message.From.GetLanguage())
+				seconds := int(math.Ceil(retryAfter.Seconds()))
+
+				return &MessageResponse{
+					Message:             formatter.Text(localizer.Plural(i18n.KeyTooManyRequests, seconds)),
+					DoNotRemoveKeyboard: true,
+				}, nil
+			}
+
+			return next(ctx, message)
+		}
+	}
+
+	return middleware
+}
@@ -0,0 +1,113 @@
+package bot
+
+import (
+	"context"
+	"sync"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+// defaultQueueSize is used if the size is not set in the config,
+// otherwise messages are dropped whenever the worker is busy.
+const defaultQueueSize = 32
+
+type Config struct {
+	// Workers is an amount of messages processed concurrently.
+	Workers int `yaml:"workers"`
+	// QueueSize is a capacity of the queue of every worker,
+	// messages are dropped when the queue is full.
+	QueueSize int `yaml:"queue_size"`
+}
+
+//go:generate mockery --name=poolMetrics --dir . --output ./mocks --exported
+type poolMetrics interface {
+	SetQueueDepth(worker int, depth int)
+	IncDropped()
+}
+
+// workerPool processes messages concurrently, messages are sharded by the user,
+// so messages of one user are processed in the order they were received.
+type workerPool struct {
+	queues  []chan *models.Message
+	metrics poolMetrics
+	handle  func(ctx context.Context, message *models.Message)
+
+	wg sync.WaitGroup
+}
+
+func newWorkerPool(config Config, metrics poolMetrics, handle func(ctx context.Context, message *models.Message)) *workerPool {
+	workers := config.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	queueSize := config.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+
+	queues := make([]chan *models.Message, workers)
+	for i := range queues {
+		queues[i] = make(chan *models.Message, queueSize)
+	}
+
+	return &workerPool{
+		queues:  queues,
+		metrics: metrics,
+		handle:  handle,
+	}
+}
+
+func (p *workerPool) start(ctx context.Context) {
+	for i := range p.queues {
+		p.wg.Add(1)
+		go p.work(ctx, i)
+	}
+}
+
+// close stops accepting messages, workers handle the queued messages and stop.
+func (p *workerPool) close() {
+	for _, queue := range p.queues {
+		close(queue)
+	}
+}
+
+// wait blocks until all workers are stopped.
+func (p *workerPool) wait() {
+	p.wg.Wait()
+}
+
+// submit puts the message to the queue of its user,
+// returns false if the queue is full and the message is dropped.
+func (p *workerPool) submit(message *models.Message) bool {
+	worker := p.shard(message.From.ID)
+	queue := p.queues[worker]
+
+	select {
+	case queue <- message:
+		p.metrics.SetQueueDepth(worker, len(queue))
+		return true
+	default:
+		p.metrics.IncDropped()
+		return false
+	}
+}
+
+func (p *workerPool) shard(userID int64) int {
+	return int(uint64(userID) % uint64(len(p.queues)))
+}
+
+func (p *workerPool) work(ctx context.Context, worker int) {
+	defer p.wg.Done()
+
+	queue := p.queues[worker]
+	for message := range queue {
+		// the rest of the queue can not be handled after canceling,
+		// it is not marked as handled, so telegram sends it again after restart
+		if ctx.Err() != nil {
+			return
+		}
+
+		p.metrics.SetQueueDepth(worker, len(queue))
+		p.handle(ctx, message)
+	}
+}
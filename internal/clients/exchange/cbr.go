@@ -0,0 +1,82 @@
+package exchange
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/clients/exchange/dto"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+// cbrCurrency is the currency of values in the feed of the Central Bank of Russia.
+const cbrCurrency = "RUB"
+
+// CBRClient is the provider of the daily XML feed of the Central Bank of Russia,
+// rates to other bases are calculated as cross rates through the ruble.
+type CBRClient struct {
+	endpoint   string
+	httpClient http.Client
+}
+
+func NewCBRClient(config ProviderConfig) (*CBRClient, error) {
+	if config.Endpoint == "" {
+		return nil, ErrEmptyEndpoint
+	}
+
+	return &CBRClient{
+		endpoint:   config.Endpoint,
+		httpClient: http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+func (c *CBRClient) Name() string {
+	return string(ProviderCBR)
+}
+
+func (c *CBRClient) GetExchange(ctx context.Context, base string, symbols []string) (*models.ExchangeData, error) {
+	resp, err := doRequest(ctx, c.httpClient, c.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result dto.CBRDaily
+	decoder := xml.NewDecoder(resp.Body)
+	decoder.CharsetReader = charsetReader
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("parse body: %w", err)
+	}
+
+	rates := make(map[string]float64, len(result.Valutes))
+	for _, valute := range result.Valutes {
+		value, err := strconv.ParseFloat(strings.Replace(valute.Value, ",", ".", 1), 64)
+		if err != nil || value <= 0 || valute.Nominal <= 0 {
+			continue
+		}
+
+		rates[valute.CharCode] = float64(valute.Nominal) / value
+	}
+
+	converted, err := crossRates(cbrCurrency, rates, base, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewExchangeData(base, converted), nil
+}
+
+// charsetReader decodes the feed, which is served in windows-1251.
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	if strings.EqualFold(charset, "windows-1251") {
+		return charmap.Windows1251.NewDecoder().Reader(input), nil
+	}
+
+	return nil, fmt.Errorf("unsupported charset %q", charset)
+}
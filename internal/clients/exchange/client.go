@@ -12,20 +12,18 @@ import (
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 )
 
-type Config struct {
-	Endpoint string `yaml:"endpoint"`
-}
-
+// Client is the provider of services with the format of exchangerate.host,
+// the base and the symbols are passed in the query.
 type Client struct {
 	endpoint   *url.URL
 	httpClient http.Client
 }
 
-func NewClient(config Config) (*Client, error) {
-	return NewClientWithHttpClient(config, http.Client{})
+func NewClient(config ProviderConfig) (*Client, error) {
+	return NewClientWithHttpClient(config, http.Client{Timeout: config.Timeout})
 }
 
-func NewClientWithHttpClient(config Config, httpClient http.Client) (*Client, error) {
+func NewClientWithHttpClient(config ProviderConfig, httpClient http.Client) (*Client, error) {
 	endpoint, err := url.Parse(config.Endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse endpoint: %w", err)
@@ -37,27 +35,50 @@ func NewClientWithHttpClient(config Config, httpClient http.Client) (*Client, er
 	}, nil
 }
 
+func (c *Client) Name() string {
+	return string(ProviderExchangeRateHost)
+}
+
 func (c *Client) GetExchange(ctx context.Context, base string, symbols []string) (*models.ExchangeData, error) {
-	reqURL := c.endpoint
+	reqURL := *c.endpoint
 	values := reqURL.Query()
 	values.Add("base", base)
 	values.Add("symbols", strings.Join(symbols, ","))
 	reqURL.RawQuery = values.Encode()
 
-	request, err := http.NewRequestWithContext(ctx, "GET", reqURL.String(), nil)
+	resp, err := doRequest(ctx, c.httpClient, reqURL.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result dto.ExchangeData
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("parse body: %w", err)
+	}
+
+	if !result.Success {
+		return nil, ErrRequestFailed
+	}
+
+	return models.NewExchangeData(result.Base, result.Rates), nil
+}
+
+func doRequest(ctx context.Context, httpClient http.Client, reqURL string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	resp, err := c.httpClient.Do(request)
+	resp, err := httpClient.Do(request)
 	if err != nil {
 		return nil, fmt.Errorf("do request: %w", err)
 	}
 
-	var result dto.ExchangeData
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("parse body: %w", err)
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: status %d", ErrRequestFailed, resp.StatusCode)
 	}
 
-	return models.NewExchangeData(result.Base, result.Rates), nil
+	return resp, nil
 }
@@ -0,0 +1,17 @@
+package dto
+
+import "encoding/xml"
+
+// CBRDaily is the daily feed of the Central Bank of Russia, values are in rubles
+// for the nominal amount of the currency with the comma as decimal separator.
+type CBRDaily struct {
+	XMLName xml.Name    `xml:"ValCurs"`
+	Date    string      `xml:"Date,attr"`
+	Valutes []CBRValute `xml:"Valute"`
+}
+
+type CBRValute struct {
+	CharCode string `xml:"CharCode"`
+	Nominal  int64  `xml:"Nominal"`
+	Value    string `xml:"Value"`
+}
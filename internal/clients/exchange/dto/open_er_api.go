@@ -0,0 +1,8 @@
+package dto
+
+type OpenERAPIData struct {
+	Result    string             `json:"result"`
+	ErrorType string             `json:"error-type"`
+	BaseCode  string             `json:"base_code"`
+	Rates     map[string]float64 `json:"rates"`
+}
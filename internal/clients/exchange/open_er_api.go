@@ -0,0 +1,54 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/clients/exchange/dto"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+// OpenERAPIClient is the provider of services with the format of open.er-api.com,
+// the base is passed in the path and rates of all currencies are returned.
+type OpenERAPIClient struct {
+	endpoint   *url.URL
+	httpClient http.Client
+}
+
+func NewOpenERAPIClient(config ProviderConfig) (*OpenERAPIClient, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+
+	return &OpenERAPIClient{
+		endpoint:   endpoint,
+		httpClient: http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+func (c *OpenERAPIClient) Name() string {
+	return string(ProviderOpenERAPI)
+}
+
+func (c *OpenERAPIClient) GetExchange(ctx context.Context, base string, symbols []string) (*models.ExchangeData, error) {
+	resp, err := doRequest(ctx, c.httpClient, c.endpoint.JoinPath(base).String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result dto.OpenERAPIData
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("parse body: %w", err)
+	}
+
+	if result.Result != "success" {
+		return nil, fmt.Errorf("%w: %s", ErrRequestFailed, result.ErrorType)
+	}
+
+	return models.NewExchangeData(result.BaseCode, filterSymbols(result.Rates, symbols)), nil
+}
@@ -0,0 +1,126 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+// ProviderType is the format of the source of exchange rates.
+type ProviderType string
+
+const (
+	// ProviderExchangeRateHost is the api with base and symbols in the query, like exchangerate.host.
+	ProviderExchangeRateHost ProviderType = "exchangerate_host"
+	// ProviderOpenERAPI is the api with base in the path, like open.er-api.com.
+	ProviderOpenERAPI ProviderType = "open_er_api"
+	// ProviderCBR is the daily XML feed of the Central Bank of Russia.
+	ProviderCBR ProviderType = "cbr"
+	// ProviderStatic is the YAML file with fixed rates for offline and test environments.
+	ProviderStatic ProviderType = "static"
+)
+
+const defaultTimeout = 10 * time.Second
+
+var (
+	ErrUnknownProvider = errors.New("unknown type of exchange provider")
+	ErrRequestFailed   = errors.New("exchange request failed")
+	ErrUnknownBase     = errors.New("base currency is not supported by provider")
+	ErrEmptyEndpoint   = errors.New("endpoint of exchange provider is empty")
+)
+
+type Config struct {
+	// Endpoint is the exchangerate.host endpoint used when providers are not set.
+	Endpoint string `yaml:"endpoint"`
+
+	// Providers are the sources of rates in order of priority.
+	Providers []ProviderConfig `yaml:"providers"`
+}
+
+type ProviderConfig struct {
+	Type     ProviderType  `yaml:"type"`
+	Endpoint string        `yaml:"endpoint"`
+	Path     string        `yaml:"path"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+// Provider returns rates of the symbols, how many units of the symbol costs one unit of the base.
+type Provider interface {
+	Name() string
+	GetExchange(ctx context.Context, base string, symbols []string) (*models.ExchangeData, error)
+}
+
+// NewProviders creates providers in order of priority of the config.
+func NewProviders(config Config) ([]Provider, error) {
+	configs := config.Providers
+	if len(configs) == 0 {
+		configs = []ProviderConfig{{Type: ProviderExchangeRateHost, Endpoint: config.Endpoint}}
+	}
+
+	providers := make([]Provider, 0, len(configs))
+	for _, providerConfig := range configs {
+		provider, err := NewProvider(providerConfig)
+		if err != nil {
+			return nil, fmt.Errorf("create provider %s: %w", providerConfig.Type, err)
+		}
+
+		providers = append(providers, provider)
+	}
+
+	return providers, nil
+}
+
+func NewProvider(config ProviderConfig) (Provider, error) {
+	if config.Timeout == 0 {
+		config.Timeout = defaultTimeout
+	}
+
+	switch config.Type {
+	case ProviderExchangeRateHost:
+		return NewClient(config)
+	case ProviderOpenERAPI:
+		return NewOpenERAPIClient(config)
+	case ProviderCBR:
+		return NewCBRClient(config)
+	case ProviderStatic:
+		return NewStaticProvider(config), nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, config.Type)
+}
+
+// filterSymbols keeps only the requested symbols, all rates are kept if symbols are not set.
+func filterSymbols(rates map[string]float64, symbols []string) map[string]float64 {
+	if len(symbols) == 0 {
+		return rates
+	}
+
+	result := make(map[string]float64, len(symbols))
+	for _, symbol := range symbols {
+		if rate, ok := rates[symbol]; ok {
+			result[symbol] = rate
+		}
+	}
+
+	return result
+}
+
+// crossRates converts the rates relative to the reference currency to the rates relative to the base.
+func crossRates(reference string, rates map[string]float64, base string, symbols []string) (map[string]float64, error) {
+	rates[reference] = 1
+
+	baseRate, ok := rates[base]
+	if !ok || baseRate <= 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownBase, base)
+	}
+
+	result := make(map[string]float64, len(rates))
+	for symbol, rate := range filterSymbols(rates, symbols) {
+		result[symbol] = rate / baseRate
+	}
+
+	return result, nil
+}
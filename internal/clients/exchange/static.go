@@ -0,0 +1,59 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+// StaticProvider reads fixed rates from the YAML file, the file is read on each request,
+// so rates can be changed without restart.
+//
+//	base: "RUB"
+//	rates:
+//	  USD: 0.0108
+type StaticProvider struct {
+	path string
+}
+
+type staticRates struct {
+	Base  string             `yaml:"base"`
+	Rates map[string]float64 `yaml:"rates"`
+}
+
+func NewStaticProvider(config ProviderConfig) *StaticProvider {
+	return &StaticProvider{
+		path: config.Path,
+	}
+}
+
+func (p *StaticProvider) Name() string {
+	return string(ProviderStatic)
+}
+
+func (p *StaticProvider) GetExchange(_ context.Context, base string, symbols []string) (*models.ExchangeData, error) {
+	rawYAML, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("read rates file: %w", err)
+	}
+
+	var file staticRates
+	if err := yaml.Unmarshal(rawYAML, &file); err != nil {
+		return nil, fmt.Errorf("parse rates file: %w", err)
+	}
+
+	if file.Rates == nil {
+		file.Rates = make(map[string]float64)
+	}
+
+	rates, err := crossRates(file.Base, file.Rates, base, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewExchangeData(base, rates), nil
+}
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/api"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+func newParseMode(parseMode enums.ParseMode) api.ParseMode {
+	switch parseMode {
+	case enums.ParseModeMarkdownV2:
+		return api.ParseMode_PARSE_MODE_MARKDOWN_V2
+	case enums.ParseModeHTML:
+		return api.ParseMode_PARSE_MODE_HTML
+	case enums.ParseModePlain:
+		return api.ParseMode_PARSE_MODE_PLAIN
+	default:
+		return api.ParseMode_PARSE_MODE_MARKDOWN
+	}
+}
+
+func newKeyboard(keyboard *models.Keyboard) *api.Keyboard {
+	if keyboard == nil {
+		return nil
+	}
+
+	rows := make([]*api.KeyboardRow, 0, len(keyboard.Rows))
+	for _, row := range keyboard.Rows {
+		buttons := make([]*api.KeyboardButton, 0, len(row))
+		for _, button := range row {
+			buttons = append(buttons, &api.KeyboardButton{
+				Text:         button.Text,
+				CallbackData: button.CallbackData,
+				Url:          button.URL,
+			})
+		}
+		rows = append(rows, &api.KeyboardRow{
+			Buttons: buttons,
+		})
+	}
+
+	var keyboardType api.KeyboardType
+	switch keyboard.Type {
+	case enums.KeyboardKeep:
+		keyboardType = api.KeyboardType_KEYBOARD_TYPE_KEEP
+	case enums.KeyboardReply:
+		keyboardType = api.KeyboardType_KEYBOARD_TYPE_REPLY
+	case enums.KeyboardInline:
+		keyboardType = api.KeyboardType_KEYBOARD_TYPE_INLINE
+	default:
+		keyboardType = api.KeyboardType_KEYBOARD_TYPE_REMOVE
+	}
+
+	return &api.Keyboard{
+		Type:    keyboardType,
+		Rows:    rows,
+		OneTime: keyboard.OneTime,
+	}
+}
+
+func newMessage(message *models.OutgoingMessage, command enums.CommandType) *api.Message {
+	return &api.Message{
+		UserId:    message.UserID,
+		Text:      message.Text,
+		Command:   string(command),
+		ParseMode: newParseMode(message.ParseMode),
+		Keyboard:  newKeyboard(message.Keyboard),
+	}
+}
+
+func newFile(file *models.OutgoingFile, idempotencyKey string) *api.File {
+	result := &api.File{
+		UserId:         file.UserID,
+		FileName:       file.FileName,
+		Caption:        file.Caption,
+		ParseMode:      newParseMode(file.ParseMode),
+		Keyboard:       newKeyboard(file.Keyboard),
+		IdempotencyKey: idempotencyKey,
+	}
+
+	if file.URL != "" {
+		result.Source = &api.File_Url{Url: file.URL}
+	} else {
+		result.Source = &api.File_Content{Content: file.Content}
+	}
+
+	return result
+}
@@ -2,12 +2,14 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/api"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
 )
@@ -51,9 +53,98 @@ func (b *TelegramBot) Stop(ctx context.Context) error {
 
 func (b *TelegramBot) SendMessage(ctx context.Context, userID int64, text string, command enums.CommandType) error {
 	_, err := b.client.SendMessage(ctx, &api.Message{
-		UserId:  userID,
-		Text:    text,
-		Command: string(command),
+		UserId:         userID,
+		Text:           text,
+		Command:        string(command),
+		IdempotencyKey: models.IdempotencyKeyFromContext(ctx),
+		Language:       string(models.LanguageFromContext(ctx)),
+	})
+	return err
+}
+
+// SendFormattedMessage sends the message with chosen parse mode and keyboard,
+// returns the identifier of the sent message.
+func (b *TelegramBot) SendFormattedMessage(
+	ctx context.Context, message *models.OutgoingMessage, command enums.CommandType,
+) (int, error) {
+	msg := newMessage(message, command)
+	msg.IdempotencyKey = models.IdempotencyKeyFromContext(ctx)
+	msg.Language = string(models.LanguageFromContext(ctx))
+
+	sent, err := b.client.SendMessage(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(sent.GetMessageId()), nil
+}
+
+// SendMessages sends the messages by one request, results are in the same order as messages.
+// The idempotency key of the context is extended by the index of the message.
+func (b *TelegramBot) SendMessages(
+	ctx context.Context, messages []*models.OutgoingMessage, command enums.CommandType,
+) ([]models.SendResult, error) {
+	key := models.IdempotencyKeyFromContext(ctx)
+
+	batch := &api.MessageBatch{
+		Messages: make([]*api.Message, 0, len(messages)),
+	}
+	for i, message := range messages {
+		msg := newMessage(message, command)
+		msg.Language = string(models.LanguageFromContext(ctx))
+		if key != "" {
+			msg.IdempotencyKey = fmt.Sprintf("%s_%d", key, i)
+		}
+		batch.Messages = append(batch.Messages, msg)
+	}
+
+	resp, err := b.client.SendMessages(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.SendResult, 0, len(resp.GetResults()))
+	for _, result := range resp.GetResults() {
+		var err error
+		if result.GetError() != "" {
+			err = errors.New(result.GetError())
+		}
+
+		results = append(results, models.SendResult{
+			MessageID: int(result.GetMessageId()),
+			Err:       err,
+		})
+	}
+
+	return results, nil
+}
+
+func (b *TelegramBot) SendPhoto(ctx context.Context, file *models.OutgoingFile) (int, error) {
+	sent, err := b.client.SendPhoto(ctx, newFile(file, models.IdempotencyKeyFromContext(ctx)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(sent.GetMessageId()), nil
+}
+
+func (b *TelegramBot) SendDocument(ctx context.Context, file *models.OutgoingFile) (int, error) {
+	sent, err := b.client.SendDocument(ctx, newFile(file, models.IdempotencyKeyFromContext(ctx)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(sent.GetMessageId()), nil
+}
+
+// EditMessage replaces the text of the sent message, only inline keyboard can be set.
+func (b *TelegramBot) EditMessage(ctx context.Context, messageID int, message *models.OutgoingMessage) error {
+	_, err := b.client.EditMessage(ctx, &api.EditedMessage{
+		UserId:    message.UserID,
+		MessageId: int32(messageID),
+		Text:      message.Text,
+		ParseMode: newParseMode(message.ParseMode),
+		Keyboard:  newKeyboard(message.Keyboard),
 	})
 	return err
 }
@@ -2,58 +2,242 @@ package telegram
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
 )
 
+var ErrEditNotInlineKeyboard = errors.New("only inline keyboard can be set to the edited message")
+
+const (
+	pollRetryDelay = 3 * time.Second
+
+	longMessageFileName = "message.txt"
+)
+
+const (
+	// ModePolling receives updates by long polling, it is the default mode.
+	ModePolling = "polling"
+	// ModeWebhook receives updates by the handler registered on the http router.
+	ModeWebhook = "webhook"
+)
+
 type Config struct {
 	Token         string `yaml:"token"`
 	Timeout       int    `yaml:"timeout"`
 	MessageBuffer int    `yaml:"message_buffer"`
+
+	Mode string `yaml:"mode"`
+	// APIEndpoint is a format of the bot api url with token and method,
+	// default is the official api, can be changed to the local fake server.
+	APIEndpoint string        `yaml:"api_endpoint"`
+	Webhook     WebhookConfig `yaml:"webhook"`
+	Outbox      OutboxConfig  `yaml:"outbox"`
+	// ParseMode is used for the messages sent without chosen parse mode,
+	// MarkdownV2 if it is not set.
+	ParseMode enums.ParseMode `yaml:"parse_mode"`
+	// MaxMessageParts is a maximum amount of parts of the long message,
+	// the longer message is sent as a text document. Zero means no limit.
+	MaxMessageParts int `yaml:"max_message_parts"`
 }
 
+// Client sends messages to telegram and receives updates from it.
+//
+// Receiving is started by Start and stopped by Stop, after that the channel
+// of updates is closed, so the receiver can handle the buffered messages.
+// Close confirms the received updates in polling mode and must be called
+// after the messages have been handled.
+//
+// Messages are sent through the outbox which retries them and respects the limits of telegram,
+// the message is sent only once for the idempotency key of the context.
 type Client struct {
 	client *tgbotapi.BotAPI
+	outbox *outbox
 	logger log.Logger
 
-	timeout int
+	mode      string
+	timeout   int
+	webhook   WebhookConfig
+	maxParts  int
+	parseMode enums.ParseMode
+
+	// receiveMu is held for reading while updates are sent to the channel by polling,
+	// Stop holds it for writing to wait for them before closing the channel.
+	receiveMu sync.RWMutex
+	receiving bool
+	stopped   chan struct{}
+	// webhookSends are the updates being sent to the channel by the webhook handler
+	// without holding receiveMu, Stop waits for them before closing the channel.
+	webhookSends sync.WaitGroup
+	// offset is an identifier of the next update for polling, the updates
+	// before it have been sent to the channel, changed only by listenUpdates.
+	offset int
+
+	// unhandled are the identifiers of the updates sent to the channel and not handled yet,
+	// Close confirms only the updates before the first of them.
+	unhandledMu sync.Mutex
+	unhandled   map[int]struct{}
 
 	messageUpdates chan *models.Message
 }
 
-func NewClient(config Config, logger log.Logger) (*Client, error) {
-	client, err := tgbotapi.NewBotAPI(config.Token)
+func NewClient(config Config, metrics outboxMetrics, logger log.Logger) (*Client, error) {
+	apiEndpoint := config.APIEndpoint
+	if apiEndpoint == "" {
+		apiEndpoint = tgbotapi.APIEndpoint
+	}
+
+	client, err := tgbotapi.NewBotAPIWithAPIEndpoint(config.Token, apiEndpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connecting to telegrag bot: %w", err)
 	}
 
-	c := &Client{
+	mode := config.Mode
+	if mode == "" {
+		mode = ModePolling
+	}
+	if mode != ModePolling && mode != ModeWebhook {
+		return nil, fmt.Errorf("unknown mode of receiving telegram updates %q", config.Mode)
+	}
+
+	parseMode := config.ParseMode
+	if parseMode == "" {
+		parseMode = enums.ParseModeMarkdownV2
+	}
+
+	logger = logger.With(log.ComponentKey, "Telegram client")
+
+	return &Client{
 		client:         client,
-		logger:         logger.With(log.ComponentKey, "Telegram client"),
+		outbox:         newOutbox(client, config.Outbox, metrics, logger),
+		logger:         logger,
+		mode:           mode,
 		timeout:        config.Timeout,
+		webhook:        config.Webhook,
+		maxParts:       config.MaxMessageParts,
+		parseMode:      parseMode,
+		stopped:        make(chan struct{}),
+		unhandled:      make(map[int]struct{}),
 		messageUpdates: make(chan *models.Message, config.MessageBuffer),
+	}, nil
+}
+
+// Start starts receiving updates from telegram.
+func (c *Client) Start() error {
+	if c.mode == ModeWebhook {
+		if err := c.setWebhook(); err != nil {
+			return err
+		}
+	}
+
+	c.receiveMu.Lock()
+	c.receiving = true
+	c.receiveMu.Unlock()
+
+	if c.mode == ModePolling {
+		go c.listenUpdates()
+	}
+
+	return nil
+}
+
+// Stop stops receiving updates and closes the channel of updates
+// when the received updates are sent to it.
+func (c *Client) Stop(ctx context.Context) error {
+	c.logger.Info("telegram client is stopping receiving updates")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		c.receiveMu.Lock()
+		receiving := c.receiving
+		if receiving {
+			c.receiving = false
+			close(c.stopped)
+		}
+		c.receiveMu.Unlock()
+
+		if receiving {
+			c.webhookSends.Wait()
+			close(c.messageUpdates)
+		}
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
+}
+
+// ParseMode returns the parse mode of the messages sent without chosen one.
+func (c *Client) ParseMode() enums.ParseMode {
+	return c.parseMode
+}
+
+// Close sends the queued messages and confirms the handled updates received by polling,
+// otherwise telegram sends them again after restart. The confirmation is made
+// by getting updates with the offset after them, the got update is not confirmed.
+//
+// Telegram confirms all updates before the offset, so the updates after the first unhandled one
+// are not confirmed too and the handled of them are received again after restart.
+func (c *Client) Close() error {
+	c.outbox.close()
 
-	go c.listenUpdates()
+	c.receiveMu.RLock()
+	offset := c.offset
+	c.receiveMu.RUnlock()
 
-	return c, nil
+	c.unhandledMu.Lock()
+	for updateID := range c.unhandled {
+		if updateID < offset {
+			offset = updateID
+		}
+	}
+	c.unhandledMu.Unlock()
+
+	if c.mode != ModePolling || offset == 0 {
+		return nil
+	}
+
+	u := tgbotapi.NewUpdate(offset)
+	u.Limit = 1
+
+	if _, err := c.client.GetUpdates(u); err != nil {
+		return fmt.Errorf("failed to confirm telegram updates: %w", err)
+	}
+
+	return nil
+}
+
+// MarkHandled marks the update of the message as handled, so it can be confirmed on closing.
+func (c *Client) MarkHandled(message *models.Message) {
+	c.unhandledMu.Lock()
+	delete(c.unhandled, message.UpdateID)
+	c.unhandledMu.Unlock()
 }
 
 func (c *Client) SendMessage(ctx context.Context, userID int64, text string) error {
 	msg := tgbotapi.NewMessage(userID, text)
 	msg.ReplyMarkup = tgbotapi.NewRemoveKeyboard(true)
-	msg.ParseMode = tgbotapi.ModeMarkdown
-	return c.sendMessage(msg)
+	msg.ParseMode = string(c.parseMode)
+	return c.sendMessage(ctx, msg)
 }
 
 func (c *Client) SendMessageWithoutRemovingKeyboard(ctx context.Context, userID int64, text string) error {
 	msg := tgbotapi.NewMessage(userID, text)
-	msg.ParseMode = tgbotapi.ModeMarkdown
-	return c.sendMessage(msg)
+	msg.ParseMode = string(c.parseMode)
+	return c.sendMessage(ctx, msg)
 }
 
 func (c *Client) GetUpdatesChan() <-chan *models.Message {
@@ -72,36 +256,252 @@ func (c *Client) SendKeyboard(ctx context.Context, userID int64, text string, ro
 	}
 
 	msg := tgbotapi.NewMessage(userID, text)
-	msg.ParseMode = tgbotapi.ModeMarkdown
+	msg.ParseMode = string(c.parseMode)
 	msg.ReplyMarkup = tgbotapi.NewReplyKeyboard(buttons...)
-	return c.sendMessage(msg)
+	return c.sendMessage(ctx, msg)
 }
 
-func (c *Client) sendMessage(msg tgbotapi.MessageConfig) error {
-	_, err := c.client.Send(msg)
+// SendFormattedMessage sends the message with chosen parse mode and keyboard,
+// returns the identifier of the sent message.
+func (c *Client) SendFormattedMessage(ctx context.Context, message *models.OutgoingMessage) (int, error) {
+	msg := tgbotapi.NewMessage(message.UserID, message.Text)
+	msg.ParseMode = string(message.ParseMode)
+
+	replyMarkup, err := newReplyMarkup(message.Keyboard)
 	if err != nil {
-		return fmt.Errorf("sending message to telegram: %w", err)
+		return 0, err
+	}
+	msg.ReplyMarkup = replyMarkup
+
+	return c.sendText(ctx, msg)
+}
+
+func (c *Client) SendPhoto(ctx context.Context, file *models.OutgoingFile) (int, error) {
+	photo := tgbotapi.NewPhoto(file.UserID, newRequestFile(file))
+	photo.Caption = file.Caption
+	photo.ParseMode = string(file.ParseMode)
+
+	replyMarkup, err := newReplyMarkup(file.Keyboard)
+	if err != nil {
+		return 0, err
+	}
+	photo.ReplyMarkup = replyMarkup
+
+	return c.send(ctx, photo)
+}
+
+func (c *Client) SendDocument(ctx context.Context, file *models.OutgoingFile) (int, error) {
+	document := tgbotapi.NewDocument(file.UserID, newRequestFile(file))
+	document.Caption = file.Caption
+	document.ParseMode = string(file.ParseMode)
+
+	replyMarkup, err := newReplyMarkup(file.Keyboard)
+	if err != nil {
+		return 0, err
+	}
+	document.ReplyMarkup = replyMarkup
+
+	return c.send(ctx, document)
+}
+
+// AnswerCallback confirms the pressed inline button, otherwise telegram shows the progress on it.
+func (c *Client) AnswerCallback(_ context.Context, callbackID string) error {
+	if _, err := c.client.Request(tgbotapi.NewCallback(callbackID, "")); err != nil {
+		return fmt.Errorf("failed to answer callback query: %w", err)
 	}
+
 	return nil
 }
 
+// EditMessage replaces the text of the sent message, only inline keyboard can be set.
+func (c *Client) EditMessage(ctx context.Context, messageID int, message *models.OutgoingMessage) error {
+	edit := tgbotapi.NewEditMessageText(message.UserID, messageID, message.Text)
+	edit.ParseMode = string(message.ParseMode)
+
+	if message.Keyboard != nil {
+		if message.Keyboard.Type != enums.KeyboardInline {
+			return ErrEditNotInlineKeyboard
+		}
+
+		markup := newInlineKeyboard(message.Keyboard)
+		edit.ReplyMarkup = &markup
+	}
+
+	_, err := c.send(ctx, edit)
+	return err
+}
+
+func (c *Client) sendMessage(ctx context.Context, msg tgbotapi.MessageConfig) error {
+	_, err := c.sendText(ctx, msg)
+	return err
+}
+
+// sendText sends the text longer than the limit of telegram by parts, the reply markup
+// is attached to the last part. Returns the identifier of the last part.
+func (c *Client) sendText(ctx context.Context, msg tgbotapi.MessageConfig) (int, error) {
+	parts := splitText(msg.Text, MaxMessageLength, enums.ParseMode(msg.ParseMode))
+	if len(parts) == 1 {
+		return c.send(ctx, msg)
+	}
+
+	if c.maxParts > 0 && len(parts) > c.maxParts {
+		return c.sendAsDocument(ctx, msg)
+	}
+
+	key := models.IdempotencyKeyFromContext(ctx)
+
+	messageID := 0
+	for i, text := range parts {
+		part := msg
+		part.Text = text
+		if i < len(parts)-1 {
+			part.ReplyMarkup = nil
+		}
+
+		partCtx := ctx
+		if key != "" {
+			partCtx = models.ContextWithIdempotencyKey(ctx, fmt.Sprintf("%s_part_%d", key, i))
+		}
+
+		id, err := c.send(partCtx, part)
+		if err != nil {
+			return 0, fmt.Errorf("failed to send part %d of %d: %w", i+1, len(parts), err)
+		}
+		messageID = id
+	}
+
+	return messageID, nil
+}
+
+// sendAsDocument sends the text without the markup as the file with the caption
+// in the language of the context.
+func (c *Client) sendAsDocument(ctx context.Context, msg tgbotapi.MessageConfig) (int, error) {
+	document := tgbotapi.NewDocument(msg.ChatID, tgbotapi.FileBytes{
+		Name:  longMessageFileName,
+		Bytes: []byte(plainText(msg.Text, enums.ParseMode(msg.ParseMode))),
+	})
+	document.Caption = i18n.New(models.LanguageFromContext(ctx)).Get(i18n.KeySentAsDocument)
+	document.ReplyMarkup = msg.ReplyMarkup
+
+	return c.send(ctx, document)
+}
+
+func (c *Client) send(ctx context.Context, msg tgbotapi.Chattable) (int, error) {
+	return c.outbox.send(ctx, msg)
+}
+
+// listenUpdates polls updates until the client is stopped, the updates
+// got after stopping are not sent and not confirmed, so telegram sends them again.
 func (c *Client) listenUpdates() {
-	u := tgbotapi.NewUpdate(0)
-	u.Timeout = c.timeout
-
-	updates := c.client.GetUpdatesChan(u)
-
-	for update := range updates {
-		if update.Message != nil {
-			msg := update.Message
-			usr := msg.From
-			c.logger.Debugf("[%s] %s", usr.UserName, msg.Text)
-
-			c.messageUpdates <- models.NewMessage(
-				msg.MessageID,
-				models.NewUser(usr.ID, usr.FirstName, usr.LastName, usr.UserName),
-				msg.Date, msg.Text,
-			)
+	for {
+		u := tgbotapi.NewUpdate(c.offset)
+		u.Timeout = c.timeout
+
+		updates, err := c.client.GetUpdates(u)
+		if err != nil {
+			select {
+			case <-c.stopped:
+				return
+			default:
+			}
+
+			c.logger.WithError(err).Warn("failed to get updates from telegram, retrying in 3 seconds")
+
+			select {
+			case <-time.After(pollRetryDelay):
+				continue
+			case <-c.stopped:
+				return
+			}
 		}
+
+		if !c.pushUpdates(updates) {
+			return
+		}
+	}
+}
+
+// pushUpdates sends the whole batch of updates to the channel,
+// returns false if the client has been stopped.
+func (c *Client) pushUpdates(updates []tgbotapi.Update) bool {
+	c.receiveMu.RLock()
+	defer c.receiveMu.RUnlock()
+
+	if !c.receiving {
+		return false
+	}
+
+	for _, update := range updates {
+		if update.UpdateID < c.offset {
+			continue
+		}
+
+		if message := c.newMessage(update); message != nil {
+			c.addUnhandled(message.UpdateID)
+			c.messageUpdates <- message
+		}
+		c.offset = update.UpdateID + 1
+	}
+
+	return true
+}
+
+func (c *Client) addUnhandled(updateID int) {
+	c.unhandledMu.Lock()
+	c.unhandled[updateID] = struct{}{}
+	c.unhandledMu.Unlock()
+}
+
+// newMessage converts the update to the message, returns nil for updates without message.
+// The pressed inline button is converted to the message with its data as the text.
+func (c *Client) newMessage(update tgbotapi.Update) *models.Message {
+	var message *models.Message
+	if update.CallbackQuery != nil {
+		message = c.newCallbackMessage(update.CallbackQuery)
+	} else {
+		message = c.newTextMessage(update.Message)
 	}
+
+	if message != nil {
+		message.UpdateID = update.UpdateID
+	}
+
+	return message
+}
+
+func (c *Client) newTextMessage(msg *tgbotapi.Message) *models.Message {
+	if msg == nil {
+		return nil
+	}
+
+	usr := msg.From
+	if usr == nil {
+		return nil
+	}
+
+	c.logger.Debugf("[%s] %s", usr.UserName, msg.Text)
+
+	return models.NewMessage(
+		msg.MessageID,
+		models.NewUser(usr.ID, usr.FirstName, usr.LastName, usr.UserName, usr.LanguageCode),
+		msg.Date, msg.Text,
+	)
+}
+
+func (c *Client) newCallbackMessage(query *tgbotapi.CallbackQuery) *models.Message {
+	usr := query.From
+	if usr == nil || query.Message == nil {
+		return nil
+	}
+
+	c.logger.Debugf("[%s] callback %s", usr.UserName, query.Data)
+
+	message := models.NewMessage(
+		query.Message.MessageID,
+		models.NewUser(usr.ID, usr.FirstName, usr.LastName, usr.UserName, usr.LanguageCode),
+		int(time.Now().Unix()), query.Data,
+	)
+	message.CallbackID = query.ID
+
+	return message
 }
@@ -0,0 +1,74 @@
+package telegram
+
+import (
+	"fmt"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+// newReplyMarkup converts the keyboard to the telegram markup,
+// nil keyboard removes the reply keyboard of the user.
+func newReplyMarkup(keyboard *models.Keyboard) (interface{}, error) {
+	if keyboard == nil {
+		return tgbotapi.NewRemoveKeyboard(true), nil
+	}
+
+	switch keyboard.Type {
+	case enums.KeyboardRemove:
+		return tgbotapi.NewRemoveKeyboard(true), nil
+
+	case enums.KeyboardKeep:
+		return nil, nil
+
+	case enums.KeyboardReply:
+		buttons := make([][]tgbotapi.KeyboardButton, 0, len(keyboard.Rows))
+		for _, row := range keyboard.Rows {
+			cols := make([]tgbotapi.KeyboardButton, 0, len(row))
+			for _, button := range row {
+				cols = append(cols, tgbotapi.NewKeyboardButton(button.Text))
+			}
+			buttons = append(buttons, cols)
+		}
+
+		markup := tgbotapi.NewReplyKeyboard(buttons...)
+		markup.OneTimeKeyboard = keyboard.OneTime
+		return markup, nil
+
+	case enums.KeyboardInline:
+		return newInlineKeyboard(keyboard), nil
+
+	default:
+		return nil, fmt.Errorf("unknown type of keyboard: %d", keyboard.Type)
+	}
+}
+
+func newInlineKeyboard(keyboard *models.Keyboard) tgbotapi.InlineKeyboardMarkup {
+	buttons := make([][]tgbotapi.InlineKeyboardButton, 0, len(keyboard.Rows))
+	for _, row := range keyboard.Rows {
+		cols := make([]tgbotapi.InlineKeyboardButton, 0, len(row))
+		for _, button := range row {
+			if button.URL != "" {
+				cols = append(cols, tgbotapi.NewInlineKeyboardButtonURL(button.Text, button.URL))
+			} else {
+				cols = append(cols, tgbotapi.NewInlineKeyboardButtonData(button.Text, button.CallbackData))
+			}
+		}
+		buttons = append(buttons, cols)
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(buttons...)
+}
+
+func newRequestFile(file *models.OutgoingFile) tgbotapi.RequestFileData {
+	if file.URL != "" {
+		return tgbotapi.FileURL(file.URL)
+	}
+
+	return tgbotapi.FileBytes{
+		Name:  file.FileName,
+		Bytes: file.Content,
+	}
+}
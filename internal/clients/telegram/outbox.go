@@ -0,0 +1,454 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
+)
+
+const (
+	RetryReasonRateLimited = "rate_limited"
+	RetryReasonTransient   = "transient"
+
+	maxTrackedChats = 1024
+)
+
+var ErrClientClosed = errors.New("telegram client is closed")
+
+type OutboxConfig struct {
+	// Workers is an amount of messages sent concurrently.
+	Workers   int `yaml:"workers"`
+	QueueSize int `yaml:"queue_size"`
+	// MaxRetryAfter is a maximum pause requested by telegram which the outbox waits,
+	// the message fails if telegram requests longer pause.
+	MaxRetryAfter time.Duration `yaml:"max_retry_after"`
+
+	// MaxAttempts is a maximum amount of attempts to send the message on transient errors.
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+
+	// GlobalRate is a maximum amount of messages per second sent to all chats.
+	GlobalRate float64 `yaml:"global_rate"`
+	// ChatInterval is a minimum interval between messages sent to one chat.
+	ChatInterval time.Duration `yaml:"chat_interval"`
+
+	// IdempotencyTTL is a duration of keeping the results of the messages with idempotency key.
+	IdempotencyTTL time.Duration `yaml:"idempotency_ttl"`
+}
+
+//go:generate mockery --name=outboxMetrics --dir . --output ./mocks --exported
+type outboxMetrics interface {
+	IncRetries(reason string)
+	IncFailures()
+	IncDeduplicated()
+}
+
+type outgoing struct {
+	ctx    context.Context
+	msg    tgbotapi.Chattable
+	result chan sendResult
+}
+
+type sendResult struct {
+	messageID int
+	err       error
+}
+
+// sentEntry is a result of sending the message with idempotency key,
+// done is closed when the result is set.
+type sentEntry struct {
+	done      chan struct{}
+	messageID int
+	err       error
+	expires   time.Time
+}
+
+// outbox sends messages to telegram by the pool of workers.
+//
+// Messages failed with transient errors are retried with exponential backoff.
+// When telegram answers with 429 and retry_after, all workers pause sending
+// for the requested time. Sending is limited globally and for every chat
+// by the limits of telegram. Messages with the same idempotency key are sent once.
+type outbox struct {
+	client  *tgbotapi.BotAPI
+	config  OutboxConfig
+	metrics outboxMetrics
+	logger  log.Logger
+
+	queue chan *outgoing
+	wg    sync.WaitGroup
+
+	// done is closed when the outbox is closed, the queue is never closed
+	// so sending to it does not need to hold the lock.
+	mu     sync.Mutex
+	closed bool
+	done   chan struct{}
+
+	pauseMu     sync.Mutex
+	pausedUntil time.Time
+
+	limitsMu   sync.Mutex
+	nextGlobal time.Time
+	nextChat   map[int64]time.Time
+
+	sentMu    sync.Mutex
+	sent      map[string]*sentEntry
+	lastSweep time.Time
+}
+
+func newOutbox(client *tgbotapi.BotAPI, config OutboxConfig, metrics outboxMetrics, logger log.Logger) *outbox {
+	workers := config.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	o := &outbox{
+		client:    client,
+		config:    config,
+		metrics:   metrics,
+		logger:    logger,
+		queue:     make(chan *outgoing, config.QueueSize),
+		done:      make(chan struct{}),
+		nextChat:  make(map[int64]time.Time),
+		sent:      make(map[string]*sentEntry),
+		lastSweep: time.Now(),
+	}
+
+	for i := 0; i < workers; i++ {
+		o.wg.Add(1)
+		go o.work()
+	}
+
+	return o
+}
+
+// send puts the message to the queue and waits for the result of sending,
+// the message with already sent idempotency key of the context is not sent again.
+func (o *outbox) send(ctx context.Context, msg tgbotapi.Chattable) (int, error) {
+	key := models.IdempotencyKeyFromContext(ctx)
+	if key == "" {
+		return o.sendOnce(ctx, msg)
+	}
+
+	entry, first := o.reserveKey(key)
+	if !first {
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+
+		if entry.err == nil {
+			o.metrics.IncDeduplicated()
+			return entry.messageID, nil
+		}
+
+		// the previous attempt failed, the message can be sent again
+		return o.send(ctx, msg)
+	}
+
+	entry.messageID, entry.err = o.sendOnce(ctx, msg)
+	o.completeKey(key, entry)
+
+	return entry.messageID, entry.err
+}
+
+func (o *outbox) sendOnce(ctx context.Context, msg tgbotapi.Chattable) (int, error) {
+	req := &outgoing{
+		ctx:    ctx,
+		msg:    msg,
+		result: make(chan sendResult, 1),
+	}
+
+	if err := o.enqueue(req); err != nil {
+		return 0, err
+	}
+
+	select {
+	case result := <-req.result:
+		return result.messageID, result.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// reserveKey returns the entry of the key and true if the message is sent the first time.
+func (o *outbox) reserveKey(key string) (*sentEntry, bool) {
+	o.sentMu.Lock()
+	defer o.sentMu.Unlock()
+
+	now := time.Now()
+	if now.Sub(o.lastSweep) > o.config.IdempotencyTTL {
+		for k, entry := range o.sent {
+			if !entry.expires.IsZero() && now.After(entry.expires) {
+				delete(o.sent, k)
+			}
+		}
+		o.lastSweep = now
+	}
+
+	entry, ok := o.sent[key]
+	if ok && (entry.expires.IsZero() || now.Before(entry.expires)) {
+		return entry, false
+	}
+
+	entry = &sentEntry{
+		done: make(chan struct{}),
+	}
+	o.sent[key] = entry
+
+	return entry, true
+}
+
+func (o *outbox) completeKey(key string, entry *sentEntry) {
+	o.sentMu.Lock()
+	defer o.sentMu.Unlock()
+
+	if entry.err != nil {
+		delete(o.sent, key)
+	} else {
+		entry.expires = time.Now().Add(o.config.IdempotencyTTL)
+	}
+
+	close(entry.done)
+}
+
+func (o *outbox) enqueue(req *outgoing) error {
+	o.mu.Lock()
+	closed := o.closed
+	o.mu.Unlock()
+
+	if closed {
+		return ErrClientClosed
+	}
+
+	select {
+	case o.queue <- req:
+		return nil
+	case <-o.done:
+		return ErrClientClosed
+	case <-req.ctx.Done():
+		return req.ctx.Err()
+	}
+}
+
+// close sends the queued messages and stops the workers.
+func (o *outbox) close() {
+	o.mu.Lock()
+	if !o.closed {
+		o.closed = true
+		close(o.done)
+	}
+	o.mu.Unlock()
+
+	o.wg.Wait()
+
+	// the messages enqueued while the workers were stopping are not sent
+	for {
+		select {
+		case req := <-o.queue:
+			req.result <- sendResult{err: ErrClientClosed}
+		default:
+			return
+		}
+	}
+}
+
+func (o *outbox) work() {
+	defer o.wg.Done()
+
+	for {
+		select {
+		case req := <-o.queue:
+			o.process(req)
+
+		case <-o.done:
+			// the queued messages are sent before stopping
+			for {
+				select {
+				case req := <-o.queue:
+					o.process(req)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (o *outbox) process(req *outgoing) {
+	messageID, err := o.sendMessage(req.ctx, req.msg)
+	req.result <- sendResult{
+		messageID: messageID,
+		err:       err,
+	}
+}
+
+func (o *outbox) sendMessage(ctx context.Context, msg tgbotapi.Chattable) (int, error) {
+	chatID := getChatID(msg)
+
+	for attempt := 1; ; attempt++ {
+		if err := o.waitPause(ctx); err != nil {
+			return 0, err
+		}
+
+		if err := o.waitLimits(ctx, chatID); err != nil {
+			return 0, err
+		}
+
+		sent, err := o.client.Send(msg)
+		if err == nil {
+			return sent.MessageID, nil
+		}
+
+		if retryAfter := getRetryAfter(err); retryAfter > 0 {
+			if retryAfter > o.config.MaxRetryAfter || attempt >= o.config.MaxAttempts {
+				o.metrics.IncFailures()
+				return 0, fmt.Errorf("sending message to telegram: %w", err)
+			}
+
+			o.logger.WithError(err).
+				Warnf("telegram requested to pause sending for %s", retryAfter)
+			o.metrics.IncRetries(RetryReasonRateLimited)
+			o.pause(retryAfter)
+			continue
+		}
+
+		if !isTransient(err) || attempt >= o.config.MaxAttempts {
+			o.metrics.IncFailures()
+			return 0, fmt.Errorf("sending message to telegram: %w", err)
+		}
+
+		backoff := o.backoff(attempt)
+		o.logger.WithError(err).
+			Warnf("failed to send message to telegram, retrying in %s", backoff)
+		o.metrics.IncRetries(RetryReasonTransient)
+
+		if err := sleep(ctx, backoff); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (o *outbox) backoff(attempt int) time.Duration {
+	backoff := time.Duration(float64(o.config.InitialBackoff) * math.Pow(2, float64(attempt-1)))
+	if backoff > o.config.MaxBackoff {
+		return o.config.MaxBackoff
+	}
+
+	return backoff
+}
+
+func (o *outbox) pause(duration time.Duration) {
+	o.pauseMu.Lock()
+	defer o.pauseMu.Unlock()
+
+	if until := time.Now().Add(duration); until.After(o.pausedUntil) {
+		o.pausedUntil = until
+	}
+}
+
+func (o *outbox) waitPause(ctx context.Context) error {
+	o.pauseMu.Lock()
+	wait := time.Until(o.pausedUntil)
+	o.pauseMu.Unlock()
+
+	return sleep(ctx, wait)
+}
+
+// waitLimits reserves the time slot for sending the message
+// by the global limit and the limit of the chat and waits for it.
+func (o *outbox) waitLimits(ctx context.Context, chatID int64) error {
+	o.limitsMu.Lock()
+
+	now := time.Now()
+	slot := now
+	if o.nextGlobal.After(slot) {
+		slot = o.nextGlobal
+	}
+	if next := o.nextChat[chatID]; next.After(slot) {
+		slot = next
+	}
+
+	if o.config.GlobalRate > 0 {
+		o.nextGlobal = slot.Add(time.Duration(float64(time.Second) / o.config.GlobalRate))
+	}
+	if chatID != 0 && o.config.ChatInterval > 0 {
+		o.nextChat[chatID] = slot.Add(o.config.ChatInterval)
+	}
+
+	// the chats without sent messages in the interval do not limit sending
+	if len(o.nextChat) > maxTrackedChats {
+		for id, next := range o.nextChat {
+			if next.Before(now) {
+				delete(o.nextChat, id)
+			}
+		}
+	}
+
+	o.limitsMu.Unlock()
+
+	return sleep(ctx, time.Until(slot))
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// getRetryAfter returns the pause requested by telegram, zero if the error is not 429.
+func getRetryAfter(err error) time.Duration {
+	var tgErr *tgbotapi.Error
+	if !errors.As(err, &tgErr) {
+		return 0
+	}
+
+	return time.Duration(tgErr.RetryAfter) * time.Second
+}
+
+// isTransient returns true for network errors and server errors of telegram,
+// other errors of telegram are caused by the message and are not retried.
+func isTransient(err error) bool {
+	var tgErr *tgbotapi.Error
+	if !errors.As(err, &tgErr) {
+		return true
+	}
+
+	return tgErr.Code >= http.StatusInternalServerError
+}
+
+func getChatID(msg tgbotapi.Chattable) int64 {
+	switch m := msg.(type) {
+	case tgbotapi.MessageConfig:
+		return m.ChatID
+	case tgbotapi.PhotoConfig:
+		return m.ChatID
+	case tgbotapi.DocumentConfig:
+		return m.ChatID
+	case tgbotapi.EditMessageTextConfig:
+		return m.ChatID
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,366 @@
+package telegram
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+// MaxMessageLength is a limit of telegram for the length of the message text.
+const MaxMessageLength = 4096
+
+// maxEntityLength is a maximum length of the HTML entity, like "&amp;" or "&#128512;".
+const maxEntityLength = 10
+
+var (
+	markdownV2Markers = []string{"||", "__", "*", "_", "~"}
+	markdownMarkers   = []string{"*", "_"}
+)
+
+type tokenKind int
+
+const (
+	// tokenText is the text which can not be split, like the character, the escape or the entity.
+	tokenText tokenKind = iota
+	tokenSpace
+	tokenNewline
+	tokenOpen
+	tokenClose
+)
+
+// span is the formatting opened in the text, it is closed at the end of the part
+// and opened again at the beginning of the next one.
+type span struct {
+	open  string
+	close string
+	// code spans do not contain other formatting
+	code bool
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	span span
+}
+
+// splitText splits the text into parts not longer than limit characters. The text is cut only between
+// the escapes, the entities and the tags, preferably on line boundaries, otherwise on spaces.
+// The formatting open at the cut is closed at the end of the part and reopened at the beginning
+// of the next one, so every part is rendered separately.
+func splitText(text string, limit int, parseMode enums.ParseMode) []string {
+	if utf8.RuneCountInString(text) <= limit {
+		return []string{text}
+	}
+
+	tokens := tokenize(text, parseMode)
+
+	var (
+		parts []string
+		open  []span
+		part  string
+	)
+	for len(tokens) > 0 {
+		part, tokens, open = takePart(tokens, open, limit)
+		parts = append(parts, part)
+	}
+
+	return parts
+}
+
+// takePart returns the part starting with the open formatting, the rest of the tokens
+// and the formatting open at the end of the part.
+func takePart(tokens []token, open []span, limit int) (string, []token, []span) {
+	var prefix strings.Builder
+	for _, s := range open {
+		prefix.WriteString(s.open)
+	}
+
+	length := utf8.RuneCountInString(prefix.String())
+	state := open
+
+	// cut is the position of the cut with the formatting open at it,
+	// the whitespace at the cut is dropped
+	type cut struct {
+		end   int
+		next  int
+		state []span
+	}
+	var newline, space *cut
+	fit := cut{state: open}
+
+	for i, tok := range tokens {
+		next := apply(state, tok)
+		tokenLength := utf8.RuneCountInString(tok.text)
+		if length+tokenLength+closingLength(next) > limit {
+			break
+		}
+
+		// the cut right after the opening marker leaves the empty formatting
+		if i > 0 && tokens[i-1].kind != tokenOpen {
+			switch tok.kind {
+			case tokenNewline:
+				newline = &cut{end: i, next: i + 1, state: state}
+			case tokenSpace:
+				space = &cut{end: i, next: i + 1, state: state}
+			}
+		}
+
+		length += tokenLength
+		state = next
+		fit = cut{end: i + 1, next: i + 1, state: state}
+	}
+
+	if fit.end == len(tokens) {
+		return prefix.String() + joinTokens(tokens), nil, nil
+	}
+
+	chosen := fit
+	switch {
+	case newline != nil:
+		chosen = *newline
+	case space != nil:
+		chosen = *space
+	case fit.end == 0:
+		// the token does not fit even the empty part, it is sent as is
+		chosen = cut{end: 1, next: 1, state: apply(open, tokens[0])}
+	}
+
+	var part strings.Builder
+	part.WriteString(prefix.String())
+	part.WriteString(joinTokens(tokens[:chosen.end]))
+	for i := len(chosen.state) - 1; i >= 0; i-- {
+		part.WriteString(chosen.state[i].close)
+	}
+
+	return part.String(), tokens[chosen.next:], chosen.state
+}
+
+// apply returns the formatting open after the token, the state is not changed.
+func apply(state []span, tok token) []span {
+	switch tok.kind {
+	case tokenOpen:
+		next := make([]span, len(state), len(state)+1)
+		copy(next, state)
+		return append(next, tok.span)
+	case tokenClose:
+		if len(state) == 0 {
+			return state
+		}
+		return state[:len(state)-1]
+	default:
+		return state
+	}
+}
+
+// plainText returns the text without the markup, the escapes and the entities are replaced by the characters.
+func plainText(text string, parseMode enums.ParseMode) string {
+	var b strings.Builder
+	for _, tok := range tokenize(text, parseMode) {
+		switch {
+		case tok.kind == tokenOpen || tok.kind == tokenClose:
+			continue
+		case parseMode == enums.ParseModeHTML:
+			b.WriteString(html.UnescapeString(tok.text))
+		case parseMode == enums.ParseModeMarkdownV2 && strings.HasPrefix(tok.text, "\\") && len(tok.text) > 1:
+			b.WriteString(tok.text[1:])
+		default:
+			b.WriteString(tok.text)
+		}
+	}
+
+	return b.String()
+}
+
+func closingLength(state []span) int {
+	length := 0
+	for _, s := range state {
+		length += utf8.RuneCountInString(s.close)
+	}
+
+	return length
+}
+
+func joinTokens(tokens []token) string {
+	var b strings.Builder
+	for _, tok := range tokens {
+		b.WriteString(tok.text)
+	}
+
+	return b.String()
+}
+
+func tokenize(text string, parseMode enums.ParseMode) []token {
+	switch parseMode {
+	case enums.ParseModeHTML:
+		return tokenizeHTML(text)
+	case enums.ParseModeMarkdownV2:
+		return tokenizeMarkdown(text, markdownV2Markers, true)
+	case enums.ParseModeMarkdown:
+		return tokenizeMarkdown(text, markdownMarkers, false)
+	default:
+		return tokenizePlain(text)
+	}
+}
+
+func tokenizePlain(text string) []token {
+	tokens := make([]token, 0, len(text))
+	for _, r := range text {
+		tokens = append(tokens, newRuneToken(r))
+	}
+
+	return tokens
+}
+
+func newRuneToken(r rune) token {
+	switch r {
+	case '\n':
+		return token{kind: tokenNewline, text: "\n"}
+	case ' ':
+		return token{kind: tokenSpace, text: " "}
+	default:
+		return token{kind: tokenText, text: string(r)}
+	}
+}
+
+// tokenizeMarkdown splits the text into the escapes, the markers of formatting and the characters,
+// the markers are not parsed in the code.
+func tokenizeMarkdown(text string, markers []string, escapes bool) []token {
+	tokens := make([]token, 0, len(text))
+	var open []span
+
+	top := func() *span {
+		if len(open) == 0 {
+			return nil
+		}
+		return &open[len(open)-1]
+	}
+	push := func(s span, text string) {
+		open = append(open, s)
+		tokens = append(tokens, token{kind: tokenOpen, text: text, span: s})
+	}
+	pop := func(text string) {
+		open = open[:len(open)-1]
+		tokens = append(tokens, token{kind: tokenClose, text: text})
+	}
+
+	for len(text) > 0 {
+		current := top()
+
+		switch {
+		case escapes && text[0] == '\\' && len(text) > 1:
+			_, size := utf8.DecodeRuneInString(text[1:])
+			tokens = append(tokens, token{kind: tokenText, text: text[:1+size]})
+			text = text[1+size:]
+			continue
+
+		case strings.HasPrefix(text, "```"):
+			if current != nil && current.close == "\n```" {
+				pop("```")
+				text = text[3:]
+				continue
+			}
+			if current == nil || !current.code {
+				// the language of the block is till the end of the line
+				line := text
+				if i := strings.IndexByte(text, '\n'); i >= 0 {
+					line = text[:i]
+				}
+				push(span{open: line + "\n", close: "\n```", code: true}, line)
+				text = text[len(line):]
+				continue
+			}
+
+		case text[0] == '`':
+			if current != nil && current.close == "`" {
+				pop("`")
+				text = text[1:]
+				continue
+			}
+			if current == nil || !current.code {
+				push(span{open: "`", close: "`", code: true}, "`")
+				text = text[1:]
+				continue
+			}
+
+		case current == nil || !current.code:
+			if marker := matchMarker(text, markers); marker != "" {
+				if current != nil && current.close == marker {
+					pop(marker)
+				} else {
+					push(span{open: marker, close: marker}, marker)
+				}
+				text = text[len(marker):]
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(text)
+		tokens = append(tokens, newRuneToken(r))
+		text = text[size:]
+	}
+
+	return tokens
+}
+
+func matchMarker(text string, markers []string) string {
+	for _, marker := range markers {
+		if strings.HasPrefix(text, marker) {
+			return marker
+		}
+	}
+
+	return ""
+}
+
+// tokenizeHTML splits the text into the tags, the entities and the characters.
+func tokenizeHTML(text string) []token {
+	tokens := make([]token, 0, len(text))
+
+	for len(text) > 0 {
+		switch text[0] {
+		case '<':
+			if end := strings.IndexByte(text, '>'); end > 0 {
+				tag := text[:end+1]
+				tokens = append(tokens, newTagToken(tag))
+				text = text[end+1:]
+				continue
+			}
+
+		case '&':
+			if end := strings.IndexByte(text, ';'); end > 0 && end < maxEntityLength {
+				tokens = append(tokens, token{kind: tokenText, text: text[:end+1]})
+				text = text[end+1:]
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(text)
+		tokens = append(tokens, newRuneToken(r))
+		text = text[size:]
+	}
+
+	return tokens
+}
+
+func newTagToken(tag string) token {
+	if strings.HasPrefix(tag, "</") {
+		return token{kind: tokenClose, text: tag}
+	}
+
+	name := strings.TrimSuffix(strings.TrimPrefix(tag, "<"), ">")
+	if i := strings.IndexAny(name, " \n"); i >= 0 {
+		name = name[:i]
+	}
+
+	return token{
+		kind: tokenOpen,
+		text: tag,
+		span: span{
+			open:  tag,
+			close: "</" + name + ">",
+			code:  name == "pre" || name == "code",
+		},
+	}
+}
@@ -0,0 +1,143 @@
+package telegram
+
+import (
+	"reflect"
+	"testing"
+	"unicode/utf8"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+func TestSplitText(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		limit     int
+		parseMode enums.ParseMode
+		want      []string
+	}{
+		{
+			name:  "short text",
+			text:  "short text",
+			limit: 20,
+			want:  []string{"short text"},
+		},
+		{
+			name:  "line boundary",
+			text:  "first line\nsecond line",
+			limit: 15,
+			want:  []string{"first line", "second line"},
+		},
+		{
+			name:  "space",
+			text:  "one two three four",
+			limit: 10,
+			want:  []string{"one two", "three four"},
+		},
+		{
+			name:  "word longer than limit",
+			text:  "abcdefghij",
+			limit: 4,
+			want:  []string{"abcd", "efgh", "ij"},
+		},
+		{
+			name:      "markdown escapes",
+			text:      `a\.b\.c\.`,
+			limit:     4,
+			parseMode: enums.ParseModeMarkdownV2,
+			want:      []string{`a\.b`, `\.c`, `\.`},
+		},
+		{
+			name:      "markdown bold",
+			text:      "*aaaa bbbb*",
+			limit:     8,
+			parseMode: enums.ParseModeMarkdownV2,
+			want:      []string{"*aaaa*", "*bbbb*"},
+		},
+		{
+			name:      "markdown code",
+			text:      "_x_ `a b c d`",
+			limit:     8,
+			parseMode: enums.ParseModeMarkdownV2,
+			want:      []string{"_x_ `a`", "`b c d`"},
+		},
+		{
+			name:      "markdown code block",
+			text:      "```\nline1\nline2\n```",
+			limit:     15,
+			parseMode: enums.ParseModeMarkdownV2,
+			want:      []string{"```\nline1\n```", "```\nline2\n```"},
+		},
+		{
+			name:      "html entity and tag",
+			text:      "<b>fish &amp; chips</b>",
+			limit:     16,
+			parseMode: enums.ParseModeHTML,
+			want:      []string{"<b>fish</b>", "<b>&amp;</b>", "<b>chips</b>"},
+		},
+		{
+			name:      "html code block",
+			text:      "<pre>\nline1\nline2\n</pre>",
+			limit:     18,
+			parseMode: enums.ParseModeHTML,
+			want:      []string{"<pre>\nline1</pre>", "<pre>line2\n</pre>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitText(tt.text, tt.limit, tt.parseMode)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("splitText() = %q, want %q", got, tt.want)
+			}
+
+			for _, part := range got {
+				if utf8.RuneCountInString(part) > tt.limit {
+					t.Errorf("part %q is longer than %d", part, tt.limit)
+				}
+			}
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		parseMode enums.ParseMode
+		want      string
+	}{
+		{
+			name:      "markdown",
+			text:      "*Total:* 1500\\.50 \\$ _\\(food\\)_",
+			parseMode: enums.ParseModeMarkdownV2,
+			want:      "Total: 1500.50 $ (food)",
+		},
+		{
+			name:      "markdown code block",
+			text:      "```\na\\\\b\n```",
+			parseMode: enums.ParseModeMarkdownV2,
+			want:      "\na\\b\n",
+		},
+		{
+			name:      "html",
+			text:      "<b>fish &amp; chips</b> &lt;3",
+			parseMode: enums.ParseModeHTML,
+			want:      "fish & chips <3",
+		},
+		{
+			name:      "plain",
+			text:      "*not bold*",
+			parseMode: enums.ParseModePlain,
+			want:      "*not bold*",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plainText(tt.text, tt.parseMode); got != tt.want {
+				t.Errorf("plainText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
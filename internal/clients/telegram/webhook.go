@@ -0,0 +1,124 @@
+package telegram
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+const (
+	secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+	maxUpdateSize = 1 << 20
+)
+
+var (
+	ErrWebhookURLRequired    = errors.New("url of the webhook is required")
+	ErrWebhookSecretRequired = errors.New("secret token of the webhook is required")
+)
+
+type WebhookConfig struct {
+	// URL is the public https address of the handler behind the ingress.
+	URL string `yaml:"url"`
+	// Path is the pattern of the handler on the http router.
+	Path string `yaml:"path"`
+	// SecretToken is sent by telegram in every request to the webhook.
+	SecretToken        string `yaml:"secret_token"`
+	MaxConnections     int    `yaml:"max_connections"`
+	DropPendingUpdates bool   `yaml:"drop_pending_updates"`
+}
+
+// setWebhook registers the webhook in telegram, the library does not support
+// the secret token, so the request is made directly.
+func (c *Client) setWebhook() error {
+	if c.webhook.URL == "" {
+		return ErrWebhookURLRequired
+	}
+	if c.webhook.SecretToken == "" {
+		return ErrWebhookSecretRequired
+	}
+
+	params := tgbotapi.Params{
+		"url":          c.webhook.URL,
+		"secret_token": c.webhook.SecretToken,
+	}
+	params.AddNonZero("max_connections", c.webhook.MaxConnections)
+	params.AddBool("drop_pending_updates", c.webhook.DropPendingUpdates)
+
+	if _, err := c.client.MakeRequest("setWebhook", params); err != nil {
+		return fmt.Errorf("failed to set telegram webhook: %w", err)
+	}
+
+	c.logger.Infof("telegram webhook has been set to %s", c.webhook.URL)
+
+	return nil
+}
+
+// WebhookPath returns the pattern for registering WebhookHandler on the http router.
+func (c *Client) WebhookPath() string {
+	return c.webhook.Path
+}
+
+// WebhookHandler returns the handler which receives updates from telegram
+// and sends them to the same channel as long polling.
+func (c *Client) WebhookHandler() http.Handler {
+	return http.HandlerFunc(c.handleWebhook)
+}
+
+func (c *Client) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	secret := r.Header.Get(secretTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(secret), []byte(c.webhook.SecretToken)) != 1 {
+		c.logger.Warn("received telegram update with invalid secret token")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var update tgbotapi.Update
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxUpdateSize)).Decode(&update); err != nil {
+		c.logger.WithError(err).Warn("failed to decode telegram update")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	message := c.newMessage(update)
+	if message == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// the lock is not held while sending, so the slow handling of updates does not block Stop
+	c.receiveMu.RLock()
+	receiving := c.receiving
+	if receiving {
+		c.webhookSends.Add(1)
+	}
+	c.receiveMu.RUnlock()
+
+	if !receiving {
+		// telegram retries the update after restart
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	defer c.webhookSends.Done()
+
+	select {
+	case c.messageUpdates <- message:
+		w.WriteHeader(http.StatusOK)
+	case <-c.stopped:
+		// telegram retries the update after restart
+		w.WriteHeader(http.StatusServiceUnavailable)
+	case <-r.Context().Done():
+		// telegram retries the update if it is not accepted
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,212 @@
+package telegram
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log/zap"
+)
+
+const (
+	testToken  = "test-token"
+	testSecret = "test-secret"
+
+	testUpdate = `{"update_id": 10, "message": {"message_id": 5, "date": 1700000000, "text": "/start",
+		"from": {"id": 42, "first_name": "Anna", "username": "anna", "language_code": "en"},
+		"chat": {"id": 42, "type": "private"}}}`
+)
+
+type nopOutboxMetrics struct{}
+
+func (nopOutboxMetrics) IncRetries(string) {}
+func (nopOutboxMetrics) IncFailures()      {}
+func (nopOutboxMetrics) IncDeduplicated()  {}
+
+// fakeBotAPI answers the requests of the client instead of telegram.
+type fakeBotAPI struct {
+	mu     sync.Mutex
+	params map[string]string
+}
+
+func (f *fakeBotAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	switch strings.TrimPrefix(r.URL.Path, "/bot"+testToken+"/") {
+	case "getMe":
+		_, _ = w.Write([]byte(`{"ok": true, "result": {"id": 1, "is_bot": true, "first_name": "Bot", "username": "bot"}}`))
+	case "setWebhook":
+		f.mu.Lock()
+		f.params = map[string]string{
+			"url":          r.PostForm.Get("url"),
+			"secret_token": r.PostForm.Get("secret_token"),
+		}
+		f.mu.Unlock()
+		_, _ = w.Write([]byte(`{"ok": true, "result": true}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newWebhookClient(t *testing.T) (*Client, *fakeBotAPI) {
+	t.Helper()
+
+	api := &fakeBotAPI{}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(Config{
+		Token:       testToken,
+		Mode:        ModeWebhook,
+		APIEndpoint: server.URL + "/bot%s/%s",
+		Webhook: WebhookConfig{
+			URL:         "https://bot.example.com/telegram",
+			Path:        "/telegram",
+			SecretToken: testSecret,
+		},
+		MessageBuffer: 1,
+		Outbox:        OutboxConfig{Workers: 1},
+	}, nopOutboxMetrics{}, zap.NewLogger("test", zapcore.FatalLevel))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	if err := client.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	return client, api
+}
+
+func postUpdate(t *testing.T, url string, secret string) int {
+	t.Helper()
+
+	status, err := sendUpdate(url, secret)
+	if err != nil {
+		t.Fatalf("sendUpdate() error = %v", err)
+	}
+
+	return status
+}
+
+func sendUpdate(url string, secret string) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(testUpdate))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set(secretTokenHeader, secret)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
+
+func TestWebhookIsSet(t *testing.T) {
+	_, api := newWebhookClient(t)
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if api.params["url"] != "https://bot.example.com/telegram" || api.params["secret_token"] != testSecret {
+		t.Errorf("setWebhook params = %v", api.params)
+	}
+}
+
+func TestWebhookSecretToken(t *testing.T) {
+	client, _ := newWebhookClient(t)
+	webhook := httptest.NewServer(client.WebhookHandler())
+	defer webhook.Close()
+
+	tests := []struct {
+		name   string
+		secret string
+		want   int
+	}{
+		{name: "missing", secret: "", want: http.StatusUnauthorized},
+		{name: "wrong", secret: "wrong-secret", want: http.StatusUnauthorized},
+		{name: "valid", secret: testSecret, want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := postUpdate(t, webhook.URL, tt.secret); got != tt.want {
+				t.Errorf("status = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookDeliversUpdate(t *testing.T) {
+	client, _ := newWebhookClient(t)
+	webhook := httptest.NewServer(client.WebhookHandler())
+	defer webhook.Close()
+
+	if got := postUpdate(t, webhook.URL, testSecret); got != http.StatusOK {
+		t.Fatalf("status = %d, want %d", got, http.StatusOK)
+	}
+
+	select {
+	case message := <-client.GetUpdatesChan():
+		if message.Text != "/start" || message.From.ID != 42 || message.UpdateID != 10 {
+			t.Errorf("message = %+v", message)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("update has not been delivered")
+	}
+}
+
+func TestWebhookShutdown(t *testing.T) {
+	client, _ := newWebhookClient(t)
+	webhook := httptest.NewServer(client.WebhookHandler())
+	defer webhook.Close()
+
+	// the update waits for the reader of the channel, stopping does not wait for it
+	sent := make(chan int, 1)
+	go func() {
+		for i := 0; i <= cap(client.messageUpdates); i++ {
+			if status, err := sendUpdate(webhook.URL, testSecret); err != nil || status != http.StatusOK {
+				sent <- status
+				return
+			}
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	time.Sleep(50 * time.Millisecond)
+	if err := client.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	select {
+	case status := <-sent:
+		if status != http.StatusServiceUnavailable {
+			t.Errorf("status of blocked update = %d, want %d", status, http.StatusServiceUnavailable)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("blocked update has not been rejected")
+	}
+
+	if got := postUpdate(t, webhook.URL, testSecret); got != http.StatusServiceUnavailable {
+		t.Errorf("status after stop = %d, want %d", got, http.StatusServiceUnavailable)
+	}
+
+	for range client.GetUpdatesChan() {
+	}
+}
@@ -24,8 +24,12 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	User  []ent.Hook
-	Waste []ent.Hook
+	Debt         []ent.Hook
+	ExchangeRate []ent.Hook
+	Goal         []ent.Hook
+	Tag          []ent.Hook
+	User         []ent.Hook
+	Waste        []ent.Hook
 }
 
 // Options applies the options on the config object.
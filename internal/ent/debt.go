@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
+)
+
+// Debt is the model entity for the Debt schema.
+type Debt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreditorID holds the value of the "creditor_id" field.
+	CreditorID int64 `json:"creditor_id,omitempty"`
+	// DebtorID holds the value of the "debtor_id" field.
+	DebtorID int64 `json:"debtor_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int64 `json:"amount,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// SettledAt holds the value of the "settled_at" field.
+	SettledAt *time.Time `json:"settled_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DebtQuery when eager-loading is set.
+	Edges DebtEdges `json:"edges"`
+}
+
+// DebtEdges holds the relations/edges for other nodes in the graph.
+type DebtEdges struct {
+	// Creditor holds the value of the creditor edge.
+	Creditor *User `json:"creditor,omitempty"`
+	// Debtor holds the value of the debtor edge.
+	Debtor *User `json:"debtor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CreditorOrErr returns the Creditor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DebtEdges) CreditorOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Creditor == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Creditor, nil
+	}
+	return nil, &NotLoadedError{edge: "creditor"}
+}
+
+// DebtorOrErr returns the Debtor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DebtEdges) DebtorOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.Debtor == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Debtor, nil
+	}
+	return nil, &NotLoadedError{edge: "debtor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Debt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case debt.FieldCreditorID, debt.FieldDebtorID, debt.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case debt.FieldCategory:
+			values[i] = new(sql.NullString)
+		case debt.FieldCreatedAt, debt.FieldSettledAt:
+			values[i] = new(sql.NullTime)
+		case debt.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Debt", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Debt fields.
+func (d *Debt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case debt.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				d.ID = *value
+			}
+		case debt.FieldCreditorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field creditor_id", values[i])
+			} else if value.Valid {
+				d.CreditorID = value.Int64
+			}
+		case debt.FieldDebtorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field debtor_id", values[i])
+			} else if value.Valid {
+				d.DebtorID = value.Int64
+			}
+		case debt.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				d.Amount = value.Int64
+			}
+		case debt.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				d.Category = value.String
+			}
+		case debt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case debt.FieldSettledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field settled_at", values[i])
+			} else if value.Valid {
+				d.SettledAt = new(time.Time)
+				*d.SettledAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCreditor queries the "creditor" edge of the Debt entity.
+func (d *Debt) QueryCreditor() *UserQuery {
+	return (&DebtClient{config: d.config}).QueryCreditor(d)
+}
+
+// QueryDebtor queries the "debtor" edge of the Debt entity.
+func (d *Debt) QueryDebtor() *UserQuery {
+	return (&DebtClient{config: d.config}).QueryDebtor(d)
+}
+
+// Update returns a builder for updating this Debt.
+// Note that you need to call Debt.Unwrap() before calling this method if this Debt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Debt) Update() *DebtUpdateOne {
+	return (&DebtClient{config: d.config}).UpdateOne(d)
+}
+
+// Unwrap unwraps the Debt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Debt) Unwrap() *Debt {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Debt is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Debt) String() string {
+	var builder strings.Builder
+	builder.WriteString("Debt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("creditor_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.CreditorID))
+	builder.WriteString(", ")
+	builder.WriteString("debtor_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.DebtorID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", d.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(d.Category)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := d.SettledAt; v != nil {
+		builder.WriteString("settled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Debts is a parsable slice of Debt.
+type Debts []*Debt
+
+func (d Debts) config(cfg config) {
+	for _i := range d {
+		d[_i].config = cfg
+	}
+}
@@ -0,0 +1,78 @@
+// Code generated by ent, DO NOT EDIT.
+
+package debt
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the debt type in the database.
+	Label = "debt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreditorID holds the string denoting the creditor_id field in the database.
+	FieldCreditorID = "creditor_id"
+	// FieldDebtorID holds the string denoting the debtor_id field in the database.
+	FieldDebtorID = "debtor_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldSettledAt holds the string denoting the settled_at field in the database.
+	FieldSettledAt = "settled_at"
+	// EdgeCreditor holds the string denoting the creditor edge name in mutations.
+	EdgeCreditor = "creditor"
+	// EdgeDebtor holds the string denoting the debtor edge name in mutations.
+	EdgeDebtor = "debtor"
+	// Table holds the table name of the debt in the database.
+	Table = "debts"
+	// CreditorTable is the table that holds the creditor relation/edge.
+	CreditorTable = "debts"
+	// CreditorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	CreditorInverseTable = "users"
+	// CreditorColumn is the table column denoting the creditor relation/edge.
+	CreditorColumn = "creditor_id"
+	// DebtorTable is the table that holds the debtor relation/edge.
+	DebtorTable = "debts"
+	// DebtorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	DebtorInverseTable = "users"
+	// DebtorColumn is the table column denoting the debtor relation/edge.
+	DebtorColumn = "debtor_id"
+)
+
+// Columns holds all SQL columns for debt fields.
+var Columns = []string{
+	FieldID,
+	FieldCreditorID,
+	FieldDebtorID,
+	FieldAmount,
+	FieldCategory,
+	FieldCreatedAt,
+	FieldSettledAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
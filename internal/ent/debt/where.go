@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package debt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreditorID applies equality check predicate on the "creditor_id" field. It's identical to CreditorIDEQ.
+func CreditorID(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreditorID), v))
+	})
+}
+
+// DebtorID applies equality check predicate on the "debtor_id" field. It's identical to DebtorIDEQ.
+func DebtorID(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDebtorID), v))
+	})
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// SettledAt applies equality check predicate on the "settled_at" field. It's identical to SettledAtEQ.
+func SettledAt(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSettledAt), v))
+	})
+}
+
+// CreditorIDEQ applies the EQ predicate on the "creditor_id" field.
+func CreditorIDEQ(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreditorID), v))
+	})
+}
+
+// CreditorIDNEQ applies the NEQ predicate on the "creditor_id" field.
+func CreditorIDNEQ(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreditorID), v))
+	})
+}
+
+// CreditorIDIn applies the In predicate on the "creditor_id" field.
+func CreditorIDIn(vs ...int64) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreditorID), v...))
+	})
+}
+
+// CreditorIDNotIn applies the NotIn predicate on the "creditor_id" field.
+func CreditorIDNotIn(vs ...int64) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreditorID), v...))
+	})
+}
+
+// DebtorIDEQ applies the EQ predicate on the "debtor_id" field.
+func DebtorIDEQ(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDebtorID), v))
+	})
+}
+
+// DebtorIDNEQ applies the NEQ predicate on the "debtor_id" field.
+func DebtorIDNEQ(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDebtorID), v))
+	})
+}
+
+// DebtorIDIn applies the In predicate on the "debtor_id" field.
+func DebtorIDIn(vs ...int64) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDebtorID), v...))
+	})
+}
+
+// DebtorIDNotIn applies the NotIn predicate on the "debtor_id" field.
+func DebtorIDNotIn(vs ...int64) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDebtorID), v...))
+	})
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int64) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int64) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int64) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAmount), v))
+	})
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCategory), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// SettledAtEQ applies the EQ predicate on the "settled_at" field.
+func SettledAtEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtNEQ applies the NEQ predicate on the "settled_at" field.
+func SettledAtNEQ(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtIn applies the In predicate on the "settled_at" field.
+func SettledAtIn(vs ...time.Time) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSettledAt), v...))
+	})
+}
+
+// SettledAtNotIn applies the NotIn predicate on the "settled_at" field.
+func SettledAtNotIn(vs ...time.Time) predicate.Debt {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSettledAt), v...))
+	})
+}
+
+// SettledAtGT applies the GT predicate on the "settled_at" field.
+func SettledAtGT(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtGTE applies the GTE predicate on the "settled_at" field.
+func SettledAtGTE(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtLT applies the LT predicate on the "settled_at" field.
+func SettledAtLT(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtLTE applies the LTE predicate on the "settled_at" field.
+func SettledAtLTE(v time.Time) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSettledAt), v))
+	})
+}
+
+// SettledAtIsNil applies the IsNil predicate on the "settled_at" field.
+func SettledAtIsNil() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSettledAt)))
+	})
+}
+
+// SettledAtNotNil applies the NotNil predicate on the "settled_at" field.
+func SettledAtNotNil() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSettledAt)))
+	})
+}
+
+// HasCreditor applies the HasEdge predicate on the "creditor" edge.
+func HasCreditor() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CreditorTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreditorTable, CreditorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreditorWith applies the HasEdge predicate on the "creditor" edge with a given conditions (other predicates).
+func HasCreditorWith(preds ...predicate.User) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CreditorInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreditorTable, CreditorColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDebtor applies the HasEdge predicate on the "debtor" edge.
+func HasDebtor() predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DebtorTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DebtorTable, DebtorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDebtorWith applies the HasEdge predicate on the "debtor" edge with a given conditions (other predicates).
+func HasDebtorWith(preds ...predicate.User) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DebtorInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DebtorTable, DebtorColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Debt) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Debt) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Debt) predicate.Debt {
+	return predicate.Debt(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
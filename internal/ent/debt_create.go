@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
+)
+
+// DebtCreate is the builder for creating a Debt entity.
+type DebtCreate struct {
+	config
+	mutation *DebtMutation
+	hooks    []Hook
+}
+
+// SetCreditorID sets the "creditor_id" field.
+func (dc *DebtCreate) SetCreditorID(i int64) *DebtCreate {
+	dc.mutation.SetCreditorID(i)
+	return dc
+}
+
+// SetDebtorID sets the "debtor_id" field.
+func (dc *DebtCreate) SetDebtorID(i int64) *DebtCreate {
+	dc.mutation.SetDebtorID(i)
+	return dc
+}
+
+// SetAmount sets the "amount" field.
+func (dc *DebtCreate) SetAmount(i int64) *DebtCreate {
+	dc.mutation.SetAmount(i)
+	return dc
+}
+
+// SetCategory sets the "category" field.
+func (dc *DebtCreate) SetCategory(s string) *DebtCreate {
+	dc.mutation.SetCategory(s)
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DebtCreate) SetCreatedAt(t time.Time) *DebtCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DebtCreate) SetNillableCreatedAt(t *time.Time) *DebtCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (dc *DebtCreate) SetSettledAt(t time.Time) *DebtCreate {
+	dc.mutation.SetSettledAt(t)
+	return dc
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (dc *DebtCreate) SetNillableSettledAt(t *time.Time) *DebtCreate {
+	if t != nil {
+		dc.SetSettledAt(*t)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DebtCreate) SetID(u uuid.UUID) *DebtCreate {
+	dc.mutation.SetID(u)
+	return dc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dc *DebtCreate) SetNillableID(u *uuid.UUID) *DebtCreate {
+	if u != nil {
+		dc.SetID(*u)
+	}
+	return dc
+}
+
+// SetCreditor sets the "creditor" edge to the User entity.
+func (dc *DebtCreate) SetCreditor(u *User) *DebtCreate {
+	return dc.SetCreditorID(u.ID)
+}
+
+// SetDebtor sets the "debtor" edge to the User entity.
+func (dc *DebtCreate) SetDebtor(u *User) *DebtCreate {
+	return dc.SetDebtorID(u.ID)
+}
+
+// Mutation returns the DebtMutation object of the builder.
+func (dc *DebtCreate) Mutation() *DebtMutation {
+	return dc.mutation
+}
+
+// Save creates the Debt in the database.
+func (dc *DebtCreate) Save(ctx context.Context) (*Debt, error) {
+	var (
+		err  error
+		node *Debt
+	)
+	dc.defaults()
+	if len(dc.hooks) == 0 {
+		if err = dc.check(); err != nil {
+			return nil, err
+		}
+		node, err = dc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DebtMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = dc.check(); err != nil {
+				return nil, err
+			}
+			dc.mutation = mutation
+			if node, err = dc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(dc.hooks) - 1; i >= 0; i-- {
+			if dc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = dc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, dc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Debt)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from DebtMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DebtCreate) SaveX(ctx context.Context) *Debt {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DebtCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DebtCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DebtCreate) defaults() {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := debt.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.ID(); !ok {
+		v := debt.DefaultID()
+		dc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DebtCreate) check() error {
+	if _, ok := dc.mutation.CreditorID(); !ok {
+		return &ValidationError{Name: "creditor_id", err: errors.New(`ent: missing required field "Debt.creditor_id"`)}
+	}
+	if _, ok := dc.mutation.DebtorID(); !ok {
+		return &ValidationError{Name: "debtor_id", err: errors.New(`ent: missing required field "Debt.debtor_id"`)}
+	}
+	if _, ok := dc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Debt.amount"`)}
+	}
+	if v, ok := dc.mutation.Amount(); ok {
+		if err := debt.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Debt.amount": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "Debt.category"`)}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Debt.created_at"`)}
+	}
+	if _, ok := dc.mutation.CreditorID(); !ok {
+		return &ValidationError{Name: "creditor", err: errors.New(`ent: missing required edge "Debt.creditor"`)}
+	}
+	if _, ok := dc.mutation.DebtorID(); !ok {
+		return &ValidationError{Name: "debtor", err: errors.New(`ent: missing required edge "Debt.debtor"`)}
+	}
+	return nil
+}
+
+func (dc *DebtCreate) sqlSave(ctx context.Context) (*Debt, error) {
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (dc *DebtCreate) createSpec() (*Debt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Debt{config: dc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: debt.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: debt.FieldID,
+			},
+		}
+	)
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: debt.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := dc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: debt.FieldCategory,
+		})
+		_node.Category = value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: debt.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.SettledAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: debt.FieldSettledAt,
+		})
+		_node.SettledAt = &value
+	}
+	if nodes := dc.mutation.CreditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.CreditorTable,
+			Columns: []string{debt.CreditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreditorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.DebtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.DebtorTable,
+			Columns: []string{debt.DebtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DebtorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DebtCreateBulk is the builder for creating many Debt entities in bulk.
+type DebtCreateBulk struct {
+	config
+	builders []*DebtCreate
+}
+
+// Save creates the Debt entities in the database.
+func (dcb *DebtCreateBulk) Save(ctx context.Context) ([]*Debt, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Debt, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DebtMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DebtCreateBulk) SaveX(ctx context.Context) []*Debt {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DebtCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DebtCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
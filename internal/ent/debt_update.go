@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
+)
+
+// DebtUpdate is the builder for updating Debt entities.
+type DebtUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DebtMutation
+}
+
+// Where appends a list predicates to the DebtUpdate builder.
+func (du *DebtUpdate) Where(ps ...predicate.Debt) *DebtUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetCreditorID sets the "creditor_id" field.
+func (du *DebtUpdate) SetCreditorID(i int64) *DebtUpdate {
+	du.mutation.SetCreditorID(i)
+	return du
+}
+
+// SetDebtorID sets the "debtor_id" field.
+func (du *DebtUpdate) SetDebtorID(i int64) *DebtUpdate {
+	du.mutation.SetDebtorID(i)
+	return du
+}
+
+// SetAmount sets the "amount" field.
+func (du *DebtUpdate) SetAmount(i int64) *DebtUpdate {
+	du.mutation.ResetAmount()
+	du.mutation.SetAmount(i)
+	return du
+}
+
+// AddAmount adds i to the "amount" field.
+func (du *DebtUpdate) AddAmount(i int64) *DebtUpdate {
+	du.mutation.AddAmount(i)
+	return du
+}
+
+// SetCategory sets the "category" field.
+func (du *DebtUpdate) SetCategory(s string) *DebtUpdate {
+	du.mutation.SetCategory(s)
+	return du
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (du *DebtUpdate) SetCreatedAt(t time.Time) *DebtUpdate {
+	du.mutation.SetCreatedAt(t)
+	return du
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (du *DebtUpdate) SetNillableCreatedAt(t *time.Time) *DebtUpdate {
+	if t != nil {
+		du.SetCreatedAt(*t)
+	}
+	return du
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (du *DebtUpdate) SetSettledAt(t time.Time) *DebtUpdate {
+	du.mutation.SetSettledAt(t)
+	return du
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (du *DebtUpdate) SetNillableSettledAt(t *time.Time) *DebtUpdate {
+	if t != nil {
+		du.SetSettledAt(*t)
+	}
+	return du
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (du *DebtUpdate) ClearSettledAt() *DebtUpdate {
+	du.mutation.ClearSettledAt()
+	return du
+}
+
+// SetCreditor sets the "creditor" edge to the User entity.
+func (du *DebtUpdate) SetCreditor(u *User) *DebtUpdate {
+	return du.SetCreditorID(u.ID)
+}
+
+// SetDebtor sets the "debtor" edge to the User entity.
+func (du *DebtUpdate) SetDebtor(u *User) *DebtUpdate {
+	return du.SetDebtorID(u.ID)
+}
+
+// Mutation returns the DebtMutation object of the builder.
+func (du *DebtUpdate) Mutation() *DebtMutation {
+	return du.mutation
+}
+
+// ClearCreditor clears the "creditor" edge to the User entity.
+func (du *DebtUpdate) ClearCreditor() *DebtUpdate {
+	du.mutation.ClearCreditor()
+	return du
+}
+
+// ClearDebtor clears the "debtor" edge to the User entity.
+func (du *DebtUpdate) ClearDebtor() *DebtUpdate {
+	du.mutation.ClearDebtor()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DebtUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(du.hooks) == 0 {
+		if err = du.check(); err != nil {
+			return 0, err
+		}
+		affected, err = du.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DebtMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = du.check(); err != nil {
+				return 0, err
+			}
+			du.mutation = mutation
+			affected, err = du.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(du.hooks) - 1; i >= 0; i-- {
+			if du.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = du.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, du.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DebtUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DebtUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DebtUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DebtUpdate) check() error {
+	if v, ok := du.mutation.Amount(); ok {
+		if err := debt.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Debt.amount": %w`, err)}
+		}
+	}
+	if _, ok := du.mutation.CreditorID(); du.mutation.CreditorCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Debt.creditor"`)
+	}
+	if _, ok := du.mutation.DebtorID(); du.mutation.DebtorCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Debt.debtor"`)
+	}
+	return nil
+}
+
+func (du *DebtUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   debt.Table,
+			Columns: debt.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: debt.FieldID,
+			},
+		},
+	}
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: debt.FieldAmount,
+		})
+	}
+	if value, ok := du.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: debt.FieldAmount,
+		})
+	}
+	if value, ok := du.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: debt.FieldCategory,
+		})
+	}
+	if value, ok := du.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: debt.FieldCreatedAt,
+		})
+	}
+	if value, ok := du.mutation.SettledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: debt.FieldSettledAt,
+		})
+	}
+	if du.mutation.SettledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: debt.FieldSettledAt,
+		})
+	}
+	if du.mutation.CreditorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.CreditorTable,
+			Columns: []string{debt.CreditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.CreditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.CreditorTable,
+			Columns: []string{debt.CreditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.DebtorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.DebtorTable,
+			Columns: []string{debt.DebtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.DebtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.DebtorTable,
+			Columns: []string{debt.DebtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{debt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// DebtUpdateOne is the builder for updating a single Debt entity.
+type DebtUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DebtMutation
+}
+
+// SetCreditorID sets the "creditor_id" field.
+func (duo *DebtUpdateOne) SetCreditorID(i int64) *DebtUpdateOne {
+	duo.mutation.SetCreditorID(i)
+	return duo
+}
+
+// SetDebtorID sets the "debtor_id" field.
+func (duo *DebtUpdateOne) SetDebtorID(i int64) *DebtUpdateOne {
+	duo.mutation.SetDebtorID(i)
+	return duo
+}
+
+// SetAmount sets the "amount" field.
+func (duo *DebtUpdateOne) SetAmount(i int64) *DebtUpdateOne {
+	duo.mutation.ResetAmount()
+	duo.mutation.SetAmount(i)
+	return duo
+}
+
+// AddAmount adds i to the "amount" field.
+func (duo *DebtUpdateOne) AddAmount(i int64) *DebtUpdateOne {
+	duo.mutation.AddAmount(i)
+	return duo
+}
+
+// SetCategory sets the "category" field.
+func (duo *DebtUpdateOne) SetCategory(s string) *DebtUpdateOne {
+	duo.mutation.SetCategory(s)
+	return duo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (duo *DebtUpdateOne) SetCreatedAt(t time.Time) *DebtUpdateOne {
+	duo.mutation.SetCreatedAt(t)
+	return duo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableCreatedAt(t *time.Time) *DebtUpdateOne {
+	if t != nil {
+		duo.SetCreatedAt(*t)
+	}
+	return duo
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (duo *DebtUpdateOne) SetSettledAt(t time.Time) *DebtUpdateOne {
+	duo.mutation.SetSettledAt(t)
+	return duo
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (duo *DebtUpdateOne) SetNillableSettledAt(t *time.Time) *DebtUpdateOne {
+	if t != nil {
+		duo.SetSettledAt(*t)
+	}
+	return duo
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (duo *DebtUpdateOne) ClearSettledAt() *DebtUpdateOne {
+	duo.mutation.ClearSettledAt()
+	return duo
+}
+
+// SetCreditor sets the "creditor" edge to the User entity.
+func (duo *DebtUpdateOne) SetCreditor(u *User) *DebtUpdateOne {
+	return duo.SetCreditorID(u.ID)
+}
+
+// SetDebtor sets the "debtor" edge to the User entity.
+func (duo *DebtUpdateOne) SetDebtor(u *User) *DebtUpdateOne {
+	return duo.SetDebtorID(u.ID)
+}
+
+// Mutation returns the DebtMutation object of the builder.
+func (duo *DebtUpdateOne) Mutation() *DebtMutation {
+	return duo.mutation
+}
+
+// ClearCreditor clears the "creditor" edge to the User entity.
+func (duo *DebtUpdateOne) ClearCreditor() *DebtUpdateOne {
+	duo.mutation.ClearCreditor()
+	return duo
+}
+
+// ClearDebtor clears the "debtor" edge to the User entity.
+func (duo *DebtUpdateOne) ClearDebtor() *DebtUpdateOne {
+	duo.mutation.ClearDebtor()
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DebtUpdateOne) Select(field string, fields ...string) *DebtUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Debt entity.
+func (duo *DebtUpdateOne) Save(ctx context.Context) (*Debt, error) {
+	var (
+		err  error
+		node *Debt
+	)
+	if len(duo.hooks) == 0 {
+		if err = duo.check(); err != nil {
+			return nil, err
+		}
+		node, err = duo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DebtMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = duo.check(); err != nil {
+				return nil, err
+			}
+			duo.mutation = mutation
+			node, err = duo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(duo.hooks) - 1; i >= 0; i-- {
+			if duo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = duo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, duo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Debt)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from DebtMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DebtUpdateOne) SaveX(ctx context.Context) *Debt {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DebtUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DebtUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DebtUpdateOne) check() error {
+	if v, ok := duo.mutation.Amount(); ok {
+		if err := debt.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Debt.amount": %w`, err)}
+		}
+	}
+	if _, ok := duo.mutation.CreditorID(); duo.mutation.CreditorCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Debt.creditor"`)
+	}
+	if _, ok := duo.mutation.DebtorID(); duo.mutation.DebtorCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Debt.debtor"`)
+	}
+	return nil
+}
+
+func (duo *DebtUpdateOne) sqlSave(ctx context.Context) (_node *Debt, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   debt.Table,
+			Columns: debt.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: debt.FieldID,
+			},
+		},
+	}
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Debt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, debt.FieldID)
+		for _, f := range fields {
+			if !debt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != debt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: debt.FieldAmount,
+		})
+	}
+	if value, ok := duo.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: debt.FieldAmount,
+		})
+	}
+	if value, ok := duo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: debt.FieldCategory,
+		})
+	}
+	if value, ok := duo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: debt.FieldCreatedAt,
+		})
+	}
+	if value, ok := duo.mutation.SettledAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: debt.FieldSettledAt,
+		})
+	}
+	if duo.mutation.SettledAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: debt.FieldSettledAt,
+		})
+	}
+	if duo.mutation.CreditorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.CreditorTable,
+			Columns: []string{debt.CreditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.CreditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.CreditorTable,
+			Columns: []string{debt.CreditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.DebtorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.DebtorTable,
+			Columns: []string{debt.DebtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.DebtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   debt.DebtorTable,
+			Columns: []string{debt.DebtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Debt{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{debt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -10,6 +10,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/exchangerate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/tag"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
 )
@@ -32,8 +36,12 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		user.Table:  user.ValidColumn,
-		waste.Table: waste.ValidColumn,
+		debt.Table:         debt.ValidColumn,
+		exchangerate.Table: exchangerate.ValidColumn,
+		goal.Table:         goal.ValidColumn,
+		tag.Table:          tag.ValidColumn,
+		user.Table:         user.ValidColumn,
+		waste.Table:        waste.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/exchangerate"
+)
+
+// ExchangeRate is the model entity for the ExchangeRate schema.
+type ExchangeRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Base holds the value of the "base" field.
+	Base string `json:"base,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate string `json:"rate,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExchangeRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldID, exchangerate.FieldBase, exchangerate.FieldRate:
+			values[i] = new(sql.NullString)
+		case exchangerate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ExchangeRate", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExchangeRate fields.
+func (er *ExchangeRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				er.ID = value.String
+			}
+		case exchangerate.FieldBase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base", values[i])
+			} else if value.Valid {
+				er.Base = value.String
+			}
+		case exchangerate.FieldRate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				er.Rate = value.String
+			}
+		case exchangerate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				er.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ExchangeRate.
+// Note that you need to call ExchangeRate.Unwrap() before calling this method if this ExchangeRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (er *ExchangeRate) Update() *ExchangeRateUpdateOne {
+	return (&ExchangeRateClient{config: er.config}).UpdateOne(er)
+}
+
+// Unwrap unwraps the ExchangeRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (er *ExchangeRate) Unwrap() *ExchangeRate {
+	_tx, ok := er.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExchangeRate is not a transactional entity")
+	}
+	er.config.driver = _tx.drv
+	return er
+}
+
+// String implements the fmt.Stringer.
+func (er *ExchangeRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExchangeRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", er.ID))
+	builder.WriteString("base=")
+	builder.WriteString(er.Base)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(er.Rate)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(er.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExchangeRates is a parsable slice of ExchangeRate.
+type ExchangeRates []*ExchangeRate
+
+func (er ExchangeRates) config(cfg config) {
+	for _i := range er {
+		er[_i].config = cfg
+	}
+}
@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Base applies equality check predicate on the "base" field. It's identical to BaseEQ.
+func Base(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBase), v))
+	})
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRate), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// BaseEQ applies the EQ predicate on the "base" field.
+func BaseEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBase), v))
+	})
+}
+
+// BaseNEQ applies the NEQ predicate on the "base" field.
+func BaseNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBase), v))
+	})
+}
+
+// BaseIn applies the In predicate on the "base" field.
+func BaseIn(vs ...string) predicate.ExchangeRate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldBase), v...))
+	})
+}
+
+// BaseNotIn applies the NotIn predicate on the "base" field.
+func BaseNotIn(vs ...string) predicate.ExchangeRate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldBase), v...))
+	})
+}
+
+// BaseGT applies the GT predicate on the "base" field.
+func BaseGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBase), v))
+	})
+}
+
+// BaseGTE applies the GTE predicate on the "base" field.
+func BaseGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBase), v))
+	})
+}
+
+// BaseLT applies the LT predicate on the "base" field.
+func BaseLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBase), v))
+	})
+}
+
+// BaseLTE applies the LTE predicate on the "base" field.
+func BaseLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBase), v))
+	})
+}
+
+// BaseContains applies the Contains predicate on the "base" field.
+func BaseContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBase), v))
+	})
+}
+
+// BaseHasPrefix applies the HasPrefix predicate on the "base" field.
+func BaseHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBase), v))
+	})
+}
+
+// BaseHasSuffix applies the HasSuffix predicate on the "base" field.
+func BaseHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBase), v))
+	})
+}
+
+// BaseEqualFold applies the EqualFold predicate on the "base" field.
+func BaseEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBase), v))
+	})
+}
+
+// BaseContainsFold applies the ContainsFold predicate on the "base" field.
+func BaseContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBase), v))
+	})
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRate), v))
+	})
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRate), v))
+	})
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...string) predicate.ExchangeRate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldRate), v...))
+	})
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...string) predicate.ExchangeRate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldRate), v...))
+	})
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRate), v))
+	})
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRate), v))
+	})
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRate), v))
+	})
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRate), v))
+	})
+}
+
+// RateContains applies the Contains predicate on the "rate" field.
+func RateContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRate), v))
+	})
+}
+
+// RateHasPrefix applies the HasPrefix predicate on the "rate" field.
+func RateHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRate), v))
+	})
+}
+
+// RateHasSuffix applies the HasSuffix predicate on the "rate" field.
+func RateHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRate), v))
+	})
+}
+
+// RateEqualFold applies the EqualFold predicate on the "rate" field.
+func RateEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRate), v))
+	})
+}
+
+// RateContainsFold applies the ContainsFold predicate on the "rate" field.
+func RateContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRate), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ExchangeRate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ExchangeRate {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/exchangerate"
+)
+
+// ExchangeRateCreate is the builder for creating a ExchangeRate entity.
+type ExchangeRateCreate struct {
+	config
+	mutation *ExchangeRateMutation
+	hooks    []Hook
+}
+
+// SetBase sets the "base" field.
+func (erc *ExchangeRateCreate) SetBase(s string) *ExchangeRateCreate {
+	erc.mutation.SetBase(s)
+	return erc
+}
+
+// SetRate sets the "rate" field.
+func (erc *ExchangeRateCreate) SetRate(s string) *ExchangeRateCreate {
+	erc.mutation.SetRate(s)
+	return erc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (erc *ExchangeRateCreate) SetUpdatedAt(t time.Time) *ExchangeRateCreate {
+	erc.mutation.SetUpdatedAt(t)
+	return erc
+}
+
+// SetID sets the "id" field.
+func (erc *ExchangeRateCreate) SetID(s string) *ExchangeRateCreate {
+	erc.mutation.SetID(s)
+	return erc
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (erc *ExchangeRateCreate) Mutation() *ExchangeRateMutation {
+	return erc.mutation
+}
+
+// Save creates the ExchangeRate in the database.
+func (erc *ExchangeRateCreate) Save(ctx context.Context) (*ExchangeRate, error) {
+	var (
+		err  error
+		node *ExchangeRate
+	)
+	if len(erc.hooks) == 0 {
+		if err = erc.check(); err != nil {
+			return nil, err
+		}
+		node, err = erc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExchangeRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = erc.check(); err != nil {
+				return nil, err
+			}
+			erc.mutation = mutation
+			if node, err = erc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(erc.hooks) - 1; i >= 0; i-- {
+			if erc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = erc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, erc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ExchangeRate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ExchangeRateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (erc *ExchangeRateCreate) SaveX(ctx context.Context) *ExchangeRate {
+	v, err := erc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (erc *ExchangeRateCreate) Exec(ctx context.Context) error {
+	_, err := erc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erc *ExchangeRateCreate) ExecX(ctx context.Context) {
+	if err := erc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (erc *ExchangeRateCreate) check() error {
+	if _, ok := erc.mutation.Base(); !ok {
+		return &ValidationError{Name: "base", err: errors.New(`ent: missing required field "ExchangeRate.base"`)}
+	}
+	if _, ok := erc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "ExchangeRate.rate"`)}
+	}
+	if _, ok := erc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExchangeRate.updated_at"`)}
+	}
+	return nil
+}
+
+func (erc *ExchangeRateCreate) sqlSave(ctx context.Context) (*ExchangeRate, error) {
+	_node, _spec := erc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, erc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ExchangeRate.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (erc *ExchangeRateCreate) createSpec() (*ExchangeRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExchangeRate{config: erc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: exchangerate.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: exchangerate.FieldID,
+			},
+		}
+	)
+	if id, ok := erc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := erc.mutation.Base(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldBase,
+		})
+		_node.Base = value
+	}
+	if value, ok := erc.mutation.Rate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldRate,
+		})
+		_node.Rate = value
+	}
+	if value, ok := erc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ExchangeRateCreateBulk is the builder for creating many ExchangeRate entities in bulk.
+type ExchangeRateCreateBulk struct {
+	config
+	builders []*ExchangeRateCreate
+}
+
+// Save creates the ExchangeRate entities in the database.
+func (ercb *ExchangeRateCreateBulk) Save(ctx context.Context) ([]*ExchangeRate, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ercb.builders))
+	nodes := make([]*ExchangeRate, len(ercb.builders))
+	mutators := make([]Mutator, len(ercb.builders))
+	for i := range ercb.builders {
+		func(i int, root context.Context) {
+			builder := ercb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExchangeRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ercb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ercb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ercb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ercb *ExchangeRateCreateBulk) SaveX(ctx context.Context) []*ExchangeRate {
+	v, err := ercb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ercb *ExchangeRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ercb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ercb *ExchangeRateCreateBulk) ExecX(ctx context.Context) {
+	if err := ercb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/exchangerate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+)
+
+// ExchangeRateUpdate is the builder for updating ExchangeRate entities.
+type ExchangeRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (eru *ExchangeRateUpdate) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdate {
+	eru.mutation.Where(ps...)
+	return eru
+}
+
+// SetBase sets the "base" field.
+func (eru *ExchangeRateUpdate) SetBase(s string) *ExchangeRateUpdate {
+	eru.mutation.SetBase(s)
+	return eru
+}
+
+// SetRate sets the "rate" field.
+func (eru *ExchangeRateUpdate) SetRate(s string) *ExchangeRateUpdate {
+	eru.mutation.SetRate(s)
+	return eru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eru *ExchangeRateUpdate) SetUpdatedAt(t time.Time) *ExchangeRateUpdate {
+	eru.mutation.SetUpdatedAt(t)
+	return eru
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (eru *ExchangeRateUpdate) Mutation() *ExchangeRateMutation {
+	return eru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eru *ExchangeRateUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(eru.hooks) == 0 {
+		affected, err = eru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExchangeRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			eru.mutation = mutation
+			affected, err = eru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(eru.hooks) - 1; i >= 0; i-- {
+			if eru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = eru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, eru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eru *ExchangeRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := eru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eru *ExchangeRateUpdate) Exec(ctx context.Context) error {
+	_, err := eru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eru *ExchangeRateUpdate) ExecX(ctx context.Context) {
+	if err := eru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eru *ExchangeRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   exchangerate.Table,
+			Columns: exchangerate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: exchangerate.FieldID,
+			},
+		},
+	}
+	if ps := eru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eru.mutation.Base(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldBase,
+		})
+	}
+	if value, ok := eru.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldRate,
+		})
+	}
+	if value, ok := eru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ExchangeRateUpdateOne is the builder for updating a single ExchangeRate entity.
+type ExchangeRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// SetBase sets the "base" field.
+func (eruo *ExchangeRateUpdateOne) SetBase(s string) *ExchangeRateUpdateOne {
+	eruo.mutation.SetBase(s)
+	return eruo
+}
+
+// SetRate sets the "rate" field.
+func (eruo *ExchangeRateUpdateOne) SetRate(s string) *ExchangeRateUpdateOne {
+	eruo.mutation.SetRate(s)
+	return eruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eruo *ExchangeRateUpdateOne) SetUpdatedAt(t time.Time) *ExchangeRateUpdateOne {
+	eruo.mutation.SetUpdatedAt(t)
+	return eruo
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (eruo *ExchangeRateUpdateOne) Mutation() *ExchangeRateMutation {
+	return eruo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eruo *ExchangeRateUpdateOne) Select(field string, fields ...string) *ExchangeRateUpdateOne {
+	eruo.fields = append([]string{field}, fields...)
+	return eruo
+}
+
+// Save executes the query and returns the updated ExchangeRate entity.
+func (eruo *ExchangeRateUpdateOne) Save(ctx context.Context) (*ExchangeRate, error) {
+	var (
+		err  error
+		node *ExchangeRate
+	)
+	if len(eruo.hooks) == 0 {
+		node, err = eruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExchangeRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			eruo.mutation = mutation
+			node, err = eruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(eruo.hooks) - 1; i >= 0; i-- {
+			if eruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = eruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, eruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ExchangeRate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ExchangeRateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eruo *ExchangeRateUpdateOne) SaveX(ctx context.Context) *ExchangeRate {
+	node, err := eruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eruo *ExchangeRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := eruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eruo *ExchangeRateUpdateOne) ExecX(ctx context.Context) {
+	if err := eruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eruo *ExchangeRateUpdateOne) sqlSave(ctx context.Context) (_node *ExchangeRate, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   exchangerate.Table,
+			Columns: exchangerate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: exchangerate.FieldID,
+			},
+		},
+	}
+	id, ok := eruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExchangeRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exchangerate.FieldID)
+		for _, f := range fields {
+			if !exchangerate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exchangerate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eruo.mutation.Base(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldBase,
+		})
+	}
+	if value, ok := eruo.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldRate,
+		})
+	}
+	if value, ok := eruo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldUpdatedAt,
+		})
+	}
+	_node = &ExchangeRate{config: eruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
+)
+
+// Goal is the model entity for the Goal schema.
+type Goal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Target holds the value of the "target" field.
+	Target int64 `json:"target,omitempty"`
+	// Saved holds the value of the "saved" field.
+	Saved int64 `json:"saved,omitempty"`
+	// Deadline holds the value of the "deadline" field.
+	Deadline time.Time `json:"deadline,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GoalQuery when eager-loading is set.
+	Edges GoalEdges `json:"edges"`
+}
+
+// GoalEdges holds the relations/edges for other nodes in the graph.
+type GoalEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Goal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goal.FieldID, goal.FieldUserID, goal.FieldTarget, goal.FieldSaved:
+			values[i] = new(sql.NullInt64)
+		case goal.FieldName:
+			values[i] = new(sql.NullString)
+		case goal.FieldDeadline, goal.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Goal", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Goal fields.
+func (_go *Goal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goal.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_go.ID = int(value.Int64)
+		case goal.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_go.UserID = value.Int64
+			}
+		case goal.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_go.Name = value.String
+			}
+		case goal.FieldTarget:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				_go.Target = value.Int64
+			}
+		case goal.FieldSaved:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field saved", values[i])
+			} else if value.Valid {
+				_go.Saved = value.Int64
+			}
+		case goal.FieldDeadline:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline", values[i])
+			} else if value.Valid {
+				_go.Deadline = value.Time
+			}
+		case goal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_go.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the "user" edge of the Goal entity.
+func (_go *Goal) QueryUser() *UserQuery {
+	return (&GoalClient{config: _go.config}).QueryUser(_go)
+}
+
+// Update returns a builder for updating this Goal.
+// Note that you need to call Goal.Unwrap() before calling this method if this Goal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_go *Goal) Update() *GoalUpdateOne {
+	return (&GoalClient{config: _go.config}).UpdateOne(_go)
+}
+
+// Unwrap unwraps the Goal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_go *Goal) Unwrap() *Goal {
+	_tx, ok := _go.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Goal is not a transactional entity")
+	}
+	_go.config.driver = _tx.drv
+	return _go
+}
+
+// String implements the fmt.Stringer.
+func (_go *Goal) String() string {
+	var builder strings.Builder
+	builder.WriteString("Goal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _go.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _go.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_go.Name)
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(fmt.Sprintf("%v", _go.Target))
+	builder.WriteString(", ")
+	builder.WriteString("saved=")
+	builder.WriteString(fmt.Sprintf("%v", _go.Saved))
+	builder.WriteString(", ")
+	builder.WriteString("deadline=")
+	builder.WriteString(_go.Deadline.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_go.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Goals is a parsable slice of Goal.
+type Goals []*Goal
+
+func (_go Goals) config(cfg config) {
+	for _i := range _go {
+		_go[_i].config = cfg
+	}
+}
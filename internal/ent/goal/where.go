@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		v := make([]any, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Target applies equality check predicate on the "target" field. It's identical to TargetEQ.
+func Target(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTarget), v))
+	})
+}
+
+// Saved applies equality check predicate on the "saved" field. It's identical to SavedEQ.
+func Saved(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSaved), v))
+	})
+}
+
+// Deadline applies equality check predicate on the "deadline" field. It's identical to DeadlineEQ.
+func Deadline(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadline), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTarget), v))
+	})
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTarget), v))
+	})
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...int64) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTarget), v...))
+	})
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...int64) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTarget), v...))
+	})
+}
+
+// TargetGT applies the GT predicate on the "target" field.
+func TargetGT(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTarget), v))
+	})
+}
+
+// TargetGTE applies the GTE predicate on the "target" field.
+func TargetGTE(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTarget), v))
+	})
+}
+
+// TargetLT applies the LT predicate on the "target" field.
+func TargetLT(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTarget), v))
+	})
+}
+
+// TargetLTE applies the LTE predicate on the "target" field.
+func TargetLTE(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTarget), v))
+	})
+}
+
+// SavedEQ applies the EQ predicate on the "saved" field.
+func SavedEQ(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSaved), v))
+	})
+}
+
+// SavedNEQ applies the NEQ predicate on the "saved" field.
+func SavedNEQ(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSaved), v))
+	})
+}
+
+// SavedIn applies the In predicate on the "saved" field.
+func SavedIn(vs ...int64) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSaved), v...))
+	})
+}
+
+// SavedNotIn applies the NotIn predicate on the "saved" field.
+func SavedNotIn(vs ...int64) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSaved), v...))
+	})
+}
+
+// SavedGT applies the GT predicate on the "saved" field.
+func SavedGT(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSaved), v))
+	})
+}
+
+// SavedGTE applies the GTE predicate on the "saved" field.
+func SavedGTE(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSaved), v))
+	})
+}
+
+// SavedLT applies the LT predicate on the "saved" field.
+func SavedLT(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSaved), v))
+	})
+}
+
+// SavedLTE applies the LTE predicate on the "saved" field.
+func SavedLTE(v int64) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSaved), v))
+	})
+}
+
+// DeadlineEQ applies the EQ predicate on the "deadline" field.
+func DeadlineEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadline), v))
+	})
+}
+
+// DeadlineNEQ applies the NEQ predicate on the "deadline" field.
+func DeadlineNEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeadline), v))
+	})
+}
+
+// DeadlineIn applies the In predicate on the "deadline" field.
+func DeadlineIn(vs ...time.Time) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDeadline), v...))
+	})
+}
+
+// DeadlineNotIn applies the NotIn predicate on the "deadline" field.
+func DeadlineNotIn(vs ...time.Time) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDeadline), v...))
+	})
+}
+
+// DeadlineGT applies the GT predicate on the "deadline" field.
+func DeadlineGT(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeadline), v))
+	})
+}
+
+// DeadlineGTE applies the GTE predicate on the "deadline" field.
+func DeadlineGTE(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeadline), v))
+	})
+}
+
+// DeadlineLT applies the LT predicate on the "deadline" field.
+func DeadlineLT(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeadline), v))
+	})
+}
+
+// DeadlineLTE applies the LTE predicate on the "deadline" field.
+func DeadlineLTE(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeadline), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Goal {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(UserInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Goal) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Goal) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Goal) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
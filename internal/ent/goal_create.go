@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
+)
+
+// GoalCreate is the builder for creating a Goal entity.
+type GoalCreate struct {
+	config
+	mutation *GoalMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (gc *GoalCreate) SetUserID(i int64) *GoalCreate {
+	gc.mutation.SetUserID(i)
+	return gc
+}
+
+// SetName sets the "name" field.
+func (gc *GoalCreate) SetName(s string) *GoalCreate {
+	gc.mutation.SetName(s)
+	return gc
+}
+
+// SetTarget sets the "target" field.
+func (gc *GoalCreate) SetTarget(i int64) *GoalCreate {
+	gc.mutation.SetTarget(i)
+	return gc
+}
+
+// SetSaved sets the "saved" field.
+func (gc *GoalCreate) SetSaved(i int64) *GoalCreate {
+	gc.mutation.SetSaved(i)
+	return gc
+}
+
+// SetNillableSaved sets the "saved" field if the given value is not nil.
+func (gc *GoalCreate) SetNillableSaved(i *int64) *GoalCreate {
+	if i != nil {
+		gc.SetSaved(*i)
+	}
+	return gc
+}
+
+// SetDeadline sets the "deadline" field.
+func (gc *GoalCreate) SetDeadline(t time.Time) *GoalCreate {
+	gc.mutation.SetDeadline(t)
+	return gc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gc *GoalCreate) SetCreatedAt(t time.Time) *GoalCreate {
+	gc.mutation.SetCreatedAt(t)
+	return gc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gc *GoalCreate) SetNillableCreatedAt(t *time.Time) *GoalCreate {
+	if t != nil {
+		gc.SetCreatedAt(*t)
+	}
+	return gc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gc *GoalCreate) SetUser(u *User) *GoalCreate {
+	return gc.SetUserID(u.ID)
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (gc *GoalCreate) Mutation() *GoalMutation {
+	return gc.mutation
+}
+
+// Save creates the Goal in the database.
+func (gc *GoalCreate) Save(ctx context.Context) (*Goal, error) {
+	var (
+		err  error
+		node *Goal
+	)
+	gc.defaults()
+	if len(gc.hooks) == 0 {
+		if err = gc.check(); err != nil {
+			return nil, err
+		}
+		node, err = gc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GoalMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = gc.check(); err != nil {
+				return nil, err
+			}
+			gc.mutation = mutation
+			if node, err = gc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(gc.hooks) - 1; i >= 0; i-- {
+			if gc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = gc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, gc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Goal)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from GoalMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gc *GoalCreate) SaveX(ctx context.Context) *Goal {
+	v, err := gc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gc *GoalCreate) Exec(ctx context.Context) error {
+	_, err := gc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gc *GoalCreate) ExecX(ctx context.Context) {
+	if err := gc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gc *GoalCreate) defaults() {
+	if _, ok := gc.mutation.Saved(); !ok {
+		v := goal.DefaultSaved
+		gc.mutation.SetSaved(v)
+	}
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		v := goal.DefaultCreatedAt()
+		gc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gc *GoalCreate) check() error {
+	if _, ok := gc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Goal.user_id"`)}
+	}
+	if _, ok := gc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Goal.name"`)}
+	}
+	if v, ok := gc.mutation.Name(); ok {
+		if err := goal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Goal.name": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "Goal.target"`)}
+	}
+	if v, ok := gc.mutation.Target(); ok {
+		if err := goal.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Goal.target": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.Saved(); !ok {
+		return &ValidationError{Name: "saved", err: errors.New(`ent: missing required field "Goal.saved"`)}
+	}
+	if _, ok := gc.mutation.Deadline(); !ok {
+		return &ValidationError{Name: "deadline", err: errors.New(`ent: missing required field "Goal.deadline"`)}
+	}
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Goal.created_at"`)}
+	}
+	if _, ok := gc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Goal.user"`)}
+	}
+	return nil
+}
+
+func (gc *GoalCreate) sqlSave(ctx context.Context) (*Goal, error) {
+	_node, _spec := gc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (gc *GoalCreate) createSpec() (*Goal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Goal{config: gc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: goal.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: goal.FieldID,
+			},
+		}
+	)
+	if value, ok := gc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: goal.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := gc.mutation.Target(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldTarget,
+		})
+		_node.Target = value
+	}
+	if value, ok := gc.mutation.Saved(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldSaved,
+		})
+		_node.Saved = value
+	}
+	if value, ok := gc.mutation.Deadline(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: goal.FieldDeadline,
+		})
+		_node.Deadline = value
+	}
+	if value, ok := gc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: goal.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := gc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.UserTable,
+			Columns: []string{goal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GoalCreateBulk is the builder for creating many Goal entities in bulk.
+type GoalCreateBulk struct {
+	config
+	builders []*GoalCreate
+}
+
+// Save creates the Goal entities in the database.
+func (gcb *GoalCreateBulk) Save(ctx context.Context) ([]*Goal, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
+	nodes := make([]*Goal, len(gcb.builders))
+	mutators := make([]Mutator, len(gcb.builders))
+	for i := range gcb.builders {
+		func(i int, root context.Context) {
+			builder := gcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcb *GoalCreateBulk) SaveX(ctx context.Context) []*Goal {
+	v, err := gcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gcb *GoalCreateBulk) Exec(ctx context.Context) error {
+	_, err := gcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcb *GoalCreateBulk) ExecX(ctx context.Context) {
+	if err := gcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,617 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
+)
+
+// GoalUpdate is the builder for updating Goal entities.
+type GoalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoalMutation
+}
+
+// Where appends a list predicates to the GoalUpdate builder.
+func (gu *GoalUpdate) Where(ps ...predicate.Goal) *GoalUpdate {
+	gu.mutation.Where(ps...)
+	return gu
+}
+
+// SetUserID sets the "user_id" field.
+func (gu *GoalUpdate) SetUserID(i int64) *GoalUpdate {
+	gu.mutation.SetUserID(i)
+	return gu
+}
+
+// SetName sets the "name" field.
+func (gu *GoalUpdate) SetName(s string) *GoalUpdate {
+	gu.mutation.SetName(s)
+	return gu
+}
+
+// SetTarget sets the "target" field.
+func (gu *GoalUpdate) SetTarget(i int64) *GoalUpdate {
+	gu.mutation.ResetTarget()
+	gu.mutation.SetTarget(i)
+	return gu
+}
+
+// AddTarget adds i to the "target" field.
+func (gu *GoalUpdate) AddTarget(i int64) *GoalUpdate {
+	gu.mutation.AddTarget(i)
+	return gu
+}
+
+// SetSaved sets the "saved" field.
+func (gu *GoalUpdate) SetSaved(i int64) *GoalUpdate {
+	gu.mutation.ResetSaved()
+	gu.mutation.SetSaved(i)
+	return gu
+}
+
+// SetNillableSaved sets the "saved" field if the given value is not nil.
+func (gu *GoalUpdate) SetNillableSaved(i *int64) *GoalUpdate {
+	if i != nil {
+		gu.SetSaved(*i)
+	}
+	return gu
+}
+
+// AddSaved adds i to the "saved" field.
+func (gu *GoalUpdate) AddSaved(i int64) *GoalUpdate {
+	gu.mutation.AddSaved(i)
+	return gu
+}
+
+// SetDeadline sets the "deadline" field.
+func (gu *GoalUpdate) SetDeadline(t time.Time) *GoalUpdate {
+	gu.mutation.SetDeadline(t)
+	return gu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gu *GoalUpdate) SetCreatedAt(t time.Time) *GoalUpdate {
+	gu.mutation.SetCreatedAt(t)
+	return gu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gu *GoalUpdate) SetNillableCreatedAt(t *time.Time) *GoalUpdate {
+	if t != nil {
+		gu.SetCreatedAt(*t)
+	}
+	return gu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gu *GoalUpdate) SetUser(u *User) *GoalUpdate {
+	return gu.SetUserID(u.ID)
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (gu *GoalUpdate) Mutation() *GoalMutation {
+	return gu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (gu *GoalUpdate) ClearUser() *GoalUpdate {
+	gu.mutation.ClearUser()
+	return gu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gu *GoalUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(gu.hooks) == 0 {
+		if err = gu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = gu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GoalMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = gu.check(); err != nil {
+				return 0, err
+			}
+			gu.mutation = mutation
+			affected, err = gu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(gu.hooks) - 1; i >= 0; i-- {
+			if gu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = gu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, gu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gu *GoalUpdate) SaveX(ctx context.Context) int {
+	affected, err := gu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gu *GoalUpdate) Exec(ctx context.Context) error {
+	_, err := gu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gu *GoalUpdate) ExecX(ctx context.Context) {
+	if err := gu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gu *GoalUpdate) check() error {
+	if v, ok := gu.mutation.Name(); ok {
+		if err := goal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Goal.name": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.Target(); ok {
+		if err := goal.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Goal.target": %w`, err)}
+		}
+	}
+	if _, ok := gu.mutation.UserID(); gu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Goal.user"`)
+	}
+	return nil
+}
+
+func (gu *GoalUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   goal.Table,
+			Columns: goal.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: goal.FieldID,
+			},
+		},
+	}
+	if ps := gu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: goal.FieldName,
+		})
+	}
+	if value, ok := gu.mutation.Target(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldTarget,
+		})
+	}
+	if value, ok := gu.mutation.AddedTarget(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldTarget,
+		})
+	}
+	if value, ok := gu.mutation.Saved(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldSaved,
+		})
+	}
+	if value, ok := gu.mutation.AddedSaved(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldSaved,
+		})
+	}
+	if value, ok := gu.mutation.Deadline(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: goal.FieldDeadline,
+		})
+	}
+	if value, ok := gu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: goal.FieldCreatedAt,
+		})
+	}
+	if gu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.UserTable,
+			Columns: []string{goal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.UserTable,
+			Columns: []string{goal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// GoalUpdateOne is the builder for updating a single Goal entity.
+type GoalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoalMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (guo *GoalUpdateOne) SetUserID(i int64) *GoalUpdateOne {
+	guo.mutation.SetUserID(i)
+	return guo
+}
+
+// SetName sets the "name" field.
+func (guo *GoalUpdateOne) SetName(s string) *GoalUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetTarget sets the "target" field.
+func (guo *GoalUpdateOne) SetTarget(i int64) *GoalUpdateOne {
+	guo.mutation.ResetTarget()
+	guo.mutation.SetTarget(i)
+	return guo
+}
+
+// AddTarget adds i to the "target" field.
+func (guo *GoalUpdateOne) AddTarget(i int64) *GoalUpdateOne {
+	guo.mutation.AddTarget(i)
+	return guo
+}
+
+// SetSaved sets the "saved" field.
+func (guo *GoalUpdateOne) SetSaved(i int64) *GoalUpdateOne {
+	guo.mutation.ResetSaved()
+	guo.mutation.SetSaved(i)
+	return guo
+}
+
+// SetNillableSaved sets the "saved" field if the given value is not nil.
+func (guo *GoalUpdateOne) SetNillableSaved(i *int64) *GoalUpdateOne {
+	if i != nil {
+		guo.SetSaved(*i)
+	}
+	return guo
+}
+
+// AddSaved adds i to the "saved" field.
+func (guo *GoalUpdateOne) AddSaved(i int64) *GoalUpdateOne {
+	guo.mutation.AddSaved(i)
+	return guo
+}
+
+// SetDeadline sets the "deadline" field.
+func (guo *GoalUpdateOne) SetDeadline(t time.Time) *GoalUpdateOne {
+	guo.mutation.SetDeadline(t)
+	return guo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (guo *GoalUpdateOne) SetCreatedAt(t time.Time) *GoalUpdateOne {
+	guo.mutation.SetCreatedAt(t)
+	return guo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (guo *GoalUpdateOne) SetNillableCreatedAt(t *time.Time) *GoalUpdateOne {
+	if t != nil {
+		guo.SetCreatedAt(*t)
+	}
+	return guo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (guo *GoalUpdateOne) SetUser(u *User) *GoalUpdateOne {
+	return guo.SetUserID(u.ID)
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (guo *GoalUpdateOne) Mutation() *GoalMutation {
+	return guo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (guo *GoalUpdateOne) ClearUser() *GoalUpdateOne {
+	guo.mutation.ClearUser()
+	return guo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (guo *GoalUpdateOne) Select(field string, fields ...string) *GoalUpdateOne {
+	guo.fields = append([]string{field}, fields...)
+	return guo
+}
+
+// Save executes the query and returns the updated Goal entity.
+func (guo *GoalUpdateOne) Save(ctx context.Context) (*Goal, error) {
+	var (
+		err  error
+		node *Goal
+	)
+	if len(guo.hooks) == 0 {
+		if err = guo.check(); err != nil {
+			return nil, err
+		}
+		node, err = guo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*GoalMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = guo.check(); err != nil {
+				return nil, err
+			}
+			guo.mutation = mutation
+			node, err = guo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(guo.hooks) - 1; i >= 0; i-- {
+			if guo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = guo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, guo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Goal)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from GoalMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (guo *GoalUpdateOne) SaveX(ctx context.Context) *Goal {
+	node, err := guo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (guo *GoalUpdateOne) Exec(ctx context.Context) error {
+	_, err := guo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (guo *GoalUpdateOne) ExecX(ctx context.Context) {
+	if err := guo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (guo *GoalUpdateOne) check() error {
+	if v, ok := guo.mutation.Name(); ok {
+		if err := goal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Goal.name": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.Target(); ok {
+		if err := goal.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Goal.target": %w`, err)}
+		}
+	}
+	if _, ok := guo.mutation.UserID(); guo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Goal.user"`)
+	}
+	return nil
+}
+
+func (guo *GoalUpdateOne) sqlSave(ctx context.Context) (_node *Goal, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   goal.Table,
+			Columns: goal.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: goal.FieldID,
+			},
+		},
+	}
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Goal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := guo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goal.FieldID)
+		for _, f := range fields {
+			if !goal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := guo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := guo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: goal.FieldName,
+		})
+	}
+	if value, ok := guo.mutation.Target(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldTarget,
+		})
+	}
+	if value, ok := guo.mutation.AddedTarget(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldTarget,
+		})
+	}
+	if value, ok := guo.mutation.Saved(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldSaved,
+		})
+	}
+	if value, ok := guo.mutation.AddedSaved(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: goal.FieldSaved,
+		})
+	}
+	if value, ok := guo.mutation.Deadline(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: goal.FieldDeadline,
+		})
+	}
+	if value, ok := guo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: goal.FieldCreatedAt,
+		})
+	}
+	if guo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.UserTable,
+			Columns: []string{goal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.UserTable,
+			Columns: []string{goal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt64,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Goal{config: guo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -8,6 +8,103 @@ import (
 )
 
 var (
+	// DebtsColumns holds the columns for the "debts" table.
+	DebtsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "category", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "settled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "creditor_id", Type: field.TypeInt64},
+		{Name: "debtor_id", Type: field.TypeInt64},
+	}
+	// DebtsTable holds the schema information for the "debts" table.
+	DebtsTable = &schema.Table{
+		Name:       "debts",
+		Columns:    DebtsColumns,
+		PrimaryKey: []*schema.Column{DebtsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "debts_users_credits",
+				Columns:    []*schema.Column{DebtsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "debts_users_debts",
+				Columns:    []*schema.Column{DebtsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "debt_creditor_id_debtor_id",
+				Unique:  false,
+				Columns: []*schema.Column{DebtsColumns[5], DebtsColumns[6]},
+			},
+			{
+				Name:    "debt_debtor_id",
+				Unique:  false,
+				Columns: []*schema.Column{DebtsColumns[6]},
+			},
+		},
+	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "currency", Type: field.TypeString},
+		{Name: "base", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+	}
+	// GoalsColumns holds the columns for the "goals" table.
+	GoalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "target", Type: field.TypeInt64},
+		{Name: "saved", Type: field.TypeInt64, Default: 0},
+		{Name: "deadline", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+	}
+	// GoalsTable holds the schema information for the "goals" table.
+	GoalsTable = &schema.Table{
+		Name:       "goals",
+		Columns:    GoalsColumns,
+		PrimaryKey: []*schema.Column{GoalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "goals_users_goals",
+				Columns:    []*schema.Column{GoalsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goal_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{GoalsColumns[6], GoalsColumns[1]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -15,6 +112,11 @@ var (
 		{Name: "last_name", Type: field.TypeString},
 		{Name: "user_name", Type: field.TypeString},
 		{Name: "waste_limit", Type: field.TypeUint64, Nullable: true},
+		{Name: "api_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "language", Type: field.TypeString, Nullable: true},
+		{Name: "currencies", Type: field.TypeJSON, Nullable: true},
+		{Name: "income", Type: field.TypeInt64, Nullable: true},
+		{Name: "goal_nudge_month", Type: field.TypeString, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -35,6 +137,10 @@ var (
 		{Name: "cost", Type: field.TypeInt64},
 		{Name: "category", Type: field.TypeString},
 		{Name: "date", Type: field.TypeTime},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "original_cost", Type: field.TypeInt64, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "claim_status", Type: field.TypeString, Nullable: true},
 		{Name: "user_wastes", Type: field.TypeInt64, Nullable: true},
 	}
 	// WastesTable holds the schema information for the "wastes" table.
@@ -45,7 +151,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "wastes_users_wastes",
-				Columns:    []*schema.Column{WastesColumns[4]},
+				Columns:    []*schema.Column{WastesColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -58,13 +164,48 @@ var (
 			},
 		},
 	}
+	// TagWastesColumns holds the columns for the "tag_wastes" table.
+	TagWastesColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeInt},
+		{Name: "waste_id", Type: field.TypeUUID},
+	}
+	// TagWastesTable holds the schema information for the "tag_wastes" table.
+	TagWastesTable = &schema.Table{
+		Name:       "tag_wastes",
+		Columns:    TagWastesColumns,
+		PrimaryKey: []*schema.Column{TagWastesColumns[0], TagWastesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_wastes_tag_id",
+				Columns:    []*schema.Column{TagWastesColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_wastes_waste_id",
+				Columns:    []*schema.Column{TagWastesColumns[1]},
+				RefColumns: []*schema.Column{WastesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		DebtsTable,
+		ExchangeRatesTable,
+		GoalsTable,
+		TagsTable,
 		UsersTable,
 		WastesTable,
+		TagWastesTable,
 	}
 )
 
 func init() {
+	DebtsTable.ForeignKeys[0].RefTable = UsersTable
+	DebtsTable.ForeignKeys[1].RefTable = UsersTable
+	GoalsTable.ForeignKeys[0].RefTable = UsersTable
 	WastesTable.ForeignKeys[0].RefTable = UsersTable
+	TagWastesTable.ForeignKeys[0].RefTable = TagsTable
+	TagWastesTable.ForeignKeys[1].RefTable = WastesTable
 }
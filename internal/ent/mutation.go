@@ -10,9 +10,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/exchangerate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/tag"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 
 	"entgo.io/ent"
 )
@@ -26,28 +31,2322 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeUser  = "User"
-	TypeWaste = "Waste"
+	TypeDebt         = "Debt"
+	TypeExchangeRate = "ExchangeRate"
+	TypeGoal         = "Goal"
+	TypeTag          = "Tag"
+	TypeUser         = "User"
+	TypeWaste        = "Waste"
 )
 
+// DebtMutation represents an operation that mutates the Debt nodes in the graph.
+type DebtMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	amount          *int64
+	addamount       *int64
+	category        *string
+	created_at      *time.Time
+	settled_at      *time.Time
+	clearedFields   map[string]struct{}
+	creditor        *int64
+	clearedcreditor bool
+	debtor          *int64
+	cleareddebtor   bool
+	done            bool
+	oldValue        func(context.Context) (*Debt, error)
+	predicates      []predicate.Debt
+}
+
+var _ ent.Mutation = (*DebtMutation)(nil)
+
+// debtOption allows management of the mutation configuration using functional options.
+type debtOption func(*DebtMutation)
+
+// newDebtMutation creates new mutation for the Debt entity.
+func newDebtMutation(c config, op Op, opts ...debtOption) *DebtMutation {
+	m := &DebtMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDebt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDebtID sets the ID field of the mutation.
+func withDebtID(id uuid.UUID) debtOption {
+	return func(m *DebtMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Debt
+		)
+		m.oldValue = func(ctx context.Context) (*Debt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Debt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDebt sets the old Debt of the mutation.
+func withDebt(node *Debt) debtOption {
+	return func(m *DebtMutation) {
+		m.oldValue = func(context.Context) (*Debt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DebtMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DebtMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Debt entities.
+func (m *DebtMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DebtMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DebtMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Debt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreditorID sets the "creditor_id" field.
+func (m *DebtMutation) SetCreditorID(i int64) {
+	m.creditor = &i
+}
+
+// CreditorID returns the value of the "creditor_id" field in the mutation.
+func (m *DebtMutation) CreditorID() (r int64, exists bool) {
+	v := m.creditor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditorID returns the old "creditor_id" field's value of the Debt entity.
+// If the Debt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DebtMutation) OldCreditorID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditorID: %w", err)
+	}
+	return oldValue.CreditorID, nil
+}
+
+// ResetCreditorID resets all changes to the "creditor_id" field.
+func (m *DebtMutation) ResetCreditorID() {
+	m.creditor = nil
+}
+
+// SetDebtorID sets the "debtor_id" field.
+func (m *DebtMutation) SetDebtorID(i int64) {
+	m.debtor = &i
+}
+
+// DebtorID returns the value of the "debtor_id" field in the mutation.
+func (m *DebtMutation) DebtorID() (r int64, exists bool) {
+	v := m.debtor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDebtorID returns the old "debtor_id" field's value of the Debt entity.
+// If the Debt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DebtMutation) OldDebtorID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDebtorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDebtorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDebtorID: %w", err)
+	}
+	return oldValue.DebtorID, nil
+}
+
+// ResetDebtorID resets all changes to the "debtor_id" field.
+func (m *DebtMutation) ResetDebtorID() {
+	m.debtor = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *DebtMutation) SetAmount(i int64) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *DebtMutation) Amount() (r int64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Debt entity.
+// If the Debt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DebtMutation) OldAmount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *DebtMutation) AddAmount(i int64) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *DebtMutation) AddedAmount() (r int64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *DebtMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *DebtMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *DebtMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Debt entity.
+// If the Debt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DebtMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *DebtMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DebtMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DebtMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Debt entity.
+// If the Debt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DebtMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DebtMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (m *DebtMutation) SetSettledAt(t time.Time) {
+	m.settled_at = &t
+}
+
+// SettledAt returns the value of the "settled_at" field in the mutation.
+func (m *DebtMutation) SettledAt() (r time.Time, exists bool) {
+	v := m.settled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettledAt returns the old "settled_at" field's value of the Debt entity.
+// If the Debt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DebtMutation) OldSettledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettledAt: %w", err)
+	}
+	return oldValue.SettledAt, nil
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (m *DebtMutation) ClearSettledAt() {
+	m.settled_at = nil
+	m.clearedFields[debt.FieldSettledAt] = struct{}{}
+}
+
+// SettledAtCleared returns if the "settled_at" field was cleared in this mutation.
+func (m *DebtMutation) SettledAtCleared() bool {
+	_, ok := m.clearedFields[debt.FieldSettledAt]
+	return ok
+}
+
+// ResetSettledAt resets all changes to the "settled_at" field.
+func (m *DebtMutation) ResetSettledAt() {
+	m.settled_at = nil
+	delete(m.clearedFields, debt.FieldSettledAt)
+}
+
+// ClearCreditor clears the "creditor" edge to the User entity.
+func (m *DebtMutation) ClearCreditor() {
+	m.clearedcreditor = true
+}
+
+// CreditorCleared reports if the "creditor" edge to the User entity was cleared.
+func (m *DebtMutation) CreditorCleared() bool {
+	return m.clearedcreditor
+}
+
+// CreditorIDs returns the "creditor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreditorID instead. It exists only for internal usage by the builders.
+func (m *DebtMutation) CreditorIDs() (ids []int64) {
+	if id := m.creditor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreditor resets all changes to the "creditor" edge.
+func (m *DebtMutation) ResetCreditor() {
+	m.creditor = nil
+	m.clearedcreditor = false
+}
+
+// ClearDebtor clears the "debtor" edge to the User entity.
+func (m *DebtMutation) ClearDebtor() {
+	m.cleareddebtor = true
+}
+
+// DebtorCleared reports if the "debtor" edge to the User entity was cleared.
+func (m *DebtMutation) DebtorCleared() bool {
+	return m.cleareddebtor
+}
+
+// DebtorIDs returns the "debtor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DebtorID instead. It exists only for internal usage by the builders.
+func (m *DebtMutation) DebtorIDs() (ids []int64) {
+	if id := m.debtor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDebtor resets all changes to the "debtor" edge.
+func (m *DebtMutation) ResetDebtor() {
+	m.debtor = nil
+	m.cleareddebtor = false
+}
+
+// Where appends a list predicates to the DebtMutation builder.
+func (m *DebtMutation) Where(ps ...predicate.Debt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *DebtMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Debt).
+func (m *DebtMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DebtMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.creditor != nil {
+		fields = append(fields, debt.FieldCreditorID)
+	}
+	if m.debtor != nil {
+		fields = append(fields, debt.FieldDebtorID)
+	}
+	if m.amount != nil {
+		fields = append(fields, debt.FieldAmount)
+	}
+	if m.category != nil {
+		fields = append(fields, debt.FieldCategory)
+	}
+	if m.created_at != nil {
+		fields = append(fields, debt.FieldCreatedAt)
+	}
+	if m.settled_at != nil {
+		fields = append(fields, debt.FieldSettledAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DebtMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case debt.FieldCreditorID:
+		return m.CreditorID()
+	case debt.FieldDebtorID:
+		return m.DebtorID()
+	case debt.FieldAmount:
+		return m.Amount()
+	case debt.FieldCategory:
+		return m.Category()
+	case debt.FieldCreatedAt:
+		return m.CreatedAt()
+	case debt.FieldSettledAt:
+		return m.SettledAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DebtMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case debt.FieldCreditorID:
+		return m.OldCreditorID(ctx)
+	case debt.FieldDebtorID:
+		return m.OldDebtorID(ctx)
+	case debt.FieldAmount:
+		return m.OldAmount(ctx)
+	case debt.FieldCategory:
+		return m.OldCategory(ctx)
+	case debt.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case debt.FieldSettledAt:
+		return m.OldSettledAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Debt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DebtMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case debt.FieldCreditorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditorID(v)
+		return nil
+	case debt.FieldDebtorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDebtorID(v)
+		return nil
+	case debt.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case debt.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case debt.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case debt.FieldSettledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettledAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Debt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DebtMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, debt.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DebtMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case debt.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DebtMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case debt.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Debt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DebtMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(debt.FieldSettledAt) {
+		fields = append(fields, debt.FieldSettledAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DebtMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DebtMutation) ClearField(name string) error {
+	switch name {
+	case debt.FieldSettledAt:
+		m.ClearSettledAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Debt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DebtMutation) ResetField(name string) error {
+	switch name {
+	case debt.FieldCreditorID:
+		m.ResetCreditorID()
+		return nil
+	case debt.FieldDebtorID:
+		m.ResetDebtorID()
+		return nil
+	case debt.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case debt.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case debt.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case debt.FieldSettledAt:
+		m.ResetSettledAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Debt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DebtMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.creditor != nil {
+		edges = append(edges, debt.EdgeCreditor)
+	}
+	if m.debtor != nil {
+		edges = append(edges, debt.EdgeDebtor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DebtMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case debt.EdgeCreditor:
+		if id := m.creditor; id != nil {
+			return []ent.Value{*id}
+		}
+	case debt.EdgeDebtor:
+		if id := m.debtor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DebtMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DebtMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DebtMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcreditor {
+		edges = append(edges, debt.EdgeCreditor)
+	}
+	if m.cleareddebtor {
+		edges = append(edges, debt.EdgeDebtor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DebtMutation) EdgeCleared(name string) bool {
+	switch name {
+	case debt.EdgeCreditor:
+		return m.clearedcreditor
+	case debt.EdgeDebtor:
+		return m.cleareddebtor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DebtMutation) ClearEdge(name string) error {
+	switch name {
+	case debt.EdgeCreditor:
+		m.ClearCreditor()
+		return nil
+	case debt.EdgeDebtor:
+		m.ClearDebtor()
+		return nil
+	}
+	return fmt.Errorf("unknown Debt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DebtMutation) ResetEdge(name string) error {
+	switch name {
+	case debt.EdgeCreditor:
+		m.ResetCreditor()
+		return nil
+	case debt.EdgeDebtor:
+		m.ResetDebtor()
+		return nil
+	}
+	return fmt.Errorf("unknown Debt edge %s", name)
+}
+
+// ExchangeRateMutation represents an operation that mutates the ExchangeRate nodes in the graph.
+type ExchangeRateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	base          *string
+	rate          *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExchangeRate, error)
+	predicates    []predicate.ExchangeRate
+}
+
+var _ ent.Mutation = (*ExchangeRateMutation)(nil)
+
+// exchangerateOption allows management of the mutation configuration using functional options.
+type exchangerateOption func(*ExchangeRateMutation)
+
+// newExchangeRateMutation creates new mutation for the ExchangeRate entity.
+func newExchangeRateMutation(c config, op Op, opts ...exchangerateOption) *ExchangeRateMutation {
+	m := &ExchangeRateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExchangeRate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExchangeRateID sets the ID field of the mutation.
+func withExchangeRateID(id string) exchangerateOption {
+	return func(m *ExchangeRateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExchangeRate
+		)
+		m.oldValue = func(ctx context.Context) (*ExchangeRate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExchangeRate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExchangeRate sets the old ExchangeRate of the mutation.
+func withExchangeRate(node *ExchangeRate) exchangerateOption {
+	return func(m *ExchangeRateMutation) {
+		m.oldValue = func(context.Context) (*ExchangeRate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExchangeRateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExchangeRateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExchangeRate entities.
+func (m *ExchangeRateMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExchangeRateMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExchangeRateMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExchangeRate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBase sets the "base" field.
+func (m *ExchangeRateMutation) SetBase(s string) {
+	m.base = &s
+}
+
+// Base returns the value of the "base" field in the mutation.
+func (m *ExchangeRateMutation) Base() (r string, exists bool) {
+	v := m.base
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBase returns the old "base" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldBase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBase: %w", err)
+	}
+	return oldValue.Base, nil
+}
+
+// ResetBase resets all changes to the "base" field.
+func (m *ExchangeRateMutation) ResetBase() {
+	m.base = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *ExchangeRateMutation) SetRate(s string) {
+	m.rate = &s
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *ExchangeRateMutation) Rate() (r string, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldRate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *ExchangeRateMutation) ResetRate() {
+	m.rate = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExchangeRateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExchangeRateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExchangeRateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ExchangeRateMutation builder.
+func (m *ExchangeRateMutation) Where(ps ...predicate.ExchangeRate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ExchangeRateMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ExchangeRate).
+func (m *ExchangeRateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExchangeRateMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.base != nil {
+		fields = append(fields, exchangerate.FieldBase)
+	}
+	if m.rate != nil {
+		fields = append(fields, exchangerate.FieldRate)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, exchangerate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExchangeRateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exchangerate.FieldBase:
+		return m.Base()
+	case exchangerate.FieldRate:
+		return m.Rate()
+	case exchangerate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExchangeRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exchangerate.FieldBase:
+		return m.OldBase(ctx)
+	case exchangerate.FieldRate:
+		return m.OldRate(ctx)
+	case exchangerate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExchangeRate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExchangeRateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exchangerate.FieldBase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBase(v)
+		return nil
+	case exchangerate.FieldRate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case exchangerate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeRate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExchangeRateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExchangeRateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExchangeRateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExchangeRate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExchangeRateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExchangeRateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExchangeRateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExchangeRate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExchangeRateMutation) ResetField(name string) error {
+	switch name {
+	case exchangerate.FieldBase:
+		m.ResetBase()
+		return nil
+	case exchangerate.FieldRate:
+		m.ResetRate()
+		return nil
+	case exchangerate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeRate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExchangeRateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExchangeRateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExchangeRateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExchangeRateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExchangeRateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExchangeRateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExchangeRateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExchangeRate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExchangeRateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExchangeRate edge %s", name)
+}
+
+// GoalMutation represents an operation that mutates the Goal nodes in the graph.
+type GoalMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	target        *int64
+	addtarget     *int64
+	saved         *int64
+	addsaved      *int64
+	deadline      *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int64
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Goal, error)
+	predicates    []predicate.Goal
+}
+
+var _ ent.Mutation = (*GoalMutation)(nil)
+
+// goalOption allows management of the mutation configuration using functional options.
+type goalOption func(*GoalMutation)
+
+// newGoalMutation creates new mutation for the Goal entity.
+func newGoalMutation(c config, op Op, opts ...goalOption) *GoalMutation {
+	m := &GoalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoalID sets the ID field of the mutation.
+func withGoalID(id int) goalOption {
+	return func(m *GoalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Goal
+		)
+		m.oldValue = func(ctx context.Context) (*Goal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Goal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoal sets the old Goal of the mutation.
+func withGoal(node *Goal) goalOption {
+	return func(m *GoalMutation) {
+		m.oldValue = func(context.Context) (*Goal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoalMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoalMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Goal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *GoalMutation) SetUserID(i int64) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GoalMutation) UserID() (r int64, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GoalMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetName sets the "name" field.
+func (m *GoalMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GoalMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GoalMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTarget sets the "target" field.
+func (m *GoalMutation) SetTarget(i int64) {
+	m.target = &i
+	m.addtarget = nil
+}
+
+// Target returns the value of the "target" field in the mutation.
+func (m *GoalMutation) Target() (r int64, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarget returns the old "target" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldTarget(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
+}
+
+// AddTarget adds i to the "target" field.
+func (m *GoalMutation) AddTarget(i int64) {
+	if m.addtarget != nil {
+		*m.addtarget += i
+	} else {
+		m.addtarget = &i
+	}
+}
+
+// AddedTarget returns the value that was added to the "target" field in this mutation.
+func (m *GoalMutation) AddedTarget() (r int64, exists bool) {
+	v := m.addtarget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTarget resets all changes to the "target" field.
+func (m *GoalMutation) ResetTarget() {
+	m.target = nil
+	m.addtarget = nil
+}
+
+// SetSaved sets the "saved" field.
+func (m *GoalMutation) SetSaved(i int64) {
+	m.saved = &i
+	m.addsaved = nil
+}
+
+// Saved returns the value of the "saved" field in the mutation.
+func (m *GoalMutation) Saved() (r int64, exists bool) {
+	v := m.saved
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSaved returns the old "saved" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldSaved(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSaved is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSaved requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSaved: %w", err)
+	}
+	return oldValue.Saved, nil
+}
+
+// AddSaved adds i to the "saved" field.
+func (m *GoalMutation) AddSaved(i int64) {
+	if m.addsaved != nil {
+		*m.addsaved += i
+	} else {
+		m.addsaved = &i
+	}
+}
+
+// AddedSaved returns the value that was added to the "saved" field in this mutation.
+func (m *GoalMutation) AddedSaved() (r int64, exists bool) {
+	v := m.addsaved
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSaved resets all changes to the "saved" field.
+func (m *GoalMutation) ResetSaved() {
+	m.saved = nil
+	m.addsaved = nil
+}
+
+// SetDeadline sets the "deadline" field.
+func (m *GoalMutation) SetDeadline(t time.Time) {
+	m.deadline = &t
+}
+
+// Deadline returns the value of the "deadline" field in the mutation.
+func (m *GoalMutation) Deadline() (r time.Time, exists bool) {
+	v := m.deadline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadline returns the old "deadline" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldDeadline(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadline: %w", err)
+	}
+	return oldValue.Deadline, nil
+}
+
+// ResetDeadline resets all changes to the "deadline" field.
+func (m *GoalMutation) ResetDeadline() {
+	m.deadline = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GoalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GoalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GoalMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GoalMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GoalMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GoalMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GoalMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the GoalMutation builder.
+func (m *GoalMutation) Where(ps ...predicate.Goal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *GoalMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Goal).
+func (m *GoalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoalMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user != nil {
+		fields = append(fields, goal.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, goal.FieldName)
+	}
+	if m.target != nil {
+		fields = append(fields, goal.FieldTarget)
+	}
+	if m.saved != nil {
+		fields = append(fields, goal.FieldSaved)
+	}
+	if m.deadline != nil {
+		fields = append(fields, goal.FieldDeadline)
+	}
+	if m.created_at != nil {
+		fields = append(fields, goal.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldUserID:
+		return m.UserID()
+	case goal.FieldName:
+		return m.Name()
+	case goal.FieldTarget:
+		return m.Target()
+	case goal.FieldSaved:
+		return m.Saved()
+	case goal.FieldDeadline:
+		return m.Deadline()
+	case goal.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goal.FieldUserID:
+		return m.OldUserID(ctx)
+	case goal.FieldName:
+		return m.OldName(ctx)
+	case goal.FieldTarget:
+		return m.OldTarget(ctx)
+	case goal.FieldSaved:
+		return m.OldSaved(ctx)
+	case goal.FieldDeadline:
+		return m.OldDeadline(ctx)
+	case goal.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Goal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case goal.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case goal.FieldTarget:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarget(v)
+		return nil
+	case goal.FieldSaved:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSaved(v)
+		return nil
+	case goal.FieldDeadline:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadline(v)
+		return nil
+	case goal.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoalMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget != nil {
+		fields = append(fields, goal.FieldTarget)
+	}
+	if m.addsaved != nil {
+		fields = append(fields, goal.FieldSaved)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldTarget:
+		return m.AddedTarget()
+	case goal.FieldSaved:
+		return m.AddedSaved()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldTarget:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTarget(v)
+		return nil
+	case goal.FieldSaved:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSaved(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoalMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoalMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Goal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoalMutation) ResetField(name string) error {
+	switch name {
+	case goal.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case goal.FieldName:
+		m.ResetName()
+		return nil
+	case goal.FieldTarget:
+		m.ResetTarget()
+		return nil
+	case goal.FieldSaved:
+		m.ResetSaved()
+		return nil
+	case goal.FieldDeadline:
+		m.ResetDeadline()
+		return nil
+	case goal.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, goal.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case goal.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, goal.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case goal.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoalMutation) ClearEdge(name string) error {
+	switch name {
+	case goal.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoalMutation) ResetEdge(name string) error {
+	switch name {
+	case goal.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	clearedFields map[string]struct{}
+	wastes        map[uuid.UUID]struct{}
+	removedwastes map[uuid.UUID]struct{}
+	clearedwastes bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// AddWasteIDs adds the "wastes" edge to the Waste entity by ids.
+func (m *TagMutation) AddWasteIDs(ids ...uuid.UUID) {
+	if m.wastes == nil {
+		m.wastes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.wastes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWastes clears the "wastes" edge to the Waste entity.
+func (m *TagMutation) ClearWastes() {
+	m.clearedwastes = true
+}
+
+// WastesCleared reports if the "wastes" edge to the Waste entity was cleared.
+func (m *TagMutation) WastesCleared() bool {
+	return m.clearedwastes
+}
+
+// RemoveWasteIDs removes the "wastes" edge to the Waste entity by IDs.
+func (m *TagMutation) RemoveWasteIDs(ids ...uuid.UUID) {
+	if m.removedwastes == nil {
+		m.removedwastes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.wastes, ids[i])
+		m.removedwastes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWastes returns the removed IDs of the "wastes" edge to the Waste entity.
+func (m *TagMutation) RemovedWastesIDs() (ids []uuid.UUID) {
+	for id := range m.removedwastes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WastesIDs returns the "wastes" edge IDs in the mutation.
+func (m *TagMutation) WastesIDs() (ids []uuid.UUID) {
+	for id := range m.wastes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWastes resets all changes to the "wastes" edge.
+func (m *TagMutation) ResetWastes() {
+	m.wastes = nil
+	m.clearedwastes = false
+	m.removedwastes = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.wastes != nil {
+		edges = append(edges, tag.EdgeWastes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeWastes:
+		ids := make([]ent.Value, 0, len(m.wastes))
+		for id := range m.wastes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedwastes != nil {
+		edges = append(edges, tag.EdgeWastes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeWastes:
+		ids := make([]ent.Value, 0, len(m.removedwastes))
+		for id := range m.removedwastes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwastes {
+		edges = append(edges, tag.EdgeWastes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeWastes:
+		return m.clearedwastes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeWastes:
+		m.ResetWastes()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int64
-	first_name     *string
-	last_name      *string
-	user_name      *string
-	waste_limit    *uint64
-	addwaste_limit *int64
-	clearedFields  map[string]struct{}
-	wastes         map[uuid.UUID]struct{}
-	removedwastes  map[uuid.UUID]struct{}
-	clearedwastes  bool
-	done           bool
-	oldValue       func(context.Context) (*User, error)
-	predicates     []predicate.User
+	op               Op
+	typ              string
+	id               *int64
+	first_name       *string
+	last_name        *string
+	user_name        *string
+	waste_limit      *uint64
+	addwaste_limit   *int64
+	api_token_hash   *string
+	language         *string
+	currencies       *[]string
+	income           *int64
+	addincome        *int64
+	goal_nudge_month *string
+	clearedFields    map[string]struct{}
+	wastes           map[uuid.UUID]struct{}
+	removedwastes    map[uuid.UUID]struct{}
+	clearedwastes    bool
+	credits          map[uuid.UUID]struct{}
+	removedcredits   map[uuid.UUID]struct{}
+	clearedcredits   bool
+	debts            map[uuid.UUID]struct{}
+	removeddebts     map[uuid.UUID]struct{}
+	cleareddebts     bool
+	goals            map[int]struct{}
+	removedgoals     map[int]struct{}
+	clearedgoals     bool
+	done             bool
+	oldValue         func(context.Context) (*User, error)
+	predicates       []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -332,6 +2631,272 @@ func (m *UserMutation) ResetWasteLimit() {
 	delete(m.clearedFields, user.FieldWasteLimit)
 }
 
+// SetAPITokenHash sets the "api_token_hash" field.
+func (m *UserMutation) SetAPITokenHash(s string) {
+	m.api_token_hash = &s
+}
+
+// APITokenHash returns the value of the "api_token_hash" field in the mutation.
+func (m *UserMutation) APITokenHash() (r string, exists bool) {
+	v := m.api_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPITokenHash returns the old "api_token_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAPITokenHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPITokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPITokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPITokenHash: %w", err)
+	}
+	return oldValue.APITokenHash, nil
+}
+
+// ClearAPITokenHash clears the value of the "api_token_hash" field.
+func (m *UserMutation) ClearAPITokenHash() {
+	m.api_token_hash = nil
+	m.clearedFields[user.FieldAPITokenHash] = struct{}{}
+}
+
+// APITokenHashCleared returns if the "api_token_hash" field was cleared in this mutation.
+func (m *UserMutation) APITokenHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldAPITokenHash]
+	return ok
+}
+
+// ResetAPITokenHash resets all changes to the "api_token_hash" field.
+func (m *UserMutation) ResetAPITokenHash() {
+	m.api_token_hash = nil
+	delete(m.clearedFields, user.FieldAPITokenHash)
+}
+
+// SetLanguage sets the "language" field.
+func (m *UserMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *UserMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLanguage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (m *UserMutation) ClearLanguage() {
+	m.language = nil
+	m.clearedFields[user.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *UserMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[user.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *UserMutation) ResetLanguage() {
+	m.language = nil
+	delete(m.clearedFields, user.FieldLanguage)
+}
+
+// SetCurrencies sets the "currencies" field.
+func (m *UserMutation) SetCurrencies(s []string) {
+	m.currencies = &s
+}
+
+// Currencies returns the value of the "currencies" field in the mutation.
+func (m *UserMutation) Currencies() (r []string, exists bool) {
+	v := m.currencies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrencies returns the old "currencies" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCurrencies(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrencies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrencies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrencies: %w", err)
+	}
+	return oldValue.Currencies, nil
+}
+
+// ClearCurrencies clears the value of the "currencies" field.
+func (m *UserMutation) ClearCurrencies() {
+	m.currencies = nil
+	m.clearedFields[user.FieldCurrencies] = struct{}{}
+}
+
+// CurrenciesCleared returns if the "currencies" field was cleared in this mutation.
+func (m *UserMutation) CurrenciesCleared() bool {
+	_, ok := m.clearedFields[user.FieldCurrencies]
+	return ok
+}
+
+// ResetCurrencies resets all changes to the "currencies" field.
+func (m *UserMutation) ResetCurrencies() {
+	m.currencies = nil
+	delete(m.clearedFields, user.FieldCurrencies)
+}
+
+// SetIncome sets the "income" field.
+func (m *UserMutation) SetIncome(i int64) {
+	m.income = &i
+	m.addincome = nil
+}
+
+// Income returns the value of the "income" field in the mutation.
+func (m *UserMutation) Income() (r int64, exists bool) {
+	v := m.income
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncome returns the old "income" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIncome(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncome is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncome requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncome: %w", err)
+	}
+	return oldValue.Income, nil
+}
+
+// AddIncome adds i to the "income" field.
+func (m *UserMutation) AddIncome(i int64) {
+	if m.addincome != nil {
+		*m.addincome += i
+	} else {
+		m.addincome = &i
+	}
+}
+
+// AddedIncome returns the value that was added to the "income" field in this mutation.
+func (m *UserMutation) AddedIncome() (r int64, exists bool) {
+	v := m.addincome
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIncome clears the value of the "income" field.
+func (m *UserMutation) ClearIncome() {
+	m.income = nil
+	m.addincome = nil
+	m.clearedFields[user.FieldIncome] = struct{}{}
+}
+
+// IncomeCleared returns if the "income" field was cleared in this mutation.
+func (m *UserMutation) IncomeCleared() bool {
+	_, ok := m.clearedFields[user.FieldIncome]
+	return ok
+}
+
+// ResetIncome resets all changes to the "income" field.
+func (m *UserMutation) ResetIncome() {
+	m.income = nil
+	m.addincome = nil
+	delete(m.clearedFields, user.FieldIncome)
+}
+
+// SetGoalNudgeMonth sets the "goal_nudge_month" field.
+func (m *UserMutation) SetGoalNudgeMonth(s string) {
+	m.goal_nudge_month = &s
+}
+
+// GoalNudgeMonth returns the value of the "goal_nudge_month" field in the mutation.
+func (m *UserMutation) GoalNudgeMonth() (r string, exists bool) {
+	v := m.goal_nudge_month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGoalNudgeMonth returns the old "goal_nudge_month" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldGoalNudgeMonth(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGoalNudgeMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGoalNudgeMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGoalNudgeMonth: %w", err)
+	}
+	return oldValue.GoalNudgeMonth, nil
+}
+
+// ClearGoalNudgeMonth clears the value of the "goal_nudge_month" field.
+func (m *UserMutation) ClearGoalNudgeMonth() {
+	m.goal_nudge_month = nil
+	m.clearedFields[user.FieldGoalNudgeMonth] = struct{}{}
+}
+
+// GoalNudgeMonthCleared returns if the "goal_nudge_month" field was cleared in this mutation.
+func (m *UserMutation) GoalNudgeMonthCleared() bool {
+	_, ok := m.clearedFields[user.FieldGoalNudgeMonth]
+	return ok
+}
+
+// ResetGoalNudgeMonth resets all changes to the "goal_nudge_month" field.
+func (m *UserMutation) ResetGoalNudgeMonth() {
+	m.goal_nudge_month = nil
+	delete(m.clearedFields, user.FieldGoalNudgeMonth)
+}
+
 // AddWasteIDs adds the "wastes" edge to the Waste entity by ids.
 func (m *UserMutation) AddWasteIDs(ids ...uuid.UUID) {
 	if m.wastes == nil {
@@ -386,6 +2951,168 @@ func (m *UserMutation) ResetWastes() {
 	m.removedwastes = nil
 }
 
+// AddCreditIDs adds the "credits" edge to the Debt entity by ids.
+func (m *UserMutation) AddCreditIDs(ids ...uuid.UUID) {
+	if m.credits == nil {
+		m.credits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.credits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCredits clears the "credits" edge to the Debt entity.
+func (m *UserMutation) ClearCredits() {
+	m.clearedcredits = true
+}
+
+// CreditsCleared reports if the "credits" edge to the Debt entity was cleared.
+func (m *UserMutation) CreditsCleared() bool {
+	return m.clearedcredits
+}
+
+// RemoveCreditIDs removes the "credits" edge to the Debt entity by IDs.
+func (m *UserMutation) RemoveCreditIDs(ids ...uuid.UUID) {
+	if m.removedcredits == nil {
+		m.removedcredits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.credits, ids[i])
+		m.removedcredits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCredits returns the removed IDs of the "credits" edge to the Debt entity.
+func (m *UserMutation) RemovedCreditsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcredits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreditsIDs returns the "credits" edge IDs in the mutation.
+func (m *UserMutation) CreditsIDs() (ids []uuid.UUID) {
+	for id := range m.credits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCredits resets all changes to the "credits" edge.
+func (m *UserMutation) ResetCredits() {
+	m.credits = nil
+	m.clearedcredits = false
+	m.removedcredits = nil
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by ids.
+func (m *UserMutation) AddDebtIDs(ids ...uuid.UUID) {
+	if m.debts == nil {
+		m.debts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.debts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDebts clears the "debts" edge to the Debt entity.
+func (m *UserMutation) ClearDebts() {
+	m.cleareddebts = true
+}
+
+// DebtsCleared reports if the "debts" edge to the Debt entity was cleared.
+func (m *UserMutation) DebtsCleared() bool {
+	return m.cleareddebts
+}
+
+// RemoveDebtIDs removes the "debts" edge to the Debt entity by IDs.
+func (m *UserMutation) RemoveDebtIDs(ids ...uuid.UUID) {
+	if m.removeddebts == nil {
+		m.removeddebts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.debts, ids[i])
+		m.removeddebts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDebts returns the removed IDs of the "debts" edge to the Debt entity.
+func (m *UserMutation) RemovedDebtsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddebts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DebtsIDs returns the "debts" edge IDs in the mutation.
+func (m *UserMutation) DebtsIDs() (ids []uuid.UUID) {
+	for id := range m.debts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDebts resets all changes to the "debts" edge.
+func (m *UserMutation) ResetDebts() {
+	m.debts = nil
+	m.cleareddebts = false
+	m.removeddebts = nil
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by ids.
+func (m *UserMutation) AddGoalIDs(ids ...int) {
+	if m.goals == nil {
+		m.goals = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.goals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGoals clears the "goals" edge to the Goal entity.
+func (m *UserMutation) ClearGoals() {
+	m.clearedgoals = true
+}
+
+// GoalsCleared reports if the "goals" edge to the Goal entity was cleared.
+func (m *UserMutation) GoalsCleared() bool {
+	return m.clearedgoals
+}
+
+// RemoveGoalIDs removes the "goals" edge to the Goal entity by IDs.
+func (m *UserMutation) RemoveGoalIDs(ids ...int) {
+	if m.removedgoals == nil {
+		m.removedgoals = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.goals, ids[i])
+		m.removedgoals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGoals returns the removed IDs of the "goals" edge to the Goal entity.
+func (m *UserMutation) RemovedGoalsIDs() (ids []int) {
+	for id := range m.removedgoals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GoalsIDs returns the "goals" edge IDs in the mutation.
+func (m *UserMutation) GoalsIDs() (ids []int) {
+	for id := range m.goals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGoals resets all changes to the "goals" edge.
+func (m *UserMutation) ResetGoals() {
+	m.goals = nil
+	m.clearedgoals = false
+	m.removedgoals = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -405,7 +3132,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 9)
 	if m.first_name != nil {
 		fields = append(fields, user.FieldFirstName)
 	}
@@ -418,6 +3145,21 @@ func (m *UserMutation) Fields() []string {
 	if m.waste_limit != nil {
 		fields = append(fields, user.FieldWasteLimit)
 	}
+	if m.api_token_hash != nil {
+		fields = append(fields, user.FieldAPITokenHash)
+	}
+	if m.language != nil {
+		fields = append(fields, user.FieldLanguage)
+	}
+	if m.currencies != nil {
+		fields = append(fields, user.FieldCurrencies)
+	}
+	if m.income != nil {
+		fields = append(fields, user.FieldIncome)
+	}
+	if m.goal_nudge_month != nil {
+		fields = append(fields, user.FieldGoalNudgeMonth)
+	}
 	return fields
 }
 
@@ -434,6 +3176,16 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.UserName()
 	case user.FieldWasteLimit:
 		return m.WasteLimit()
+	case user.FieldAPITokenHash:
+		return m.APITokenHash()
+	case user.FieldLanguage:
+		return m.Language()
+	case user.FieldCurrencies:
+		return m.Currencies()
+	case user.FieldIncome:
+		return m.Income()
+	case user.FieldGoalNudgeMonth:
+		return m.GoalNudgeMonth()
 	}
 	return nil, false
 }
@@ -451,6 +3203,16 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldUserName(ctx)
 	case user.FieldWasteLimit:
 		return m.OldWasteLimit(ctx)
+	case user.FieldAPITokenHash:
+		return m.OldAPITokenHash(ctx)
+	case user.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case user.FieldCurrencies:
+		return m.OldCurrencies(ctx)
+	case user.FieldIncome:
+		return m.OldIncome(ctx)
+	case user.FieldGoalNudgeMonth:
+		return m.OldGoalNudgeMonth(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -488,6 +3250,41 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetWasteLimit(v)
 		return nil
+	case user.FieldAPITokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPITokenHash(v)
+		return nil
+	case user.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case user.FieldCurrencies:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrencies(v)
+		return nil
+	case user.FieldIncome:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncome(v)
+		return nil
+	case user.FieldGoalNudgeMonth:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGoalNudgeMonth(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -499,6 +3296,9 @@ func (m *UserMutation) AddedFields() []string {
 	if m.addwaste_limit != nil {
 		fields = append(fields, user.FieldWasteLimit)
 	}
+	if m.addincome != nil {
+		fields = append(fields, user.FieldIncome)
+	}
 	return fields
 }
 
@@ -509,6 +3309,8 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case user.FieldWasteLimit:
 		return m.AddedWasteLimit()
+	case user.FieldIncome:
+		return m.AddedIncome()
 	}
 	return nil, false
 }
@@ -525,6 +3327,13 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddWasteLimit(v)
 		return nil
+	case user.FieldIncome:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIncome(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -536,6 +3345,21 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldWasteLimit) {
 		fields = append(fields, user.FieldWasteLimit)
 	}
+	if m.FieldCleared(user.FieldAPITokenHash) {
+		fields = append(fields, user.FieldAPITokenHash)
+	}
+	if m.FieldCleared(user.FieldLanguage) {
+		fields = append(fields, user.FieldLanguage)
+	}
+	if m.FieldCleared(user.FieldCurrencies) {
+		fields = append(fields, user.FieldCurrencies)
+	}
+	if m.FieldCleared(user.FieldIncome) {
+		fields = append(fields, user.FieldIncome)
+	}
+	if m.FieldCleared(user.FieldGoalNudgeMonth) {
+		fields = append(fields, user.FieldGoalNudgeMonth)
+	}
 	return fields
 }
 
@@ -553,6 +3377,21 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldWasteLimit:
 		m.ClearWasteLimit()
 		return nil
+	case user.FieldAPITokenHash:
+		m.ClearAPITokenHash()
+		return nil
+	case user.FieldLanguage:
+		m.ClearLanguage()
+		return nil
+	case user.FieldCurrencies:
+		m.ClearCurrencies()
+		return nil
+	case user.FieldIncome:
+		m.ClearIncome()
+		return nil
+	case user.FieldGoalNudgeMonth:
+		m.ClearGoalNudgeMonth()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -573,16 +3412,40 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldWasteLimit:
 		m.ResetWasteLimit()
 		return nil
+	case user.FieldAPITokenHash:
+		m.ResetAPITokenHash()
+		return nil
+	case user.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case user.FieldCurrencies:
+		m.ResetCurrencies()
+		return nil
+	case user.FieldIncome:
+		m.ResetIncome()
+		return nil
+	case user.FieldGoalNudgeMonth:
+		m.ResetGoalNudgeMonth()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 4)
 	if m.wastes != nil {
 		edges = append(edges, user.EdgeWastes)
 	}
+	if m.credits != nil {
+		edges = append(edges, user.EdgeCredits)
+	}
+	if m.debts != nil {
+		edges = append(edges, user.EdgeDebts)
+	}
+	if m.goals != nil {
+		edges = append(edges, user.EdgeGoals)
+	}
 	return edges
 }
 
@@ -596,16 +3459,43 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeCredits:
+		ids := make([]ent.Value, 0, len(m.credits))
+		for id := range m.credits {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDebts:
+		ids := make([]ent.Value, 0, len(m.debts))
+		for id := range m.debts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGoals:
+		ids := make([]ent.Value, 0, len(m.goals))
+		for id := range m.goals {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 4)
 	if m.removedwastes != nil {
 		edges = append(edges, user.EdgeWastes)
 	}
+	if m.removedcredits != nil {
+		edges = append(edges, user.EdgeCredits)
+	}
+	if m.removeddebts != nil {
+		edges = append(edges, user.EdgeDebts)
+	}
+	if m.removedgoals != nil {
+		edges = append(edges, user.EdgeGoals)
+	}
 	return edges
 }
 
@@ -619,16 +3509,43 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeCredits:
+		ids := make([]ent.Value, 0, len(m.removedcredits))
+		for id := range m.removedcredits {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDebts:
+		ids := make([]ent.Value, 0, len(m.removeddebts))
+		for id := range m.removeddebts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGoals:
+		ids := make([]ent.Value, 0, len(m.removedgoals))
+		for id := range m.removedgoals {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 4)
 	if m.clearedwastes {
 		edges = append(edges, user.EdgeWastes)
 	}
+	if m.clearedcredits {
+		edges = append(edges, user.EdgeCredits)
+	}
+	if m.cleareddebts {
+		edges = append(edges, user.EdgeDebts)
+	}
+	if m.clearedgoals {
+		edges = append(edges, user.EdgeGoals)
+	}
 	return edges
 }
 
@@ -638,6 +3555,12 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
 	case user.EdgeWastes:
 		return m.clearedwastes
+	case user.EdgeCredits:
+		return m.clearedcredits
+	case user.EdgeDebts:
+		return m.cleareddebts
+	case user.EdgeGoals:
+		return m.clearedgoals
 	}
 	return false
 }
@@ -657,6 +3580,15 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeWastes:
 		m.ResetWastes()
 		return nil
+	case user.EdgeCredits:
+		m.ResetCredits()
+		return nil
+	case user.EdgeDebts:
+		m.ResetDebts()
+		return nil
+	case user.EdgeGoals:
+		m.ResetGoals()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -664,19 +3596,27 @@ func (m *UserMutation) ResetEdge(name string) error {
 // WasteMutation represents an operation that mutates the Waste nodes in the graph.
 type WasteMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	cost          *int64
-	addcost       *int64
-	category      *string
-	date          *time.Time
-	clearedFields map[string]struct{}
-	user          *int64
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Waste, error)
-	predicates    []predicate.Waste
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	cost             *int64
+	addcost          *int64
+	category         *string
+	date             *time.Time
+	currency         *string
+	original_cost    *int64
+	addoriginal_cost *int64
+	note             *string
+	claim_status     *enums.ClaimStatus
+	clearedFields    map[string]struct{}
+	user             *int64
+	cleareduser      bool
+	tags             map[int]struct{}
+	removedtags      map[int]struct{}
+	clearedtags      bool
+	done             bool
+	oldValue         func(context.Context) (*Waste, error)
+	predicates       []predicate.Waste
 }
 
 var _ ent.Mutation = (*WasteMutation)(nil)
@@ -911,6 +3851,223 @@ func (m *WasteMutation) ResetDate() {
 	m.date = nil
 }
 
+// SetCurrency sets the "currency" field.
+func (m *WasteMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *WasteMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Waste entity.
+// If the Waste object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WasteMutation) OldCurrency(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (m *WasteMutation) ClearCurrency() {
+	m.currency = nil
+	m.clearedFields[waste.FieldCurrency] = struct{}{}
+}
+
+// CurrencyCleared returns if the "currency" field was cleared in this mutation.
+func (m *WasteMutation) CurrencyCleared() bool {
+	_, ok := m.clearedFields[waste.FieldCurrency]
+	return ok
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *WasteMutation) ResetCurrency() {
+	m.currency = nil
+	delete(m.clearedFields, waste.FieldCurrency)
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (m *WasteMutation) SetOriginalCost(i int64) {
+	m.original_cost = &i
+	m.addoriginal_cost = nil
+}
+
+// OriginalCost returns the value of the "original_cost" field in the mutation.
+func (m *WasteMutation) OriginalCost() (r int64, exists bool) {
+	v := m.original_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalCost returns the old "original_cost" field's value of the Waste entity.
+// If the Waste object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WasteMutation) OldOriginalCost(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalCost: %w", err)
+	}
+	return oldValue.OriginalCost, nil
+}
+
+// AddOriginalCost adds i to the "original_cost" field.
+func (m *WasteMutation) AddOriginalCost(i int64) {
+	if m.addoriginal_cost != nil {
+		*m.addoriginal_cost += i
+	} else {
+		m.addoriginal_cost = &i
+	}
+}
+
+// AddedOriginalCost returns the value that was added to the "original_cost" field in this mutation.
+func (m *WasteMutation) AddedOriginalCost() (r int64, exists bool) {
+	v := m.addoriginal_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOriginalCost clears the value of the "original_cost" field.
+func (m *WasteMutation) ClearOriginalCost() {
+	m.original_cost = nil
+	m.addoriginal_cost = nil
+	m.clearedFields[waste.FieldOriginalCost] = struct{}{}
+}
+
+// OriginalCostCleared returns if the "original_cost" field was cleared in this mutation.
+func (m *WasteMutation) OriginalCostCleared() bool {
+	_, ok := m.clearedFields[waste.FieldOriginalCost]
+	return ok
+}
+
+// ResetOriginalCost resets all changes to the "original_cost" field.
+func (m *WasteMutation) ResetOriginalCost() {
+	m.original_cost = nil
+	m.addoriginal_cost = nil
+	delete(m.clearedFields, waste.FieldOriginalCost)
+}
+
+// SetNote sets the "note" field.
+func (m *WasteMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *WasteMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the Waste entity.
+// If the Waste object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WasteMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *WasteMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[waste.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *WasteMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[waste.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *WasteMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, waste.FieldNote)
+}
+
+// SetClaimStatus sets the "claim_status" field.
+func (m *WasteMutation) SetClaimStatus(es enums.ClaimStatus) {
+	m.claim_status = &es
+}
+
+// ClaimStatus returns the value of the "claim_status" field in the mutation.
+func (m *WasteMutation) ClaimStatus() (r enums.ClaimStatus, exists bool) {
+	v := m.claim_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimStatus returns the old "claim_status" field's value of the Waste entity.
+// If the Waste object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WasteMutation) OldClaimStatus(ctx context.Context) (v *enums.ClaimStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimStatus: %w", err)
+	}
+	return oldValue.ClaimStatus, nil
+}
+
+// ClearClaimStatus clears the value of the "claim_status" field.
+func (m *WasteMutation) ClearClaimStatus() {
+	m.claim_status = nil
+	m.clearedFields[waste.FieldClaimStatus] = struct{}{}
+}
+
+// ClaimStatusCleared returns if the "claim_status" field was cleared in this mutation.
+func (m *WasteMutation) ClaimStatusCleared() bool {
+	_, ok := m.clearedFields[waste.FieldClaimStatus]
+	return ok
+}
+
+// ResetClaimStatus resets all changes to the "claim_status" field.
+func (m *WasteMutation) ResetClaimStatus() {
+	m.claim_status = nil
+	delete(m.clearedFields, waste.FieldClaimStatus)
+}
+
 // SetUserID sets the "user" edge to the User entity by id.
 func (m *WasteMutation) SetUserID(id int64) {
 	m.user = &id
@@ -950,6 +4107,60 @@ func (m *WasteMutation) ResetUser() {
 	m.cleareduser = false
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *WasteMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *WasteMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *WasteMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *WasteMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *WasteMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *WasteMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *WasteMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
 // Where appends a list predicates to the WasteMutation builder.
 func (m *WasteMutation) Where(ps ...predicate.Waste) {
 	m.predicates = append(m.predicates, ps...)
@@ -969,7 +4180,7 @@ func (m *WasteMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *WasteMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 7)
 	if m.cost != nil {
 		fields = append(fields, waste.FieldCost)
 	}
@@ -979,6 +4190,18 @@ func (m *WasteMutation) Fields() []string {
 	if m.date != nil {
 		fields = append(fields, waste.FieldDate)
 	}
+	if m.currency != nil {
+		fields = append(fields, waste.FieldCurrency)
+	}
+	if m.original_cost != nil {
+		fields = append(fields, waste.FieldOriginalCost)
+	}
+	if m.note != nil {
+		fields = append(fields, waste.FieldNote)
+	}
+	if m.claim_status != nil {
+		fields = append(fields, waste.FieldClaimStatus)
+	}
 	return fields
 }
 
@@ -993,6 +4216,14 @@ func (m *WasteMutation) Field(name string) (ent.Value, bool) {
 		return m.Category()
 	case waste.FieldDate:
 		return m.Date()
+	case waste.FieldCurrency:
+		return m.Currency()
+	case waste.FieldOriginalCost:
+		return m.OriginalCost()
+	case waste.FieldNote:
+		return m.Note()
+	case waste.FieldClaimStatus:
+		return m.ClaimStatus()
 	}
 	return nil, false
 }
@@ -1008,6 +4239,14 @@ func (m *WasteMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldCategory(ctx)
 	case waste.FieldDate:
 		return m.OldDate(ctx)
+	case waste.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case waste.FieldOriginalCost:
+		return m.OldOriginalCost(ctx)
+	case waste.FieldNote:
+		return m.OldNote(ctx)
+	case waste.FieldClaimStatus:
+		return m.OldClaimStatus(ctx)
 	}
 	return nil, fmt.Errorf("unknown Waste field %s", name)
 }
@@ -1038,6 +4277,34 @@ func (m *WasteMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDate(v)
 		return nil
+	case waste.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case waste.FieldOriginalCost:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalCost(v)
+		return nil
+	case waste.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case waste.FieldClaimStatus:
+		v, ok := value.(enums.ClaimStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimStatus(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Waste field %s", name)
 }
@@ -1049,6 +4316,9 @@ func (m *WasteMutation) AddedFields() []string {
 	if m.addcost != nil {
 		fields = append(fields, waste.FieldCost)
 	}
+	if m.addoriginal_cost != nil {
+		fields = append(fields, waste.FieldOriginalCost)
+	}
 	return fields
 }
 
@@ -1059,6 +4329,8 @@ func (m *WasteMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case waste.FieldCost:
 		return m.AddedCost()
+	case waste.FieldOriginalCost:
+		return m.AddedOriginalCost()
 	}
 	return nil, false
 }
@@ -1075,6 +4347,13 @@ func (m *WasteMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddCost(v)
 		return nil
+	case waste.FieldOriginalCost:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOriginalCost(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Waste numeric field %s", name)
 }
@@ -1082,7 +4361,20 @@ func (m *WasteMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *WasteMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(waste.FieldCurrency) {
+		fields = append(fields, waste.FieldCurrency)
+	}
+	if m.FieldCleared(waste.FieldOriginalCost) {
+		fields = append(fields, waste.FieldOriginalCost)
+	}
+	if m.FieldCleared(waste.FieldNote) {
+		fields = append(fields, waste.FieldNote)
+	}
+	if m.FieldCleared(waste.FieldClaimStatus) {
+		fields = append(fields, waste.FieldClaimStatus)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -1095,6 +4387,20 @@ func (m *WasteMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *WasteMutation) ClearField(name string) error {
+	switch name {
+	case waste.FieldCurrency:
+		m.ClearCurrency()
+		return nil
+	case waste.FieldOriginalCost:
+		m.ClearOriginalCost()
+		return nil
+	case waste.FieldNote:
+		m.ClearNote()
+		return nil
+	case waste.FieldClaimStatus:
+		m.ClearClaimStatus()
+		return nil
+	}
 	return fmt.Errorf("unknown Waste nullable field %s", name)
 }
 
@@ -1111,16 +4417,31 @@ func (m *WasteMutation) ResetField(name string) error {
 	case waste.FieldDate:
 		m.ResetDate()
 		return nil
+	case waste.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case waste.FieldOriginalCost:
+		m.ResetOriginalCost()
+		return nil
+	case waste.FieldNote:
+		m.ResetNote()
+		return nil
+	case waste.FieldClaimStatus:
+		m.ResetClaimStatus()
+		return nil
 	}
 	return fmt.Errorf("unknown Waste field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *WasteMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.user != nil {
 		edges = append(edges, waste.EdgeUser)
 	}
+	if m.tags != nil {
+		edges = append(edges, waste.EdgeTags)
+	}
 	return edges
 }
 
@@ -1132,28 +4453,48 @@ func (m *WasteMutation) AddedIDs(name string) []ent.Value {
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case waste.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *WasteMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
+	if m.removedtags != nil {
+		edges = append(edges, waste.EdgeTags)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *WasteMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case waste.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *WasteMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.cleareduser {
 		edges = append(edges, waste.EdgeUser)
 	}
+	if m.clearedtags {
+		edges = append(edges, waste.EdgeTags)
+	}
 	return edges
 }
 
@@ -1163,6 +4504,8 @@ func (m *WasteMutation) EdgeCleared(name string) bool {
 	switch name {
 	case waste.EdgeUser:
 		return m.cleareduser
+	case waste.EdgeTags:
+		return m.clearedtags
 	}
 	return false
 }
@@ -1185,6 +4528,9 @@ func (m *WasteMutation) ResetEdge(name string) error {
 	case waste.EdgeUser:
 		m.ResetUser()
 		return nil
+	case waste.EdgeTags:
+		m.ResetTags()
+		return nil
 	}
 	return fmt.Errorf("unknown Waste edge %s", name)
 }
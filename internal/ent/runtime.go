@@ -3,8 +3,13 @@
 package ent
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/schema"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/tag"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
 )
 
@@ -12,6 +17,44 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	debtFields := schema.Debt{}.Fields()
+	_ = debtFields
+	// debtDescAmount is the schema descriptor for amount field.
+	debtDescAmount := debtFields[3].Descriptor()
+	// debt.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	debt.AmountValidator = debtDescAmount.Validators[0].(func(int64) error)
+	// debtDescCreatedAt is the schema descriptor for created_at field.
+	debtDescCreatedAt := debtFields[5].Descriptor()
+	// debt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	debt.DefaultCreatedAt = debtDescCreatedAt.Default.(func() time.Time)
+	// debtDescID is the schema descriptor for id field.
+	debtDescID := debtFields[0].Descriptor()
+	// debt.DefaultID holds the default value on creation for the id field.
+	debt.DefaultID = debtDescID.Default.(func() uuid.UUID)
+	goalFields := schema.Goal{}.Fields()
+	_ = goalFields
+	// goalDescName is the schema descriptor for name field.
+	goalDescName := goalFields[1].Descriptor()
+	// goal.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	goal.NameValidator = goalDescName.Validators[0].(func(string) error)
+	// goalDescTarget is the schema descriptor for target field.
+	goalDescTarget := goalFields[2].Descriptor()
+	// goal.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	goal.TargetValidator = goalDescTarget.Validators[0].(func(int64) error)
+	// goalDescSaved is the schema descriptor for saved field.
+	goalDescSaved := goalFields[3].Descriptor()
+	// goal.DefaultSaved holds the default value on creation for the saved field.
+	goal.DefaultSaved = goalDescSaved.Default.(int64)
+	// goalDescCreatedAt is the schema descriptor for created_at field.
+	goalDescCreatedAt := goalFields[5].Descriptor()
+	// goal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goal.DefaultCreatedAt = goalDescCreatedAt.Default.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
 	wasteFields := schema.Waste{}.Fields()
 	_ = wasteFields
 	// wasteDescID is the schema descriptor for id field.
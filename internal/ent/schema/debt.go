@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Debt holds the schema definition for the Debt entity.
+type Debt struct {
+	ent.Schema
+}
+
+// Fields of the Debt.
+func (Debt) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// creditor_id is the user who paid the waste, debtor_id is the user who owes the share
+		field.Int64("creditor_id"),
+		field.Int64("debtor_id"),
+		// amount is the share of the debtor in the default currency
+		field.Int64("amount").
+			Positive(),
+		field.String("category"),
+		field.Time("created_at").
+			Default(time.Now),
+		// settled_at is set when the creditor confirms the debt is paid
+		field.Time("settled_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Debt.
+func (Debt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("creditor", User.Type).
+			Ref("credits").
+			Field("creditor_id").
+			Unique().
+			Required(),
+		edge.From("debtor", User.Type).
+			Ref("debts").
+			Field("debtor_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Debt.
+func (Debt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("creditor_id", "debtor_id"),
+		index.Fields("debtor_id"),
+	}
+}
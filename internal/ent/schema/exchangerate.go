@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ExchangeRate holds the schema definition for the ExchangeRate entity,
+// the last received rate of the currency to the base currency.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+// Fields of the ExchangeRate.
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("currency"),
+		field.String("base"),
+		field.String("rate"),
+		field.Time("updated_at"),
+	}
+}
+
+// Edges of the ExchangeRate.
+func (ExchangeRate) Edges() []ent.Edge {
+	return nil
+}
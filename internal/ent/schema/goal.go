@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Goal holds the schema definition for the Goal entity.
+type Goal struct {
+	ent.Schema
+}
+
+// Fields of the Goal.
+func (Goal) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		field.String("name").
+			NotEmpty(),
+		// target and saved are in the default currency
+		field.Int64("target").
+			Positive(),
+		field.Int64("saved").
+			Default(0),
+		field.Time("deadline"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Goal.
+func (Goal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("goals").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Goal.
+func (Goal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").
+			Unique(),
+	}
+}
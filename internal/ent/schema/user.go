@@ -22,6 +22,24 @@ func (User) Fields() []ent.Field {
 		field.Uint64("waste_limit").
 			Optional().
 			Nillable(),
+		field.String("api_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive(),
+		field.String("language").
+			Optional().
+			Nillable(),
+		field.Strings("currencies").
+			Optional(),
+		// income is the monthly income in the default currency, it is used to plan savings goals
+		field.Int64("income").
+			Optional().
+			Nillable(),
+		// goal_nudge_month is the month of the last sent reminder about the savings goals, like "2024-03"
+		field.String("goal_nudge_month").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -29,6 +47,9 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("wastes", Waste.Type),
+		edge.To("credits", Debt.Type),
+		edge.To("debts", Debt.Type),
+		edge.To("goals", Goal.Type),
 	}
 }
 
@@ -6,6 +6,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 // Waste holds the schema definition for the Waste entity.
@@ -21,6 +23,21 @@ func (Waste) Fields() []ent.Field {
 		field.Int64("cost"),
 		field.String("category"),
 		field.Time("date"),
+		// currency and original_cost are the currency and the amount entered by the user,
+		// cost is always in the default currency
+		field.String("currency").
+			Optional().
+			Nillable(),
+		field.Int64("original_cost").
+			Optional().
+			Nillable(),
+		field.String("note").
+			Optional(),
+		// claim_status is set only for reimbursable wastes
+		field.String("claim_status").
+			GoType(enums.ClaimStatus("")).
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -30,6 +47,8 @@ func (Waste) Edges() []ent.Edge {
 		edge.From("user", User.Type).
 			Ref("wastes").
 			Unique(),
+		edge.From("tags", Tag.Type).
+			Ref("wastes"),
 	}
 }
 
@@ -0,0 +1,48 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tag
+
+const (
+	// Label holds the string label denoting the tag type in the database.
+	Label = "tag"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeWastes holds the string denoting the wastes edge name in mutations.
+	EdgeWastes = "wastes"
+	// Table holds the table name of the tag in the database.
+	Table = "tags"
+	// WastesTable is the table that holds the wastes relation/edge. The primary key declared below.
+	WastesTable = "tag_wastes"
+	// WastesInverseTable is the table name for the Waste entity.
+	// It exists in this package in order to avoid circular dependency with the "waste" package.
+	WastesInverseTable = "wastes"
+)
+
+// Columns holds all SQL columns for tag fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+}
+
+var (
+	// WastesPrimaryKey and WastesColumn2 are the table columns denoting the
+	// primary key for the wastes relation (M2M).
+	WastesPrimaryKey = []string{"tag_id", "waste_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -23,6 +24,16 @@ type User struct {
 	UserName string `json:"user_name,omitempty"`
 	// WasteLimit holds the value of the "waste_limit" field.
 	WasteLimit *uint64 `json:"waste_limit,omitempty"`
+	// APITokenHash holds the value of the "api_token_hash" field.
+	APITokenHash *string `json:"-"`
+	// Language holds the value of the "language" field.
+	Language *string `json:"language,omitempty"`
+	// Currencies holds the value of the "currencies" field.
+	Currencies []string `json:"currencies,omitempty"`
+	// Income holds the value of the "income" field.
+	Income *int64 `json:"income,omitempty"`
+	// GoalNudgeMonth holds the value of the "goal_nudge_month" field.
+	GoalNudgeMonth *string `json:"goal_nudge_month,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges UserEdges `json:"edges"`
@@ -32,9 +43,15 @@ type User struct {
 type UserEdges struct {
 	// Wastes holds the value of the wastes edge.
 	Wastes []*Waste `json:"wastes,omitempty"`
+	// Credits holds the value of the credits edge.
+	Credits []*Debt `json:"credits,omitempty"`
+	// Debts holds the value of the debts edge.
+	Debts []*Debt `json:"debts,omitempty"`
+	// Goals holds the value of the goals edge.
+	Goals []*Goal `json:"goals,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // WastesOrErr returns the Wastes value or an error if the edge
@@ -46,14 +63,43 @@ func (e UserEdges) WastesOrErr() ([]*Waste, error) {
 	return nil, &NotLoadedError{edge: "wastes"}
 }
 
+// CreditsOrErr returns the Credits value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CreditsOrErr() ([]*Debt, error) {
+	if e.loadedTypes[1] {
+		return e.Credits, nil
+	}
+	return nil, &NotLoadedError{edge: "credits"}
+}
+
+// DebtsOrErr returns the Debts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DebtsOrErr() ([]*Debt, error) {
+	if e.loadedTypes[2] {
+		return e.Debts, nil
+	}
+	return nil, &NotLoadedError{edge: "debts"}
+}
+
+// GoalsOrErr returns the Goals value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GoalsOrErr() ([]*Goal, error) {
+	if e.loadedTypes[3] {
+		return e.Goals, nil
+	}
+	return nil, &NotLoadedError{edge: "goals"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldWasteLimit:
+		case user.FieldCurrencies:
+			values[i] = new([]byte)
+		case user.FieldID, user.FieldWasteLimit, user.FieldIncome:
 			values[i] = new(sql.NullInt64)
-		case user.FieldFirstName, user.FieldLastName, user.FieldUserName:
+		case user.FieldFirstName, user.FieldLastName, user.FieldUserName, user.FieldAPITokenHash, user.FieldLanguage, user.FieldGoalNudgeMonth:
 			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type User", columns[i])
@@ -101,6 +147,42 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.WasteLimit = new(uint64)
 				*u.WasteLimit = uint64(value.Int64)
 			}
+		case user.FieldAPITokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_token_hash", values[i])
+			} else if value.Valid {
+				u.APITokenHash = new(string)
+				*u.APITokenHash = value.String
+			}
+		case user.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				u.Language = new(string)
+				*u.Language = value.String
+			}
+		case user.FieldCurrencies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field currencies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Currencies); err != nil {
+					return fmt.Errorf("unmarshal field currencies: %w", err)
+				}
+			}
+		case user.FieldIncome:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field income", values[i])
+			} else if value.Valid {
+				u.Income = new(int64)
+				*u.Income = value.Int64
+			}
+		case user.FieldGoalNudgeMonth:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field goal_nudge_month", values[i])
+			} else if value.Valid {
+				u.GoalNudgeMonth = new(string)
+				*u.GoalNudgeMonth = value.String
+			}
 		}
 	}
 	return nil
@@ -111,6 +193,21 @@ func (u *User) QueryWastes() *WasteQuery {
 	return (&UserClient{config: u.config}).QueryWastes(u)
 }
 
+// QueryCredits queries the "credits" edge of the User entity.
+func (u *User) QueryCredits() *DebtQuery {
+	return (&UserClient{config: u.config}).QueryCredits(u)
+}
+
+// QueryDebts queries the "debts" edge of the User entity.
+func (u *User) QueryDebts() *DebtQuery {
+	return (&UserClient{config: u.config}).QueryDebts(u)
+}
+
+// QueryGoals queries the "goals" edge of the User entity.
+func (u *User) QueryGoals() *GoalQuery {
+	return (&UserClient{config: u.config}).QueryGoals(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -147,6 +244,26 @@ func (u *User) String() string {
 		builder.WriteString("waste_limit=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("api_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.Language; v != nil {
+		builder.WriteString("language=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("currencies=")
+	builder.WriteString(fmt.Sprintf("%v", u.Currencies))
+	builder.WriteString(", ")
+	if v := u.Income; v != nil {
+		builder.WriteString("income=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := u.GoalNudgeMonth; v != nil {
+		builder.WriteString("goal_nudge_month=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
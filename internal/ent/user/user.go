@@ -15,8 +15,24 @@ const (
 	FieldUserName = "user_name"
 	// FieldWasteLimit holds the string denoting the waste_limit field in the database.
 	FieldWasteLimit = "waste_limit"
+	// FieldAPITokenHash holds the string denoting the api_token_hash field in the database.
+	FieldAPITokenHash = "api_token_hash"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldCurrencies holds the string denoting the currencies field in the database.
+	FieldCurrencies = "currencies"
+	// FieldIncome holds the string denoting the income field in the database.
+	FieldIncome = "income"
+	// FieldGoalNudgeMonth holds the string denoting the goal_nudge_month field in the database.
+	FieldGoalNudgeMonth = "goal_nudge_month"
 	// EdgeWastes holds the string denoting the wastes edge name in mutations.
 	EdgeWastes = "wastes"
+	// EdgeCredits holds the string denoting the credits edge name in mutations.
+	EdgeCredits = "credits"
+	// EdgeDebts holds the string denoting the debts edge name in mutations.
+	EdgeDebts = "debts"
+	// EdgeGoals holds the string denoting the goals edge name in mutations.
+	EdgeGoals = "goals"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// WastesTable is the table that holds the wastes relation/edge.
@@ -26,6 +42,27 @@ const (
 	WastesInverseTable = "wastes"
 	// WastesColumn is the table column denoting the wastes relation/edge.
 	WastesColumn = "user_wastes"
+	// CreditsTable is the table that holds the credits relation/edge.
+	CreditsTable = "debts"
+	// CreditsInverseTable is the table name for the Debt entity.
+	// It exists in this package in order to avoid circular dependency with the "debt" package.
+	CreditsInverseTable = "debts"
+	// CreditsColumn is the table column denoting the credits relation/edge.
+	CreditsColumn = "creditor_id"
+	// DebtsTable is the table that holds the debts relation/edge.
+	DebtsTable = "debts"
+	// DebtsInverseTable is the table name for the Debt entity.
+	// It exists in this package in order to avoid circular dependency with the "debt" package.
+	DebtsInverseTable = "debts"
+	// DebtsColumn is the table column denoting the debts relation/edge.
+	DebtsColumn = "debtor_id"
+	// GoalsTable is the table that holds the goals relation/edge.
+	GoalsTable = "goals"
+	// GoalsInverseTable is the table name for the Goal entity.
+	// It exists in this package in order to avoid circular dependency with the "goal" package.
+	GoalsInverseTable = "goals"
+	// GoalsColumn is the table column denoting the goals relation/edge.
+	GoalsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -35,6 +72,11 @@ var Columns = []string{
 	FieldLastName,
 	FieldUserName,
 	FieldWasteLimit,
+	FieldAPITokenHash,
+	FieldLanguage,
+	FieldCurrencies,
+	FieldIncome,
+	FieldGoalNudgeMonth,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -107,6 +107,34 @@ func WasteLimit(v uint64) predicate.User {
 	})
 }
 
+// APITokenHash applies equality check predicate on the "api_token_hash" field. It's identical to APITokenHashEQ.
+func APITokenHash(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLanguage), v))
+	})
+}
+
+// Income applies equality check predicate on the "income" field. It's identical to IncomeEQ.
+func Income(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIncome), v))
+	})
+}
+
+// GoalNudgeMonth applies equality check predicate on the "goal_nudge_month" field. It's identical to GoalNudgeMonthEQ.
+func GoalNudgeMonth(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
 // FirstNameEQ applies the EQ predicate on the "first_name" field.
 func FirstNameEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -482,6 +510,437 @@ func WasteLimitNotNil() predicate.User {
 	})
 }
 
+// APITokenHashEQ applies the EQ predicate on the "api_token_hash" field.
+func APITokenHashEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashNEQ applies the NEQ predicate on the "api_token_hash" field.
+func APITokenHashNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashIn applies the In predicate on the "api_token_hash" field.
+func APITokenHashIn(vs ...string) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAPITokenHash), v...))
+	})
+}
+
+// APITokenHashNotIn applies the NotIn predicate on the "api_token_hash" field.
+func APITokenHashNotIn(vs ...string) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAPITokenHash), v...))
+	})
+}
+
+// APITokenHashGT applies the GT predicate on the "api_token_hash" field.
+func APITokenHashGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashGTE applies the GTE predicate on the "api_token_hash" field.
+func APITokenHashGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashLT applies the LT predicate on the "api_token_hash" field.
+func APITokenHashLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashLTE applies the LTE predicate on the "api_token_hash" field.
+func APITokenHashLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashContains applies the Contains predicate on the "api_token_hash" field.
+func APITokenHashContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashHasPrefix applies the HasPrefix predicate on the "api_token_hash" field.
+func APITokenHashHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashHasSuffix applies the HasSuffix predicate on the "api_token_hash" field.
+func APITokenHashHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashIsNil applies the IsNil predicate on the "api_token_hash" field.
+func APITokenHashIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAPITokenHash)))
+	})
+}
+
+// APITokenHashNotNil applies the NotNil predicate on the "api_token_hash" field.
+func APITokenHashNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAPITokenHash)))
+	})
+}
+
+// APITokenHashEqualFold applies the EqualFold predicate on the "api_token_hash" field.
+func APITokenHashEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// APITokenHashContainsFold applies the ContainsFold predicate on the "api_token_hash" field.
+func APITokenHashContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAPITokenHash), v))
+	})
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLanguage), v...))
+	})
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLanguage), v...))
+	})
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLanguage)))
+	})
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLanguage)))
+	})
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLanguage), v))
+	})
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLanguage), v))
+	})
+}
+
+// CurrenciesIsNil applies the IsNil predicate on the "currencies" field.
+func CurrenciesIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCurrencies)))
+	})
+}
+
+// CurrenciesNotNil applies the NotNil predicate on the "currencies" field.
+func CurrenciesNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCurrencies)))
+	})
+}
+
+// IncomeEQ applies the EQ predicate on the "income" field.
+func IncomeEQ(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIncome), v))
+	})
+}
+
+// IncomeNEQ applies the NEQ predicate on the "income" field.
+func IncomeNEQ(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIncome), v))
+	})
+}
+
+// IncomeIn applies the In predicate on the "income" field.
+func IncomeIn(vs ...int64) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldIncome), v...))
+	})
+}
+
+// IncomeNotIn applies the NotIn predicate on the "income" field.
+func IncomeNotIn(vs ...int64) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldIncome), v...))
+	})
+}
+
+// IncomeGT applies the GT predicate on the "income" field.
+func IncomeGT(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIncome), v))
+	})
+}
+
+// IncomeGTE applies the GTE predicate on the "income" field.
+func IncomeGTE(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIncome), v))
+	})
+}
+
+// IncomeLT applies the LT predicate on the "income" field.
+func IncomeLT(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIncome), v))
+	})
+}
+
+// IncomeLTE applies the LTE predicate on the "income" field.
+func IncomeLTE(v int64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIncome), v))
+	})
+}
+
+// IncomeIsNil applies the IsNil predicate on the "income" field.
+func IncomeIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIncome)))
+	})
+}
+
+// IncomeNotNil applies the NotNil predicate on the "income" field.
+func IncomeNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIncome)))
+	})
+}
+
+// GoalNudgeMonthEQ applies the EQ predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthNEQ applies the NEQ predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthIn applies the In predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthIn(vs ...string) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldGoalNudgeMonth), v...))
+	})
+}
+
+// GoalNudgeMonthNotIn applies the NotIn predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthNotIn(vs ...string) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldGoalNudgeMonth), v...))
+	})
+}
+
+// GoalNudgeMonthGT applies the GT predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthGTE applies the GTE predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthLT applies the LT predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthLTE applies the LTE predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthContains applies the Contains predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthHasPrefix applies the HasPrefix predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthHasSuffix applies the HasSuffix predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthIsNil applies the IsNil predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldGoalNudgeMonth)))
+	})
+}
+
+// GoalNudgeMonthNotNil applies the NotNil predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldGoalNudgeMonth)))
+	})
+}
+
+// GoalNudgeMonthEqualFold applies the EqualFold predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
+// GoalNudgeMonthContainsFold applies the ContainsFold predicate on the "goal_nudge_month" field.
+func GoalNudgeMonthContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldGoalNudgeMonth), v))
+	})
+}
+
 // HasWastes applies the HasEdge predicate on the "wastes" edge.
 func HasWastes() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -510,6 +969,90 @@ func HasWastesWith(preds ...predicate.Waste) predicate.User {
 	})
 }
 
+// HasCredits applies the HasEdge predicate on the "credits" edge.
+func HasCredits() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CreditsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CreditsTable, CreditsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreditsWith applies the HasEdge predicate on the "credits" edge with a given conditions (other predicates).
+func HasCreditsWith(preds ...predicate.Debt) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CreditsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CreditsTable, CreditsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDebts applies the HasEdge predicate on the "debts" edge.
+func HasDebts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DebtsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DebtsTable, DebtsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDebtsWith applies the HasEdge predicate on the "debts" edge with a given conditions (other predicates).
+func HasDebtsWith(preds ...predicate.Debt) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(DebtsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DebtsTable, DebtsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGoals applies the HasEdge predicate on the "goals" edge.
+func HasGoals() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GoalsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GoalsTable, GoalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGoalsWith applies the HasEdge predicate on the "goals" edge with a given conditions (other predicates).
+func HasGoalsWith(preds ...predicate.Goal) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GoalsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GoalsTable, GoalsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -10,6 +10,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
 )
@@ -53,6 +55,68 @@ func (uc *UserCreate) SetNillableWasteLimit(u *uint64) *UserCreate {
 	return uc
 }
 
+// SetAPITokenHash sets the "api_token_hash" field.
+func (uc *UserCreate) SetAPITokenHash(s string) *UserCreate {
+	uc.mutation.SetAPITokenHash(s)
+	return uc
+}
+
+// SetNillableAPITokenHash sets the "api_token_hash" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAPITokenHash(s *string) *UserCreate {
+	if s != nil {
+		uc.SetAPITokenHash(*s)
+	}
+	return uc
+}
+
+// SetLanguage sets the "language" field.
+func (uc *UserCreate) SetLanguage(s string) *UserCreate {
+	uc.mutation.SetLanguage(s)
+	return uc
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLanguage(s *string) *UserCreate {
+	if s != nil {
+		uc.SetLanguage(*s)
+	}
+	return uc
+}
+
+// SetCurrencies sets the "currencies" field.
+func (uc *UserCreate) SetCurrencies(s []string) *UserCreate {
+	uc.mutation.SetCurrencies(s)
+	return uc
+}
+
+// SetIncome sets the "income" field.
+func (uc *UserCreate) SetIncome(i int64) *UserCreate {
+	uc.mutation.SetIncome(i)
+	return uc
+}
+
+// SetNillableIncome sets the "income" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIncome(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetIncome(*i)
+	}
+	return uc
+}
+
+// SetGoalNudgeMonth sets the "goal_nudge_month" field.
+func (uc *UserCreate) SetGoalNudgeMonth(s string) *UserCreate {
+	uc.mutation.SetGoalNudgeMonth(s)
+	return uc
+}
+
+// SetNillableGoalNudgeMonth sets the "goal_nudge_month" field if the given value is not nil.
+func (uc *UserCreate) SetNillableGoalNudgeMonth(s *string) *UserCreate {
+	if s != nil {
+		uc.SetGoalNudgeMonth(*s)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(i int64) *UserCreate {
 	uc.mutation.SetID(i)
@@ -74,6 +138,51 @@ func (uc *UserCreate) AddWastes(w ...*Waste) *UserCreate {
 	return uc.AddWasteIDs(ids...)
 }
 
+// AddCreditIDs adds the "credits" edge to the Debt entity by IDs.
+func (uc *UserCreate) AddCreditIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddCreditIDs(ids...)
+	return uc
+}
+
+// AddCredits adds the "credits" edges to the Debt entity.
+func (uc *UserCreate) AddCredits(d ...*Debt) *UserCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uc.AddCreditIDs(ids...)
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by IDs.
+func (uc *UserCreate) AddDebtIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddDebtIDs(ids...)
+	return uc
+}
+
+// AddDebts adds the "debts" edges to the Debt entity.
+func (uc *UserCreate) AddDebts(d ...*Debt) *UserCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uc.AddDebtIDs(ids...)
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (uc *UserCreate) AddGoalIDs(ids ...int) *UserCreate {
+	uc.mutation.AddGoalIDs(ids...)
+	return uc
+}
+
+// AddGoals adds the "goals" edges to the Goal entity.
+func (uc *UserCreate) AddGoals(g ...*Goal) *UserCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGoalIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -224,6 +333,46 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		_node.WasteLimit = &value
 	}
+	if value, ok := uc.mutation.APITokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAPITokenHash,
+		})
+		_node.APITokenHash = &value
+	}
+	if value, ok := uc.mutation.Language(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldLanguage,
+		})
+		_node.Language = &value
+	}
+	if value, ok := uc.mutation.Currencies(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldCurrencies,
+		})
+		_node.Currencies = value
+	}
+	if value, ok := uc.mutation.Income(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldIncome,
+		})
+		_node.Income = &value
+	}
+	if value, ok := uc.mutation.GoalNudgeMonth(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldGoalNudgeMonth,
+		})
+		_node.GoalNudgeMonth = &value
+	}
 	if nodes := uc.mutation.WastesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -243,6 +392,63 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.CreditsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditsTable,
+			Columns: []string{user.CreditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DebtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DebtsTable,
+			Columns: []string{user.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: goal.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
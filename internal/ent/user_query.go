@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
@@ -19,13 +21,16 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.User
-	withWastes *WasteQuery
+	limit       *int
+	offset      *int
+	unique      *bool
+	order       []OrderFunc
+	fields      []string
+	predicates  []predicate.User
+	withWastes  *WasteQuery
+	withCredits *DebtQuery
+	withDebts   *DebtQuery
+	withGoals   *GoalQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +89,72 @@ func (uq *UserQuery) QueryWastes() *WasteQuery {
 	return query
 }
 
+// QueryCredits chains the current query on the "credits" edge.
+func (uq *UserQuery) QueryCredits() *DebtQuery {
+	query := &DebtQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(debt.Table, debt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CreditsTable, user.CreditsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDebts chains the current query on the "debts" edge.
+func (uq *UserQuery) QueryDebts() *DebtQuery {
+	query := &DebtQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(debt.Table, debt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DebtsTable, user.DebtsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGoals chains the current query on the "goals" edge.
+func (uq *UserQuery) QueryGoals() *GoalQuery {
+	query := &GoalQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(goal.Table, goal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GoalsTable, user.GoalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -260,12 +331,15 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:     uq.config,
-		limit:      uq.limit,
-		offset:     uq.offset,
-		order:      append([]OrderFunc{}, uq.order...),
-		predicates: append([]predicate.User{}, uq.predicates...),
-		withWastes: uq.withWastes.Clone(),
+		config:      uq.config,
+		limit:       uq.limit,
+		offset:      uq.offset,
+		order:       append([]OrderFunc{}, uq.order...),
+		predicates:  append([]predicate.User{}, uq.predicates...),
+		withWastes:  uq.withWastes.Clone(),
+		withCredits: uq.withCredits.Clone(),
+		withDebts:   uq.withDebts.Clone(),
+		withGoals:   uq.withGoals.Clone(),
 		// clone intermediate query.
 		sql:    uq.sql.Clone(),
 		path:   uq.path,
@@ -284,6 +358,39 @@ func (uq *UserQuery) WithWastes(opts ...func(*WasteQuery)) *UserQuery {
 	return uq
 }
 
+// WithCredits tells the query-builder to eager-load the nodes that are connected to
+// the "credits" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithCredits(opts ...func(*DebtQuery)) *UserQuery {
+	query := &DebtQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withCredits = query
+	return uq
+}
+
+// WithDebts tells the query-builder to eager-load the nodes that are connected to
+// the "debts" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithDebts(opts ...func(*DebtQuery)) *UserQuery {
+	query := &DebtQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withDebts = query
+	return uq
+}
+
+// WithGoals tells the query-builder to eager-load the nodes that are connected to
+// the "goals" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithGoals(opts ...func(*GoalQuery)) *UserQuery {
+	query := &GoalQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGoals = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -352,8 +459,11 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			uq.withWastes != nil,
+			uq.withCredits != nil,
+			uq.withDebts != nil,
+			uq.withGoals != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -381,6 +491,27 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withCredits; query != nil {
+		if err := uq.loadCredits(ctx, query, nodes,
+			func(n *User) { n.Edges.Credits = []*Debt{} },
+			func(n *User, e *Debt) { n.Edges.Credits = append(n.Edges.Credits, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withDebts; query != nil {
+		if err := uq.loadDebts(ctx, query, nodes,
+			func(n *User) { n.Edges.Debts = []*Debt{} },
+			func(n *User, e *Debt) { n.Edges.Debts = append(n.Edges.Debts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGoals; query != nil {
+		if err := uq.loadGoals(ctx, query, nodes,
+			func(n *User) { n.Edges.Goals = []*Goal{} },
+			func(n *User, e *Goal) { n.Edges.Goals = append(n.Edges.Goals, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -415,6 +546,87 @@ func (uq *UserQuery) loadWastes(ctx context.Context, query *WasteQuery, nodes []
 	}
 	return nil
 }
+func (uq *UserQuery) loadCredits(ctx context.Context, query *DebtQuery, nodes []*User, init func(*User), assign func(*User, *Debt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.CreditsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreditorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "creditor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadDebts(ctx context.Context, query *DebtQuery, nodes []*User, init func(*User), assign func(*User, *Debt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.Debt(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.DebtsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DebtorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "debtor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadGoals(ctx context.Context, query *GoalQuery, nodes []*User, init func(*User), assign func(*User, *Goal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.GoalsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
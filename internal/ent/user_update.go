@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
@@ -74,6 +76,105 @@ func (uu *UserUpdate) ClearWasteLimit() *UserUpdate {
 	return uu
 }
 
+// SetAPITokenHash sets the "api_token_hash" field.
+func (uu *UserUpdate) SetAPITokenHash(s string) *UserUpdate {
+	uu.mutation.SetAPITokenHash(s)
+	return uu
+}
+
+// SetNillableAPITokenHash sets the "api_token_hash" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAPITokenHash(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetAPITokenHash(*s)
+	}
+	return uu
+}
+
+// ClearAPITokenHash clears the value of the "api_token_hash" field.
+func (uu *UserUpdate) ClearAPITokenHash() *UserUpdate {
+	uu.mutation.ClearAPITokenHash()
+	return uu
+}
+
+// SetLanguage sets the "language" field.
+func (uu *UserUpdate) SetLanguage(s string) *UserUpdate {
+	uu.mutation.SetLanguage(s)
+	return uu
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLanguage(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetLanguage(*s)
+	}
+	return uu
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (uu *UserUpdate) ClearLanguage() *UserUpdate {
+	uu.mutation.ClearLanguage()
+	return uu
+}
+
+// SetCurrencies sets the "currencies" field.
+func (uu *UserUpdate) SetCurrencies(s []string) *UserUpdate {
+	uu.mutation.SetCurrencies(s)
+	return uu
+}
+
+// ClearCurrencies clears the value of the "currencies" field.
+func (uu *UserUpdate) ClearCurrencies() *UserUpdate {
+	uu.mutation.ClearCurrencies()
+	return uu
+}
+
+// SetIncome sets the "income" field.
+func (uu *UserUpdate) SetIncome(i int64) *UserUpdate {
+	uu.mutation.ResetIncome()
+	uu.mutation.SetIncome(i)
+	return uu
+}
+
+// SetNillableIncome sets the "income" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableIncome(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetIncome(*i)
+	}
+	return uu
+}
+
+// AddIncome adds i to the "income" field.
+func (uu *UserUpdate) AddIncome(i int64) *UserUpdate {
+	uu.mutation.AddIncome(i)
+	return uu
+}
+
+// ClearIncome clears the value of the "income" field.
+func (uu *UserUpdate) ClearIncome() *UserUpdate {
+	uu.mutation.ClearIncome()
+	return uu
+}
+
+// SetGoalNudgeMonth sets the "goal_nudge_month" field.
+func (uu *UserUpdate) SetGoalNudgeMonth(s string) *UserUpdate {
+	uu.mutation.SetGoalNudgeMonth(s)
+	return uu
+}
+
+// SetNillableGoalNudgeMonth sets the "goal_nudge_month" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableGoalNudgeMonth(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetGoalNudgeMonth(*s)
+	}
+	return uu
+}
+
+// ClearGoalNudgeMonth clears the value of the "goal_nudge_month" field.
+func (uu *UserUpdate) ClearGoalNudgeMonth() *UserUpdate {
+	uu.mutation.ClearGoalNudgeMonth()
+	return uu
+}
+
 // AddWasteIDs adds the "wastes" edge to the Waste entity by IDs.
 func (uu *UserUpdate) AddWasteIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddWasteIDs(ids...)
@@ -89,6 +190,51 @@ func (uu *UserUpdate) AddWastes(w ...*Waste) *UserUpdate {
 	return uu.AddWasteIDs(ids...)
 }
 
+// AddCreditIDs adds the "credits" edge to the Debt entity by IDs.
+func (uu *UserUpdate) AddCreditIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddCreditIDs(ids...)
+	return uu
+}
+
+// AddCredits adds the "credits" edges to the Debt entity.
+func (uu *UserUpdate) AddCredits(d ...*Debt) *UserUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddCreditIDs(ids...)
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by IDs.
+func (uu *UserUpdate) AddDebtIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddDebtIDs(ids...)
+	return uu
+}
+
+// AddDebts adds the "debts" edges to the Debt entity.
+func (uu *UserUpdate) AddDebts(d ...*Debt) *UserUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddDebtIDs(ids...)
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (uu *UserUpdate) AddGoalIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddGoalIDs(ids...)
+	return uu
+}
+
+// AddGoals adds the "goals" edges to the Goal entity.
+func (uu *UserUpdate) AddGoals(g ...*Goal) *UserUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddGoalIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -115,6 +261,69 @@ func (uu *UserUpdate) RemoveWastes(w ...*Waste) *UserUpdate {
 	return uu.RemoveWasteIDs(ids...)
 }
 
+// ClearCredits clears all "credits" edges to the Debt entity.
+func (uu *UserUpdate) ClearCredits() *UserUpdate {
+	uu.mutation.ClearCredits()
+	return uu
+}
+
+// RemoveCreditIDs removes the "credits" edge to Debt entities by IDs.
+func (uu *UserUpdate) RemoveCreditIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveCreditIDs(ids...)
+	return uu
+}
+
+// RemoveCredits removes "credits" edges to Debt entities.
+func (uu *UserUpdate) RemoveCredits(d ...*Debt) *UserUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.RemoveCreditIDs(ids...)
+}
+
+// ClearDebts clears all "debts" edges to the Debt entity.
+func (uu *UserUpdate) ClearDebts() *UserUpdate {
+	uu.mutation.ClearDebts()
+	return uu
+}
+
+// RemoveDebtIDs removes the "debts" edge to Debt entities by IDs.
+func (uu *UserUpdate) RemoveDebtIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveDebtIDs(ids...)
+	return uu
+}
+
+// RemoveDebts removes "debts" edges to Debt entities.
+func (uu *UserUpdate) RemoveDebts(d ...*Debt) *UserUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.RemoveDebtIDs(ids...)
+}
+
+// ClearGoals clears all "goals" edges to the Goal entity.
+func (uu *UserUpdate) ClearGoals() *UserUpdate {
+	uu.mutation.ClearGoals()
+	return uu
+}
+
+// RemoveGoalIDs removes the "goals" edge to Goal entities by IDs.
+func (uu *UserUpdate) RemoveGoalIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveGoalIDs(ids...)
+	return uu
+}
+
+// RemoveGoals removes "goals" edges to Goal entities.
+func (uu *UserUpdate) RemoveGoals(g ...*Goal) *UserUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveGoalIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -228,6 +437,78 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldWasteLimit,
 		})
 	}
+	if value, ok := uu.mutation.APITokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAPITokenHash,
+		})
+	}
+	if uu.mutation.APITokenHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldAPITokenHash,
+		})
+	}
+	if value, ok := uu.mutation.Language(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldLanguage,
+		})
+	}
+	if uu.mutation.LanguageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldLanguage,
+		})
+	}
+	if value, ok := uu.mutation.Currencies(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldCurrencies,
+		})
+	}
+	if uu.mutation.CurrenciesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldCurrencies,
+		})
+	}
+	if value, ok := uu.mutation.Income(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldIncome,
+		})
+	}
+	if value, ok := uu.mutation.AddedIncome(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldIncome,
+		})
+	}
+	if uu.mutation.IncomeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: user.FieldIncome,
+		})
+	}
+	if value, ok := uu.mutation.GoalNudgeMonth(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldGoalNudgeMonth,
+		})
+	}
+	if uu.mutation.GoalNudgeMonthCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldGoalNudgeMonth,
+		})
+	}
 	if uu.mutation.WastesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -282,6 +563,168 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.CreditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditsTable,
+			Columns: []string{user.CreditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCreditsIDs(); len(nodes) > 0 && !uu.mutation.CreditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditsTable,
+			Columns: []string{user.CreditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CreditsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditsTable,
+			Columns: []string{user.CreditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DebtsTable,
+			Columns: []string{user.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedDebtsIDs(); len(nodes) > 0 && !uu.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DebtsTable,
+			Columns: []string{user.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DebtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DebtsTable,
+			Columns: []string{user.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: goal.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !uu.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: goal.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.GoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: goal.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -293,56 +736,155 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetFirstName sets the "first_name" field.
+func (uuo *UserUpdateOne) SetFirstName(s string) *UserUpdateOne {
+	uuo.mutation.SetFirstName(s)
+	return uuo
+}
+
+// SetLastName sets the "last_name" field.
+func (uuo *UserUpdateOne) SetLastName(s string) *UserUpdateOne {
+	uuo.mutation.SetLastName(s)
+	return uuo
+}
+
+// SetUserName sets the "user_name" field.
+func (uuo *UserUpdateOne) SetUserName(s string) *UserUpdateOne {
+	uuo.mutation.SetUserName(s)
+	return uuo
+}
+
+// SetWasteLimit sets the "waste_limit" field.
+func (uuo *UserUpdateOne) SetWasteLimit(u uint64) *UserUpdateOne {
+	uuo.mutation.ResetWasteLimit()
+	uuo.mutation.SetWasteLimit(u)
+	return uuo
+}
+
+// SetNillableWasteLimit sets the "waste_limit" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableWasteLimit(u *uint64) *UserUpdateOne {
+	if u != nil {
+		uuo.SetWasteLimit(*u)
+	}
+	return uuo
+}
+
+// AddWasteLimit adds u to the "waste_limit" field.
+func (uuo *UserUpdateOne) AddWasteLimit(u int64) *UserUpdateOne {
+	uuo.mutation.AddWasteLimit(u)
+	return uuo
+}
+
+// ClearWasteLimit clears the value of the "waste_limit" field.
+func (uuo *UserUpdateOne) ClearWasteLimit() *UserUpdateOne {
+	uuo.mutation.ClearWasteLimit()
+	return uuo
+}
+
+// SetAPITokenHash sets the "api_token_hash" field.
+func (uuo *UserUpdateOne) SetAPITokenHash(s string) *UserUpdateOne {
+	uuo.mutation.SetAPITokenHash(s)
+	return uuo
+}
+
+// SetNillableAPITokenHash sets the "api_token_hash" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAPITokenHash(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAPITokenHash(*s)
+	}
+	return uuo
+}
+
+// ClearAPITokenHash clears the value of the "api_token_hash" field.
+func (uuo *UserUpdateOne) ClearAPITokenHash() *UserUpdateOne {
+	uuo.mutation.ClearAPITokenHash()
+	return uuo
+}
+
+// SetLanguage sets the "language" field.
+func (uuo *UserUpdateOne) SetLanguage(s string) *UserUpdateOne {
+	uuo.mutation.SetLanguage(s)
+	return uuo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLanguage(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetLanguage(*s)
+	}
+	return uuo
 }
 
-// SetFirstName sets the "first_name" field.
-func (uuo *UserUpdateOne) SetFirstName(s string) *UserUpdateOne {
-	uuo.mutation.SetFirstName(s)
+// ClearLanguage clears the value of the "language" field.
+func (uuo *UserUpdateOne) ClearLanguage() *UserUpdateOne {
+	uuo.mutation.ClearLanguage()
 	return uuo
 }
 
-// SetLastName sets the "last_name" field.
-func (uuo *UserUpdateOne) SetLastName(s string) *UserUpdateOne {
-	uuo.mutation.SetLastName(s)
+// SetCurrencies sets the "currencies" field.
+func (uuo *UserUpdateOne) SetCurrencies(s []string) *UserUpdateOne {
+	uuo.mutation.SetCurrencies(s)
 	return uuo
 }
 
-// SetUserName sets the "user_name" field.
-func (uuo *UserUpdateOne) SetUserName(s string) *UserUpdateOne {
-	uuo.mutation.SetUserName(s)
+// ClearCurrencies clears the value of the "currencies" field.
+func (uuo *UserUpdateOne) ClearCurrencies() *UserUpdateOne {
+	uuo.mutation.ClearCurrencies()
 	return uuo
 }
 
-// SetWasteLimit sets the "waste_limit" field.
-func (uuo *UserUpdateOne) SetWasteLimit(u uint64) *UserUpdateOne {
-	uuo.mutation.ResetWasteLimit()
-	uuo.mutation.SetWasteLimit(u)
+// SetIncome sets the "income" field.
+func (uuo *UserUpdateOne) SetIncome(i int64) *UserUpdateOne {
+	uuo.mutation.ResetIncome()
+	uuo.mutation.SetIncome(i)
 	return uuo
 }
 
-// SetNillableWasteLimit sets the "waste_limit" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableWasteLimit(u *uint64) *UserUpdateOne {
-	if u != nil {
-		uuo.SetWasteLimit(*u)
+// SetNillableIncome sets the "income" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIncome(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetIncome(*i)
 	}
 	return uuo
 }
 
-// AddWasteLimit adds u to the "waste_limit" field.
-func (uuo *UserUpdateOne) AddWasteLimit(u int64) *UserUpdateOne {
-	uuo.mutation.AddWasteLimit(u)
+// AddIncome adds i to the "income" field.
+func (uuo *UserUpdateOne) AddIncome(i int64) *UserUpdateOne {
+	uuo.mutation.AddIncome(i)
 	return uuo
 }
 
-// ClearWasteLimit clears the value of the "waste_limit" field.
-func (uuo *UserUpdateOne) ClearWasteLimit() *UserUpdateOne {
-	uuo.mutation.ClearWasteLimit()
+// ClearIncome clears the value of the "income" field.
+func (uuo *UserUpdateOne) ClearIncome() *UserUpdateOne {
+	uuo.mutation.ClearIncome()
+	return uuo
+}
+
+// SetGoalNudgeMonth sets the "goal_nudge_month" field.
+func (uuo *UserUpdateOne) SetGoalNudgeMonth(s string) *UserUpdateOne {
+	uuo.mutation.SetGoalNudgeMonth(s)
+	return uuo
+}
+
+// SetNillableGoalNudgeMonth sets the "goal_nudge_month" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGoalNudgeMonth(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetGoalNudgeMonth(*s)
+	}
+	return uuo
+}
+
+// ClearGoalNudgeMonth clears the value of the "goal_nudge_month" field.
+func (uuo *UserUpdateOne) ClearGoalNudgeMonth() *UserUpdateOne {
+	uuo.mutation.ClearGoalNudgeMonth()
 	return uuo
 }
 
@@ -361,6 +903,51 @@ func (uuo *UserUpdateOne) AddWastes(w ...*Waste) *UserUpdateOne {
 	return uuo.AddWasteIDs(ids...)
 }
 
+// AddCreditIDs adds the "credits" edge to the Debt entity by IDs.
+func (uuo *UserUpdateOne) AddCreditIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddCreditIDs(ids...)
+	return uuo
+}
+
+// AddCredits adds the "credits" edges to the Debt entity.
+func (uuo *UserUpdateOne) AddCredits(d ...*Debt) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddCreditIDs(ids...)
+}
+
+// AddDebtIDs adds the "debts" edge to the Debt entity by IDs.
+func (uuo *UserUpdateOne) AddDebtIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddDebtIDs(ids...)
+	return uuo
+}
+
+// AddDebts adds the "debts" edges to the Debt entity.
+func (uuo *UserUpdateOne) AddDebts(d ...*Debt) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDebtIDs(ids...)
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (uuo *UserUpdateOne) AddGoalIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddGoalIDs(ids...)
+	return uuo
+}
+
+// AddGoals adds the "goals" edges to the Goal entity.
+func (uuo *UserUpdateOne) AddGoals(g ...*Goal) *UserUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddGoalIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -387,6 +974,69 @@ func (uuo *UserUpdateOne) RemoveWastes(w ...*Waste) *UserUpdateOne {
 	return uuo.RemoveWasteIDs(ids...)
 }
 
+// ClearCredits clears all "credits" edges to the Debt entity.
+func (uuo *UserUpdateOne) ClearCredits() *UserUpdateOne {
+	uuo.mutation.ClearCredits()
+	return uuo
+}
+
+// RemoveCreditIDs removes the "credits" edge to Debt entities by IDs.
+func (uuo *UserUpdateOne) RemoveCreditIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveCreditIDs(ids...)
+	return uuo
+}
+
+// RemoveCredits removes "credits" edges to Debt entities.
+func (uuo *UserUpdateOne) RemoveCredits(d ...*Debt) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveCreditIDs(ids...)
+}
+
+// ClearDebts clears all "debts" edges to the Debt entity.
+func (uuo *UserUpdateOne) ClearDebts() *UserUpdateOne {
+	uuo.mutation.ClearDebts()
+	return uuo
+}
+
+// RemoveDebtIDs removes the "debts" edge to Debt entities by IDs.
+func (uuo *UserUpdateOne) RemoveDebtIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveDebtIDs(ids...)
+	return uuo
+}
+
+// RemoveDebts removes "debts" edges to Debt entities.
+func (uuo *UserUpdateOne) RemoveDebts(d ...*Debt) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDebtIDs(ids...)
+}
+
+// ClearGoals clears all "goals" edges to the Goal entity.
+func (uuo *UserUpdateOne) ClearGoals() *UserUpdateOne {
+	uuo.mutation.ClearGoals()
+	return uuo
+}
+
+// RemoveGoalIDs removes the "goals" edge to Goal entities by IDs.
+func (uuo *UserUpdateOne) RemoveGoalIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveGoalIDs(ids...)
+	return uuo
+}
+
+// RemoveGoals removes "goals" edges to Goal entities.
+func (uuo *UserUpdateOne) RemoveGoals(g ...*Goal) *UserUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveGoalIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
@@ -530,6 +1180,78 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldWasteLimit,
 		})
 	}
+	if value, ok := uuo.mutation.APITokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAPITokenHash,
+		})
+	}
+	if uuo.mutation.APITokenHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldAPITokenHash,
+		})
+	}
+	if value, ok := uuo.mutation.Language(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldLanguage,
+		})
+	}
+	if uuo.mutation.LanguageCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldLanguage,
+		})
+	}
+	if value, ok := uuo.mutation.Currencies(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldCurrencies,
+		})
+	}
+	if uuo.mutation.CurrenciesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldCurrencies,
+		})
+	}
+	if value, ok := uuo.mutation.Income(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldIncome,
+		})
+	}
+	if value, ok := uuo.mutation.AddedIncome(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldIncome,
+		})
+	}
+	if uuo.mutation.IncomeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: user.FieldIncome,
+		})
+	}
+	if value, ok := uuo.mutation.GoalNudgeMonth(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldGoalNudgeMonth,
+		})
+	}
+	if uuo.mutation.GoalNudgeMonthCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldGoalNudgeMonth,
+		})
+	}
 	if uuo.mutation.WastesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -584,6 +1306,168 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.CreditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditsTable,
+			Columns: []string{user.CreditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedCreditsIDs(); len(nodes) > 0 && !uuo.mutation.CreditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditsTable,
+			Columns: []string{user.CreditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.CreditsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreditsTable,
+			Columns: []string{user.CreditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DebtsTable,
+			Columns: []string{user.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedDebtsIDs(); len(nodes) > 0 && !uuo.mutation.DebtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DebtsTable,
+			Columns: []string{user.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.DebtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DebtsTable,
+			Columns: []string{user.DebtsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: debt.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: goal.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !uuo.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: goal.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.GoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: goal.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 // Waste is the model entity for the Waste schema.
@@ -24,6 +25,14 @@ type Waste struct {
 	Category string `json:"category,omitempty"`
 	// Date holds the value of the "date" field.
 	Date time.Time `json:"date,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency *string `json:"currency,omitempty"`
+	// OriginalCost holds the value of the "original_cost" field.
+	OriginalCost *int64 `json:"original_cost,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// ClaimStatus holds the value of the "claim_status" field.
+	ClaimStatus *enums.ClaimStatus `json:"claim_status,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the WasteQuery when eager-loading is set.
 	Edges       WasteEdges `json:"edges"`
@@ -34,9 +43,11 @@ type Waste struct {
 type WasteEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -52,14 +63,23 @@ func (e WasteEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e WasteEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[1] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Waste) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case waste.FieldCost:
+		case waste.FieldCost, waste.FieldOriginalCost:
 			values[i] = new(sql.NullInt64)
-		case waste.FieldCategory:
+		case waste.FieldCategory, waste.FieldCurrency, waste.FieldNote, waste.FieldClaimStatus:
 			values[i] = new(sql.NullString)
 		case waste.FieldDate:
 			values[i] = new(sql.NullTime)
@@ -106,6 +126,33 @@ func (w *Waste) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				w.Date = value.Time
 			}
+		case waste.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				w.Currency = new(string)
+				*w.Currency = value.String
+			}
+		case waste.FieldOriginalCost:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_cost", values[i])
+			} else if value.Valid {
+				w.OriginalCost = new(int64)
+				*w.OriginalCost = value.Int64
+			}
+		case waste.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				w.Note = value.String
+			}
+		case waste.FieldClaimStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_status", values[i])
+			} else if value.Valid {
+				w.ClaimStatus = new(enums.ClaimStatus)
+				*w.ClaimStatus = enums.ClaimStatus(value.String)
+			}
 		case waste.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_wastes", value)
@@ -123,6 +170,11 @@ func (w *Waste) QueryUser() *UserQuery {
 	return (&WasteClient{config: w.config}).QueryUser(w)
 }
 
+// QueryTags queries the "tags" edge of the Waste entity.
+func (w *Waste) QueryTags() *TagQuery {
+	return (&WasteClient{config: w.config}).QueryTags(w)
+}
+
 // Update returns a builder for updating this Waste.
 // Note that you need to call Waste.Unwrap() before calling this method if this Waste
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -154,6 +206,24 @@ func (w *Waste) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("date=")
 	builder.WriteString(w.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := w.Currency; v != nil {
+		builder.WriteString("currency=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := w.OriginalCost; v != nil {
+		builder.WriteString("original_cost=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(w.Note)
+	builder.WriteString(", ")
+	if v := w.ClaimStatus; v != nil {
+		builder.WriteString("claim_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,8 +17,18 @@ const (
 	FieldCategory = "category"
 	// FieldDate holds the string denoting the date field in the database.
 	FieldDate = "date"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldOriginalCost holds the string denoting the original_cost field in the database.
+	FieldOriginalCost = "original_cost"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldClaimStatus holds the string denoting the claim_status field in the database.
+	FieldClaimStatus = "claim_status"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
 	// Table holds the table name of the waste in the database.
 	Table = "wastes"
 	// UserTable is the table that holds the user relation/edge.
@@ -28,6 +38,11 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_wastes"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_wastes"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
 )
 
 // Columns holds all SQL columns for waste fields.
@@ -36,6 +51,10 @@ var Columns = []string{
 	FieldCost,
 	FieldCategory,
 	FieldDate,
+	FieldCurrency,
+	FieldOriginalCost,
+	FieldNote,
+	FieldClaimStatus,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "wastes"
@@ -44,6 +63,12 @@ var ForeignKeys = []string{
 	"user_wastes",
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "waste_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 // ID filters vertices based on their ID field.
@@ -103,6 +104,35 @@ func Date(v time.Time) predicate.Waste {
 	})
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// OriginalCost applies equality check predicate on the "original_cost" field. It's identical to OriginalCostEQ.
+func OriginalCost(v int64) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOriginalCost), v))
+	})
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNote), v))
+	})
+}
+
+// ClaimStatus applies equality check predicate on the "claim_status" field. It's identical to ClaimStatusEQ.
+func ClaimStatus(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimStatus), vc))
+	})
+}
+
 // CostEQ applies the EQ predicate on the "cost" field.
 func CostEQ(v int64) predicate.Waste {
 	return predicate.Waste(func(s *sql.Selector) {
@@ -330,6 +360,434 @@ func DateLTE(v time.Time) predicate.Waste {
 	})
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Waste {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Waste {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCurrency)))
+	})
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCurrency)))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
+// OriginalCostEQ applies the EQ predicate on the "original_cost" field.
+func OriginalCostEQ(v int64) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOriginalCost), v))
+	})
+}
+
+// OriginalCostNEQ applies the NEQ predicate on the "original_cost" field.
+func OriginalCostNEQ(v int64) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOriginalCost), v))
+	})
+}
+
+// OriginalCostIn applies the In predicate on the "original_cost" field.
+func OriginalCostIn(vs ...int64) predicate.Waste {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldOriginalCost), v...))
+	})
+}
+
+// OriginalCostNotIn applies the NotIn predicate on the "original_cost" field.
+func OriginalCostNotIn(vs ...int64) predicate.Waste {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldOriginalCost), v...))
+	})
+}
+
+// OriginalCostGT applies the GT predicate on the "original_cost" field.
+func OriginalCostGT(v int64) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOriginalCost), v))
+	})
+}
+
+// OriginalCostGTE applies the GTE predicate on the "original_cost" field.
+func OriginalCostGTE(v int64) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOriginalCost), v))
+	})
+}
+
+// OriginalCostLT applies the LT predicate on the "original_cost" field.
+func OriginalCostLT(v int64) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOriginalCost), v))
+	})
+}
+
+// OriginalCostLTE applies the LTE predicate on the "original_cost" field.
+func OriginalCostLTE(v int64) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOriginalCost), v))
+	})
+}
+
+// OriginalCostIsNil applies the IsNil predicate on the "original_cost" field.
+func OriginalCostIsNil() predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOriginalCost)))
+	})
+}
+
+// OriginalCostNotNil applies the NotNil predicate on the "original_cost" field.
+func OriginalCostNotNil() predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOriginalCost)))
+	})
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNote), v))
+	})
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNote), v))
+	})
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.Waste {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldNote), v...))
+	})
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.Waste {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldNote), v...))
+	})
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNote), v))
+	})
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNote), v))
+	})
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNote), v))
+	})
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNote), v))
+	})
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNote), v))
+	})
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNote), v))
+	})
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNote), v))
+	})
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNote)))
+	})
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNote)))
+	})
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNote), v))
+	})
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNote), v))
+	})
+}
+
+// ClaimStatusEQ applies the EQ predicate on the "claim_status" field.
+func ClaimStatusEQ(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusNEQ applies the NEQ predicate on the "claim_status" field.
+func ClaimStatusNEQ(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusIn applies the In predicate on the "claim_status" field.
+func ClaimStatusIn(vs ...enums.ClaimStatus) predicate.Waste {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldClaimStatus), v...))
+	})
+}
+
+// ClaimStatusNotIn applies the NotIn predicate on the "claim_status" field.
+func ClaimStatusNotIn(vs ...enums.ClaimStatus) predicate.Waste {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldClaimStatus), v...))
+	})
+}
+
+// ClaimStatusGT applies the GT predicate on the "claim_status" field.
+func ClaimStatusGT(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusGTE applies the GTE predicate on the "claim_status" field.
+func ClaimStatusGTE(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusLT applies the LT predicate on the "claim_status" field.
+func ClaimStatusLT(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusLTE applies the LTE predicate on the "claim_status" field.
+func ClaimStatusLTE(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusContains applies the Contains predicate on the "claim_status" field.
+func ClaimStatusContains(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusHasPrefix applies the HasPrefix predicate on the "claim_status" field.
+func ClaimStatusHasPrefix(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusHasSuffix applies the HasSuffix predicate on the "claim_status" field.
+func ClaimStatusHasSuffix(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusIsNil applies the IsNil predicate on the "claim_status" field.
+func ClaimStatusIsNil() predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldClaimStatus)))
+	})
+}
+
+// ClaimStatusNotNil applies the NotNil predicate on the "claim_status" field.
+func ClaimStatusNotNil() predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldClaimStatus)))
+	})
+}
+
+// ClaimStatusEqualFold applies the EqualFold predicate on the "claim_status" field.
+func ClaimStatusEqualFold(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldClaimStatus), vc))
+	})
+}
+
+// ClaimStatusContainsFold applies the ContainsFold predicate on the "claim_status" field.
+func ClaimStatusContainsFold(v enums.ClaimStatus) predicate.Waste {
+	vc := string(v)
+	return predicate.Waste(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldClaimStatus), vc))
+	})
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Waste {
 	return predicate.Waste(func(s *sql.Selector) {
@@ -358,6 +816,34 @@ func HasUserWith(preds ...predicate.User) predicate.Waste {
 	})
 }
 
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TagsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Waste {
+	return predicate.Waste(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TagsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Waste) predicate.Waste {
 	return predicate.Waste(func(s *sql.Selector) {
@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/tag"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 // WasteCreate is the builder for creating a Waste entity.
@@ -40,6 +42,62 @@ func (wc *WasteCreate) SetDate(t time.Time) *WasteCreate {
 	return wc
 }
 
+// SetCurrency sets the "currency" field.
+func (wc *WasteCreate) SetCurrency(s string) *WasteCreate {
+	wc.mutation.SetCurrency(s)
+	return wc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (wc *WasteCreate) SetNillableCurrency(s *string) *WasteCreate {
+	if s != nil {
+		wc.SetCurrency(*s)
+	}
+	return wc
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (wc *WasteCreate) SetOriginalCost(i int64) *WasteCreate {
+	wc.mutation.SetOriginalCost(i)
+	return wc
+}
+
+// SetNillableOriginalCost sets the "original_cost" field if the given value is not nil.
+func (wc *WasteCreate) SetNillableOriginalCost(i *int64) *WasteCreate {
+	if i != nil {
+		wc.SetOriginalCost(*i)
+	}
+	return wc
+}
+
+// SetNote sets the "note" field.
+func (wc *WasteCreate) SetNote(s string) *WasteCreate {
+	wc.mutation.SetNote(s)
+	return wc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (wc *WasteCreate) SetNillableNote(s *string) *WasteCreate {
+	if s != nil {
+		wc.SetNote(*s)
+	}
+	return wc
+}
+
+// SetClaimStatus sets the "claim_status" field.
+func (wc *WasteCreate) SetClaimStatus(es enums.ClaimStatus) *WasteCreate {
+	wc.mutation.SetClaimStatus(es)
+	return wc
+}
+
+// SetNillableClaimStatus sets the "claim_status" field if the given value is not nil.
+func (wc *WasteCreate) SetNillableClaimStatus(es *enums.ClaimStatus) *WasteCreate {
+	if es != nil {
+		wc.SetClaimStatus(*es)
+	}
+	return wc
+}
+
 // SetID sets the "id" field.
 func (wc *WasteCreate) SetID(u uuid.UUID) *WasteCreate {
 	wc.mutation.SetID(u)
@@ -73,6 +131,21 @@ func (wc *WasteCreate) SetUser(u *User) *WasteCreate {
 	return wc.SetUserID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (wc *WasteCreate) AddTagIDs(ids ...int) *WasteCreate {
+	wc.mutation.AddTagIDs(ids...)
+	return wc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (wc *WasteCreate) AddTags(t ...*Tag) *WasteCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wc.AddTagIDs(ids...)
+}
+
 // Mutation returns the WasteMutation object of the builder.
 func (wc *WasteCreate) Mutation() *WasteMutation {
 	return wc.mutation
@@ -227,6 +300,38 @@ func (wc *WasteCreate) createSpec() (*Waste, *sqlgraph.CreateSpec) {
 		})
 		_node.Date = value
 	}
+	if value, ok := wc.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waste.FieldCurrency,
+		})
+		_node.Currency = &value
+	}
+	if value, ok := wc.mutation.OriginalCost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: waste.FieldOriginalCost,
+		})
+		_node.OriginalCost = &value
+	}
+	if value, ok := wc.mutation.Note(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waste.FieldNote,
+		})
+		_node.Note = value
+	}
+	if value, ok := wc.mutation.ClaimStatus(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waste.FieldClaimStatus,
+		})
+		_node.ClaimStatus = &value
+	}
 	if nodes := wc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -247,6 +352,25 @@ func (wc *WasteCreate) createSpec() (*Waste, *sqlgraph.CreateSpec) {
 		_node.user_wastes = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := wc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   waste.TagsTable,
+			Columns: waste.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/tag"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
 )
@@ -26,6 +28,7 @@ type WasteQuery struct {
 	fields     []string
 	predicates []predicate.Waste
 	withUser   *UserQuery
+	withTags   *TagQuery
 	withFKs    bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -85,6 +88,28 @@ func (wq *WasteQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryTags chains the current query on the "tags" edge.
+func (wq *WasteQuery) QueryTags() *TagQuery {
+	query := &TagQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waste.Table, waste.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, waste.TagsTable, waste.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Waste entity from the query.
 // Returns a *NotFoundError when no Waste was found.
 func (wq *WasteQuery) First(ctx context.Context) (*Waste, error) {
@@ -267,6 +292,7 @@ func (wq *WasteQuery) Clone() *WasteQuery {
 		order:      append([]OrderFunc{}, wq.order...),
 		predicates: append([]predicate.Waste{}, wq.predicates...),
 		withUser:   wq.withUser.Clone(),
+		withTags:   wq.withTags.Clone(),
 		// clone intermediate query.
 		sql:    wq.sql.Clone(),
 		path:   wq.path,
@@ -285,6 +311,17 @@ func (wq *WasteQuery) WithUser(opts ...func(*UserQuery)) *WasteQuery {
 	return wq
 }
 
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WasteQuery) WithTags(opts ...func(*TagQuery)) *WasteQuery {
+	query := &TagQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withTags = query
+	return wq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -354,8 +391,9 @@ func (wq *WasteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Waste,
 		nodes       = []*Waste{}
 		withFKs     = wq.withFKs
 		_spec       = wq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			wq.withUser != nil,
+			wq.withTags != nil,
 		}
 	)
 	if wq.withUser != nil {
@@ -388,6 +426,13 @@ func (wq *WasteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Waste,
 			return nil, err
 		}
 	}
+	if query := wq.withTags; query != nil {
+		if err := wq.loadTags(ctx, query, nodes,
+			func(n *Waste) { n.Edges.Tags = []*Tag{} },
+			func(n *Waste, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -420,6 +465,64 @@ func (wq *WasteQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*W
 	}
 	return nil
 }
+func (wq *WasteQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Waste, init func(*Waste), assign func(*Waste, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Waste)
+	nids := make(map[int]map[*Waste]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(waste.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(waste.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(waste.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(waste.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	neighbors, err := query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+		assign := spec.Assign
+		values := spec.ScanValues
+		spec.ScanValues = func(columns []string) ([]any, error) {
+			values, err := values(columns[1:])
+			if err != nil {
+				return nil, err
+			}
+			return append([]any{new(uuid.UUID)}, values...), nil
+		}
+		spec.Assign = func(columns []string, values []any) error {
+			outValue := *values[0].(*uuid.UUID)
+			inValue := int(values[1].(*sql.NullInt64).Int64)
+			if nids[inValue] == nil {
+				nids[inValue] = map[*Waste]struct{}{byID[outValue]: struct{}{}}
+				return assign(columns[1:], values[1:])
+			}
+			nids[inValue][byID[outValue]] = struct{}{}
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (wq *WasteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := wq.querySpec()
@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/tag"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 // WasteUpdate is the builder for updating Waste entities.
@@ -54,6 +56,93 @@ func (wu *WasteUpdate) SetDate(t time.Time) *WasteUpdate {
 	return wu
 }
 
+// SetCurrency sets the "currency" field.
+func (wu *WasteUpdate) SetCurrency(s string) *WasteUpdate {
+	wu.mutation.SetCurrency(s)
+	return wu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (wu *WasteUpdate) SetNillableCurrency(s *string) *WasteUpdate {
+	if s != nil {
+		wu.SetCurrency(*s)
+	}
+	return wu
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (wu *WasteUpdate) ClearCurrency() *WasteUpdate {
+	wu.mutation.ClearCurrency()
+	return wu
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (wu *WasteUpdate) SetOriginalCost(i int64) *WasteUpdate {
+	wu.mutation.ResetOriginalCost()
+	wu.mutation.SetOriginalCost(i)
+	return wu
+}
+
+// SetNillableOriginalCost sets the "original_cost" field if the given value is not nil.
+func (wu *WasteUpdate) SetNillableOriginalCost(i *int64) *WasteUpdate {
+	if i != nil {
+		wu.SetOriginalCost(*i)
+	}
+	return wu
+}
+
+// AddOriginalCost adds i to the "original_cost" field.
+func (wu *WasteUpdate) AddOriginalCost(i int64) *WasteUpdate {
+	wu.mutation.AddOriginalCost(i)
+	return wu
+}
+
+// ClearOriginalCost clears the value of the "original_cost" field.
+func (wu *WasteUpdate) ClearOriginalCost() *WasteUpdate {
+	wu.mutation.ClearOriginalCost()
+	return wu
+}
+
+// SetNote sets the "note" field.
+func (wu *WasteUpdate) SetNote(s string) *WasteUpdate {
+	wu.mutation.SetNote(s)
+	return wu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (wu *WasteUpdate) SetNillableNote(s *string) *WasteUpdate {
+	if s != nil {
+		wu.SetNote(*s)
+	}
+	return wu
+}
+
+// ClearNote clears the value of the "note" field.
+func (wu *WasteUpdate) ClearNote() *WasteUpdate {
+	wu.mutation.ClearNote()
+	return wu
+}
+
+// SetClaimStatus sets the "claim_status" field.
+func (wu *WasteUpdate) SetClaimStatus(es enums.ClaimStatus) *WasteUpdate {
+	wu.mutation.SetClaimStatus(es)
+	return wu
+}
+
+// SetNillableClaimStatus sets the "claim_status" field if the given value is not nil.
+func (wu *WasteUpdate) SetNillableClaimStatus(es *enums.ClaimStatus) *WasteUpdate {
+	if es != nil {
+		wu.SetClaimStatus(*es)
+	}
+	return wu
+}
+
+// ClearClaimStatus clears the value of the "claim_status" field.
+func (wu *WasteUpdate) ClearClaimStatus() *WasteUpdate {
+	wu.mutation.ClearClaimStatus()
+	return wu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (wu *WasteUpdate) SetUserID(id int64) *WasteUpdate {
 	wu.mutation.SetUserID(id)
@@ -73,6 +162,21 @@ func (wu *WasteUpdate) SetUser(u *User) *WasteUpdate {
 	return wu.SetUserID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (wu *WasteUpdate) AddTagIDs(ids ...int) *WasteUpdate {
+	wu.mutation.AddTagIDs(ids...)
+	return wu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (wu *WasteUpdate) AddTags(t ...*Tag) *WasteUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wu.AddTagIDs(ids...)
+}
+
 // Mutation returns the WasteMutation object of the builder.
 func (wu *WasteUpdate) Mutation() *WasteMutation {
 	return wu.mutation
@@ -84,6 +188,27 @@ func (wu *WasteUpdate) ClearUser() *WasteUpdate {
 	return wu
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (wu *WasteUpdate) ClearTags() *WasteUpdate {
+	wu.mutation.ClearTags()
+	return wu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (wu *WasteUpdate) RemoveTagIDs(ids ...int) *WasteUpdate {
+	wu.mutation.RemoveTagIDs(ids...)
+	return wu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (wu *WasteUpdate) RemoveTags(t ...*Tag) *WasteUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (wu *WasteUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -184,6 +309,65 @@ func (wu *WasteUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: waste.FieldDate,
 		})
 	}
+	if value, ok := wu.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waste.FieldCurrency,
+		})
+	}
+	if wu.mutation.CurrencyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: waste.FieldCurrency,
+		})
+	}
+	if value, ok := wu.mutation.OriginalCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: waste.FieldOriginalCost,
+		})
+	}
+	if value, ok := wu.mutation.AddedOriginalCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: waste.FieldOriginalCost,
+		})
+	}
+	if wu.mutation.OriginalCostCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: waste.FieldOriginalCost,
+		})
+	}
+	if value, ok := wu.mutation.Note(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waste.FieldNote,
+		})
+	}
+	if wu.mutation.NoteCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: waste.FieldNote,
+		})
+	}
+	if value, ok := wu.mutation.ClaimStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waste.FieldClaimStatus,
+		})
+	}
+	if wu.mutation.ClaimStatusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: waste.FieldClaimStatus,
+		})
+	}
 	if wu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -219,6 +403,60 @@ func (wu *WasteUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   waste.TagsTable,
+			Columns: waste.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !wu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   waste.TagsTable,
+			Columns: waste.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   waste.TagsTable,
+			Columns: waste.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{waste.Label}
@@ -263,6 +501,93 @@ func (wuo *WasteUpdateOne) SetDate(t time.Time) *WasteUpdateOne {
 	return wuo
 }
 
+// SetCurrency sets the "currency" field.
+func (wuo *WasteUpdateOne) SetCurrency(s string) *WasteUpdateOne {
+	wuo.mutation.SetCurrency(s)
+	return wuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (wuo *WasteUpdateOne) SetNillableCurrency(s *string) *WasteUpdateOne {
+	if s != nil {
+		wuo.SetCurrency(*s)
+	}
+	return wuo
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (wuo *WasteUpdateOne) ClearCurrency() *WasteUpdateOne {
+	wuo.mutation.ClearCurrency()
+	return wuo
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (wuo *WasteUpdateOne) SetOriginalCost(i int64) *WasteUpdateOne {
+	wuo.mutation.ResetOriginalCost()
+	wuo.mutation.SetOriginalCost(i)
+	return wuo
+}
+
+// SetNillableOriginalCost sets the "original_cost" field if the given value is not nil.
+func (wuo *WasteUpdateOne) SetNillableOriginalCost(i *int64) *WasteUpdateOne {
+	if i != nil {
+		wuo.SetOriginalCost(*i)
+	}
+	return wuo
+}
+
+// AddOriginalCost adds i to the "original_cost" field.
+func (wuo *WasteUpdateOne) AddOriginalCost(i int64) *WasteUpdateOne {
+	wuo.mutation.AddOriginalCost(i)
+	return wuo
+}
+
+// ClearOriginalCost clears the value of the "original_cost" field.
+func (wuo *WasteUpdateOne) ClearOriginalCost() *WasteUpdateOne {
+	wuo.mutation.ClearOriginalCost()
+	return wuo
+}
+
+// SetNote sets the "note" field.
+func (wuo *WasteUpdateOne) SetNote(s string) *WasteUpdateOne {
+	wuo.mutation.SetNote(s)
+	return wuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (wuo *WasteUpdateOne) SetNillableNote(s *string) *WasteUpdateOne {
+	if s != nil {
+		wuo.SetNote(*s)
+	}
+	return wuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (wuo *WasteUpdateOne) ClearNote() *WasteUpdateOne {
+	wuo.mutation.ClearNote()
+	return wuo
+}
+
+// SetClaimStatus sets the "claim_status" field.
+func (wuo *WasteUpdateOne) SetClaimStatus(es enums.ClaimStatus) *WasteUpdateOne {
+	wuo.mutation.SetClaimStatus(es)
+	return wuo
+}
+
+// SetNillableClaimStatus sets the "claim_status" field if the given value is not nil.
+func (wuo *WasteUpdateOne) SetNillableClaimStatus(es *enums.ClaimStatus) *WasteUpdateOne {
+	if es != nil {
+		wuo.SetClaimStatus(*es)
+	}
+	return wuo
+}
+
+// ClearClaimStatus clears the value of the "claim_status" field.
+func (wuo *WasteUpdateOne) ClearClaimStatus() *WasteUpdateOne {
+	wuo.mutation.ClearClaimStatus()
+	return wuo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (wuo *WasteUpdateOne) SetUserID(id int64) *WasteUpdateOne {
 	wuo.mutation.SetUserID(id)
@@ -282,6 +607,21 @@ func (wuo *WasteUpdateOne) SetUser(u *User) *WasteUpdateOne {
 	return wuo.SetUserID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (wuo *WasteUpdateOne) AddTagIDs(ids ...int) *WasteUpdateOne {
+	wuo.mutation.AddTagIDs(ids...)
+	return wuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (wuo *WasteUpdateOne) AddTags(t ...*Tag) *WasteUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wuo.AddTagIDs(ids...)
+}
+
 // Mutation returns the WasteMutation object of the builder.
 func (wuo *WasteUpdateOne) Mutation() *WasteMutation {
 	return wuo.mutation
@@ -293,6 +633,27 @@ func (wuo *WasteUpdateOne) ClearUser() *WasteUpdateOne {
 	return wuo
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (wuo *WasteUpdateOne) ClearTags() *WasteUpdateOne {
+	wuo.mutation.ClearTags()
+	return wuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (wuo *WasteUpdateOne) RemoveTagIDs(ids ...int) *WasteUpdateOne {
+	wuo.mutation.RemoveTagIDs(ids...)
+	return wuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (wuo *WasteUpdateOne) RemoveTags(t ...*Tag) *WasteUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return wuo.RemoveTagIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (wuo *WasteUpdateOne) Select(field string, fields ...string) *WasteUpdateOne {
@@ -423,6 +784,65 @@ func (wuo *WasteUpdateOne) sqlSave(ctx context.Context) (_node *Waste, err error
 			Column: waste.FieldDate,
 		})
 	}
+	if value, ok := wuo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waste.FieldCurrency,
+		})
+	}
+	if wuo.mutation.CurrencyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: waste.FieldCurrency,
+		})
+	}
+	if value, ok := wuo.mutation.OriginalCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: waste.FieldOriginalCost,
+		})
+	}
+	if value, ok := wuo.mutation.AddedOriginalCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: waste.FieldOriginalCost,
+		})
+	}
+	if wuo.mutation.OriginalCostCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: waste.FieldOriginalCost,
+		})
+	}
+	if value, ok := wuo.mutation.Note(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waste.FieldNote,
+		})
+	}
+	if wuo.mutation.NoteCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: waste.FieldNote,
+		})
+	}
+	if value, ok := wuo.mutation.ClaimStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: waste.FieldClaimStatus,
+		})
+	}
+	if wuo.mutation.ClaimStatusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: waste.FieldClaimStatus,
+		})
+	}
 	if wuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -458,6 +878,60 @@ func (wuo *WasteUpdateOne) sqlSave(ctx context.Context) (_node *Waste, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   waste.TagsTable,
+			Columns: waste.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !wuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   waste.TagsTable,
+			Columns: waste.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   waste.TagsTable,
+			Columns: waste.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: tag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Waste{config: wuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
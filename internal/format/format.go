@@ -0,0 +1,146 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+var ErrUnsupportedParseMode = errors.New("unsupported parse mode, only MarkdownV2 and HTML are supported")
+
+var (
+	// markdownV2Escaper escapes all special characters of MarkdownV2 outside of entities.
+	markdownV2Escaper = strings.NewReplacer(
+		`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`,
+		"~", `\~`, "`", "\\`", ">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`, "=", `\=`,
+		"|", `\|`, "{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+	)
+	// markdownV2CodeEscaper escapes the characters of MarkdownV2 inside code and pre entities.
+	markdownV2CodeEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`")
+)
+
+// Formatter renders the content of messages for the parse mode of telegram,
+// the text of users is escaped, so it can not break the markup of the message.
+type Formatter struct {
+	mode enums.ParseMode
+}
+
+func New(mode enums.ParseMode) (*Formatter, error) {
+	if mode != enums.ParseModeMarkdownV2 && mode != enums.ParseModeHTML {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedParseMode, mode)
+	}
+
+	return &Formatter{
+		mode: mode,
+	}, nil
+}
+
+// ParseMode returns the mode for sending the rendered messages.
+func (f *Formatter) ParseMode() enums.ParseMode {
+	return f.mode
+}
+
+// Escape returns the text which is shown as is.
+func (f *Formatter) Escape(text string) string {
+	if f.mode == enums.ParseModeHTML {
+		return html.EscapeString(text)
+	}
+
+	return markdownV2Escaper.Replace(text)
+}
+
+func (f *Formatter) escapeCode(text string) string {
+	if f.mode == enums.ParseModeHTML {
+		return html.EscapeString(text)
+	}
+
+	return markdownV2CodeEscaper.Replace(text)
+}
+
+// NewMessage starts building the message.
+func (f *Formatter) NewMessage() *Message {
+	return &Message{
+		f: f,
+	}
+}
+
+// Text returns the message with the only text.
+func (f *Formatter) Text(text string) string {
+	return f.NewMessage().Text(text).String()
+}
+
+// Textf returns the message with the only formatted text.
+func (f *Formatter) Textf(format string, args ...interface{}) string {
+	return f.NewMessage().Textf(format, args...).String()
+}
+
+// Message is a builder of the message from the parts of content.
+type Message struct {
+	f *Formatter
+	b strings.Builder
+}
+
+func (m *Message) Text(text string) *Message {
+	m.b.WriteString(m.f.Escape(text))
+	return m
+}
+
+func (m *Message) Textf(format string, args ...interface{}) *Message {
+	return m.Text(fmt.Sprintf(format, args...))
+}
+
+func (m *Message) Bold(text string) *Message {
+	return m.wrap(text, "*", "<b>", "</b>")
+}
+
+func (m *Message) Italic(text string) *Message {
+	return m.wrap(text, "_", "<i>", "</i>")
+}
+
+// Code adds the inline monospace text.
+func (m *Message) Code(text string) *Message {
+	if m.f.mode == enums.ParseModeHTML {
+		m.b.WriteString("<code>" + m.f.escapeCode(text) + "</code>")
+	} else {
+		m.b.WriteString("`" + m.f.escapeCode(text) + "`")
+	}
+
+	return m
+}
+
+// Pre adds the block of monospace text, the markers of the block are on separate lines,
+// so the long message can be split into parts between them.
+func (m *Message) Pre(text string) *Message {
+	text = strings.TrimSuffix(text, "\n")
+
+	if m.f.mode == enums.ParseModeHTML {
+		m.b.WriteString("<pre>\n" + m.f.escapeCode(text) + "\n</pre>")
+	} else {
+		m.b.WriteString("```\n" + m.f.escapeCode(text) + "\n```")
+	}
+
+	return m
+}
+
+// Line adds the line break.
+func (m *Message) Line() *Message {
+	m.b.WriteString("\n")
+	return m
+}
+
+func (m *Message) String() string {
+	return m.b.String()
+}
+
+func (m *Message) wrap(text string, markdown string, openTag string, closeTag string) *Message {
+	if m.f.mode == enums.ParseModeHTML {
+		m.b.WriteString(openTag + m.f.Escape(text) + closeTag)
+	} else {
+		m.b.WriteString(markdown + m.f.Escape(text) + markdown)
+	}
+
+	return m
+}
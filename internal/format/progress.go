@@ -0,0 +1,18 @@
+package format
+
+import "strings"
+
+const progressBarWidth = 10
+
+// ProgressBar returns the bar of the percent from 0 to 100, like "▓▓▓▓░░░░░░" for 40.
+func ProgressBar(percent int) string {
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 100 {
+		percent = 100
+	}
+
+	filled := percent * progressBarWidth / 100
+	return strings.Repeat("▓", filled) + strings.Repeat("░", progressBarWidth-filled)
+}
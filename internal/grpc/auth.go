@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/apitoken"
+)
+
+const (
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+)
+
+//go:generate mockery --name=authenticator --dir . --output ./mocks --exported
+type authenticator interface {
+	Authenticate(ctx context.Context, token string) (int64, error)
+}
+
+// authInterceptor authenticates the user by api token, it is used only by the public server.
+func authInterceptor(auth authenticator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		token := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(authorizationKey); len(values) > 0 {
+				token = strings.TrimPrefix(values[0], bearerPrefix)
+			}
+		}
+
+		userID, err := auth.Authenticate(ctx, token)
+		if errors.Is(err, apitoken.ErrInvalidToken) {
+			return nil, status.Error(codes.Unauthenticated, "invalid api token")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to authenticate: %v", err)
+		}
+
+		return handler(apitoken.ContextWithUserID(ctx, userID), req)
+	}
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"errors"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/api"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+var errEmptyFile = errors.New("content or url of the file must be set")
+
+func newParseMode(parseMode api.ParseMode) enums.ParseMode {
+	switch parseMode {
+	case api.ParseMode_PARSE_MODE_MARKDOWN_V2:
+		return enums.ParseModeMarkdownV2
+	case api.ParseMode_PARSE_MODE_HTML:
+		return enums.ParseModeHTML
+	case api.ParseMode_PARSE_MODE_PLAIN:
+		return enums.ParseModePlain
+	default:
+		return enums.ParseModeMarkdown
+	}
+}
+
+func newKeyboard(keyboard *api.Keyboard) *models.Keyboard {
+	if keyboard == nil {
+		return nil
+	}
+
+	rows := make([][]models.KeyboardButton, 0, len(keyboard.GetRows()))
+	for _, row := range keyboard.GetRows() {
+		buttons := make([]models.KeyboardButton, 0, len(row.GetButtons()))
+		for _, button := range row.GetButtons() {
+			buttons = append(buttons, models.KeyboardButton{
+				Text:         button.GetText(),
+				CallbackData: button.GetCallbackData(),
+				URL:          button.GetUrl(),
+			})
+		}
+		rows = append(rows, buttons)
+	}
+
+	var keyboardType enums.KeyboardType
+	switch keyboard.GetType() {
+	case api.KeyboardType_KEYBOARD_TYPE_KEEP:
+		keyboardType = enums.KeyboardKeep
+	case api.KeyboardType_KEYBOARD_TYPE_REPLY:
+		keyboardType = enums.KeyboardReply
+	case api.KeyboardType_KEYBOARD_TYPE_INLINE:
+		keyboardType = enums.KeyboardInline
+	default:
+		keyboardType = enums.KeyboardRemove
+	}
+
+	return &models.Keyboard{
+		Type:    keyboardType,
+		Rows:    rows,
+		OneTime: keyboard.GetOneTime(),
+	}
+}
+
+func newOutgoingFile(file *api.File) (*models.OutgoingFile, error) {
+	if len(file.GetContent()) == 0 && file.GetUrl() == "" {
+		return nil, errEmptyFile
+	}
+
+	return &models.OutgoingFile{
+		UserID:    file.GetUserId(),
+		FileName:  file.GetFileName(),
+		Content:   file.GetContent(),
+		URL:       file.GetUrl(),
+		Caption:   file.GetCaption(),
+		ParseMode: newParseMode(file.GetParseMode()),
+		Keyboard:  newKeyboard(file.GetKeyboard()),
+	}, nil
+}
@@ -12,28 +12,47 @@ import (
 )
 
 type Config struct {
+	// Port is the port of the internal service of the bot used by the report service.
 	Port int `yaml:"port"`
+	// PublicPort is the port of the public API of the wastes authenticated by the api tokens.
+	PublicPort int `yaml:"public_port"`
 }
 
+// Server serves the internal service of the bot and the public API of the wastes
+// on the separate ports, so the internal service is not reachable from the public port.
 type Server struct {
-	port   int
-	logger log.Logger
+	port       int
+	publicPort int
+	logger     log.Logger
 
-	server *grpc.Server
+	server       *grpc.Server
+	publicServer *grpc.Server
 
-	tgClient telegramClient
-	cache    cacheService
+	tgClient      telegramClient
+	cache         cacheService
+	wastesService *WastesService
+	auth          authenticator
 
 	isClosed bool
 }
 
-func NewServer(config Config, tgClient telegramClient, cacheService cacheService, logger log.Logger) *Server {
+func NewServer(
+	config Config,
+	tgClient telegramClient,
+	cacheService cacheService,
+	wastesService *WastesService,
+	auth authenticator,
+	logger log.Logger,
+) *Server {
 	return &Server{
-		port:   config.Port,
-		logger: logger.With(log.ComponentKey, "Grpc server"),
+		port:       config.Port,
+		publicPort: config.PublicPort,
+		logger:     logger.With(log.ComponentKey, "Grpc server"),
 
-		tgClient: tgClient,
-		cache:    cacheService,
+		tgClient:      tgClient,
+		cache:         cacheService,
+		wastesService: wastesService,
+		auth:          auth,
 
 		isClosed: false,
 	}
@@ -45,24 +64,37 @@ func (s *Server) Start() error {
 		return fmt.Errorf("failed to listen port %d: %w", s.port, err)
 	}
 
+	publicListener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.publicPort))
+	if err != nil {
+		_ = listener.Close()
+		return fmt.Errorf("failed to listen port %d: %w", s.publicPort, err)
+	}
+
 	s.server = grpc.NewServer()
 	api.RegisterTelegramBotServer(s.server, NewTelegramBotClient(s.tgClient, s.cache))
 
-	go func() {
-		s.logger.Infof("server is listening the port %d", s.port)
+	s.publicServer = grpc.NewServer(grpc.UnaryInterceptor(authInterceptor(s.auth)))
+	api.RegisterWastesServiceServer(s.publicServer, s.wastesService)
 
-		if err := s.server.Serve(listener); err != nil && !s.isClosed {
-			s.logger.WithError(err).
-				Fatalf("fail to serve the server on the port %d", s.port)
-		}
-	}()
+	go s.serve(s.server, listener, s.port)
+	go s.serve(s.publicServer, publicListener, s.publicPort)
 
 	return nil
 }
 
+func (s *Server) serve(server *grpc.Server, listener net.Listener, port int) {
+	s.logger.Infof("server is listening the port %d", port)
+
+	if err := server.Serve(listener); err != nil && !s.isClosed {
+		s.logger.WithError(err).
+			Fatalf("fail to serve the server on the port %d", port)
+	}
+}
+
 func (s *Server) Stop(ctx context.Context) error {
 	s.logger.Info("grpc server is stopping")
 	s.isClosed = true
 	s.server.Stop()
+	s.publicServer.Stop()
 	return nil
 }
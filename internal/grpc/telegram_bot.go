@@ -4,17 +4,25 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/api"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 //go:generate mockery --name=telegramClient --dir . --output ./mocks --exported
 type telegramClient interface {
-	SendMessage(ctx context.Context, userID int64, text string) error
+	SendFormattedMessage(ctx context.Context, message *models.OutgoingMessage) (int, error)
+	SendPhoto(ctx context.Context, file *models.OutgoingFile) (int, error)
+	SendDocument(ctx context.Context, file *models.OutgoingFile) (int, error)
+	EditMessage(ctx context.Context, messageID int, message *models.OutgoingMessage) error
 }
 
 type cacheService interface {
 	Set(ctx context.Context, userID int64, command enums.CommandType, value string) error
+	ClearKeys(ctx context.Context, userID int64, commands ...enums.CommandType) error
 }
 
 type TelegramBotClient struct {
@@ -31,16 +39,124 @@ func NewTelegramBotClient(tgClient telegramClient, cacheService cacheService) *T
 	}
 }
 
-func (c *TelegramBotClient) SendMessage(ctx context.Context, msg *api.Message) (*api.EmptyMessage, error) {
-	err := c.tgClient.SendMessage(ctx, msg.GetUserId(), msg.GetText())
+func (c *TelegramBotClient) SendMessage(ctx context.Context, msg *api.Message) (*api.SentMessage, error) {
+	messageID, err := c.sendMessage(ctx, msg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send message by tg client: %w", err)
+		return nil, err
 	}
 
-	err = c.cache.Set(ctx, msg.GetUserId(), enums.CommandType(msg.GetCommand()), msg.GetText())
+	return &api.SentMessage{
+		MessageId: int32(messageID),
+	}, nil
+}
+
+// SendMessages sends all messages of the batch, the error of one message does not stop sending the others.
+func (c *TelegramBotClient) SendMessages(ctx context.Context, batch *api.MessageBatch) (*api.MessageBatchResult, error) {
+	results := make([]*api.MessageResult, 0, len(batch.GetMessages()))
+	for _, msg := range batch.GetMessages() {
+		messageID, err := c.sendMessage(ctx, msg)
+		if err != nil {
+			results = append(results, &api.MessageResult{
+				Error: err.Error(),
+			})
+			continue
+		}
+
+		results = append(results, &api.MessageResult{
+			MessageId: int32(messageID),
+		})
+	}
+
+	return &api.MessageBatchResult{
+		Results: results,
+	}, nil
+}
+
+func (c *TelegramBotClient) SendPhoto(ctx context.Context, file *api.File) (*api.SentMessage, error) {
+	outgoingFile, err := newOutgoingFile(file)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	messageID, err := c.tgClient.SendPhoto(withIdempotencyKey(ctx, file.GetIdempotencyKey()), outgoingFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send photo by tg client: %w", err)
+	}
+
+	return &api.SentMessage{
+		MessageId: int32(messageID),
+	}, nil
+}
+
+func (c *TelegramBotClient) SendDocument(ctx context.Context, file *api.File) (*api.SentMessage, error) {
+	outgoingFile, err := newOutgoingFile(file)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	messageID, err := c.tgClient.SendDocument(withIdempotencyKey(ctx, file.GetIdempotencyKey()), outgoingFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send document by tg client: %w", err)
+	}
+
+	return &api.SentMessage{
+		MessageId: int32(messageID),
+	}, nil
+}
+
+func (c *TelegramBotClient) EditMessage(ctx context.Context, msg *api.EditedMessage) (*api.EmptyMessage, error) {
+	keyboard := newKeyboard(msg.GetKeyboard())
+	if keyboard != nil && keyboard.Type != enums.KeyboardInline {
+		return nil, status.Error(codes.InvalidArgument, "only inline keyboard can be set to the edited message")
+	}
+
+	err := c.tgClient.EditMessage(ctx, int(msg.GetMessageId()), &models.OutgoingMessage{
+		UserID:    msg.GetUserId(),
+		Text:      msg.GetText(),
+		ParseMode: newParseMode(msg.GetParseMode()),
+		Keyboard:  keyboard,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to set value to the cache: %w", err)
+		return nil, fmt.Errorf("failed to edit message by tg client: %w", err)
 	}
 
 	return &api.EmptyMessage{}, nil
 }
+
+func (c *TelegramBotClient) sendMessage(ctx context.Context, msg *api.Message) (int, error) {
+	sendCtx := withIdempotencyKey(ctx, msg.GetIdempotencyKey())
+	if language, ok := enums.ParseLanguage(msg.GetLanguage()); ok {
+		sendCtx = models.ContextWithLanguage(sendCtx, language)
+	}
+
+	messageID, err := c.tgClient.SendFormattedMessage(sendCtx, &models.OutgoingMessage{
+		UserID:    msg.GetUserId(),
+		Text:      msg.GetText(),
+		ParseMode: newParseMode(msg.GetParseMode()),
+		Keyboard:  newKeyboard(msg.GetKeyboard()),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to send message by tg client: %w", err)
+	}
+
+	// the messages without the command are not the answers to the commands and are not cached
+	if msg.GetCommand() == "" {
+		return messageID, nil
+	}
+
+	err = c.cache.Set(ctx, msg.GetUserId(), enums.CommandType(msg.GetCommand()), msg.GetText())
+	if err != nil {
+		return 0, fmt.Errorf("failed to set value to the cache: %w", err)
+	}
+
+	return messageID, nil
+}
+
+// withIdempotencyKey returns the context for sending the message once if the key is set.
+func withIdempotencyKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+
+	return models.ContextWithIdempotencyKey(ctx, key)
+}
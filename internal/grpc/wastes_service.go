@@ -0,0 +1,299 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/api"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/repository"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/apitoken"
+)
+
+const (
+	defaultListLimit = 100
+	maxListLimit     = 1000
+)
+
+//go:generate mockery --name=wasteRepository --dir . --output ./mocks --exported
+type wasteRepository interface {
+	ListWastes(ctx context.Context, userID int64, filter models.WasteFilter) ([]*models.Waste, int, error)
+	AddWasteToUser(ctx context.Context, userID int64, waste *models.Waste) (*models.Waste, error)
+	UpdateWaste(ctx context.Context, userID int64, waste *models.Waste) (*models.Waste, error)
+	DeleteWaste(ctx context.Context, userID int64, id uuid.UUID) error
+	GetReportBetweenDates(ctx context.Context, userID int64, from time.Time, to time.Time) ([]*models.CategoryReport, error)
+}
+
+//go:generate mockery --name=userRepository --dir . --output ./mocks --exported
+type userRepository interface {
+	GetWasteLimit(ctx context.Context, id int64) (*uint64, error)
+	SetWasteLimit(ctx context.Context, id int64, limit uint64) (*models.User, error)
+	ClearWasteLimit(ctx context.Context, id int64) (*models.User, error)
+}
+
+// WastesService is a public API for wastes of the authenticated user.
+type WastesService struct {
+	api.UnimplementedWastesServiceServer
+
+	wasteRepo wasteRepository
+	userRepo  userRepository
+	cache     cacheService
+}
+
+func NewWastesService(wasteRepo wasteRepository, userRepo userRepository, cacheService cacheService) *WastesService {
+	return &WastesService{
+		wasteRepo: wasteRepo,
+		userRepo:  userRepo,
+		cache:     cacheService,
+	}
+}
+
+func (s *WastesService) ListWastes(ctx context.Context, req *api.ListWastesRequest) (*api.ListWastesResponse, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	filter := models.WasteFilter{
+		Category: req.GetCategory(),
+		Limit:    limit,
+		Offset:   int(req.GetOffset()),
+	}
+	if req.GetFrom() != nil {
+		filter.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		filter.To = req.GetTo().AsTime()
+	}
+
+	wastes, total, err := s.wasteRepo.ListWastes(ctx, userID, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list wastes: %v", err)
+	}
+
+	result := make([]*api.Waste, 0, len(wastes))
+	for _, waste := range wastes {
+		result = append(result, newAPIWaste(waste))
+	}
+
+	return &api.ListWastesResponse{
+		Wastes: result,
+		Total:  int32(total),
+	}, nil
+}
+
+func (s *WastesService) AddWaste(ctx context.Context, req *api.AddWasteRequest) (*api.Waste, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateWaste(req.GetCategory(), req.GetCost()); err != nil {
+		return nil, err
+	}
+
+	date := time.Now()
+	if req.GetDate() != nil {
+		date = req.GetDate().AsTime()
+	}
+
+	waste, err := s.wasteRepo.AddWasteToUser(ctx, userID, models.NewWaste(req.GetCategory(), req.GetCost(), date))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add waste: %v", err)
+	}
+
+	s.clearReportsCache(ctx, userID)
+
+	return newAPIWaste(waste), nil
+}
+
+func (s *WastesService) UpdateWaste(ctx context.Context, req *api.UpdateWasteRequest) (*api.Waste, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id of the waste")
+	}
+
+	if err := validateWaste(req.GetCategory(), req.GetCost()); err != nil {
+		return nil, err
+	}
+
+	if req.GetDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "date of the waste is required")
+	}
+
+	waste := models.NewWaste(req.GetCategory(), req.GetCost(), req.GetDate().AsTime())
+	waste.ID = id
+
+	updated, err := s.wasteRepo.UpdateWaste(ctx, userID, waste)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "waste not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update waste: %v", err)
+	}
+
+	s.clearReportsCache(ctx, userID)
+
+	return newAPIWaste(updated), nil
+}
+
+func (s *WastesService) DeleteWaste(ctx context.Context, req *api.DeleteWasteRequest) (*api.EmptyMessage, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id of the waste")
+	}
+
+	err = s.wasteRepo.DeleteWaste(ctx, userID, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "waste not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete waste: %v", err)
+	}
+
+	s.clearReportsCache(ctx, userID)
+
+	return &api.EmptyMessage{}, nil
+}
+
+func (s *WastesService) GetLimit(ctx context.Context, req *api.GetLimitRequest) (*api.Limit, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := s.userRepo.GetWasteLimit(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get limit: %v", err)
+	}
+
+	return &api.Limit{
+		Limit: limit,
+	}, nil
+}
+
+func (s *WastesService) SetLimit(ctx context.Context, req *api.SetLimitRequest) (*api.Limit, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *models.User
+	if req.Limit == nil {
+		user, err = s.userRepo.ClearWasteLimit(ctx, userID)
+	} else {
+		user, err = s.userRepo.SetWasteLimit(ctx, userID, req.GetLimit())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set limit: %v", err)
+	}
+
+	err = s.cache.ClearKeys(ctx, userID, enums.CommandTypeGetLimit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to clear cache: %v", err)
+	}
+
+	return &api.Limit{
+		Limit: user.WasteLimit,
+	}, nil
+}
+
+func (s *WastesService) GetReport(ctx context.Context, req *api.GetReportRequest) (*api.Report, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Time{}
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+
+	to := time.Now()
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	report, err := s.wasteRepo.GetReportBetweenDates(ctx, userID, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get report: %v", err)
+	}
+
+	result := &api.Report{
+		Categories: make([]*api.CategorySum, 0, len(report)),
+	}
+	for _, category := range report {
+		result.Categories = append(result.Categories, &api.CategorySum{
+			Category: category.Category,
+			Sum:      category.Sum,
+		})
+		result.Total += category.Sum
+	}
+
+	return result, nil
+}
+
+// clearReportsCache drops the cached reports after changing the wastes,
+// the error is not returned because the wastes are already changed.
+func (s *WastesService) clearReportsCache(ctx context.Context, userID int64) {
+	_ = s.cache.ClearKeys(ctx, userID,
+		enums.CommandTypeWeekReport,
+		enums.CommandTypeMonthReport,
+		enums.CommandTypeYearReport,
+	)
+}
+
+func userFromContext(ctx context.Context) (int64, error) {
+	userID, ok := apitoken.UserIDFromContext(ctx)
+	if !ok {
+		return 0, status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+
+	return userID, nil
+}
+
+func validateWaste(category string, cost int64) error {
+	if category == "" {
+		return status.Error(codes.InvalidArgument, "category of the waste is required")
+	}
+
+	if cost <= 0 {
+		return status.Error(codes.InvalidArgument, "cost of the waste must be positive")
+	}
+
+	return nil
+}
+
+func newAPIWaste(waste *models.Waste) *api.Waste {
+	return &api.Waste{
+		Id:       waste.ID.String(),
+		Category: waste.Category,
+		Cost:     waste.Cost,
+		Date:     timestamppb.New(waste.Date),
+	}
+}
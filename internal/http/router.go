@@ -16,6 +16,7 @@ type Config struct {
 
 type HttpRouter struct {
 	port   int
+	mux    *http.ServeMux
 	server *http.Server
 	logger log.Logger
 }
@@ -26,6 +27,7 @@ func NewHttpRouter(config Config, logger log.Logger) *HttpRouter {
 
 	return &HttpRouter{
 		port: config.Port,
+		mux:  serveMux,
 		server: &http.Server{
 			Handler: serveMux,
 		},
@@ -33,6 +35,11 @@ func NewHttpRouter(config Config, logger log.Logger) *HttpRouter {
 	}
 }
 
+// Handle registers the handler for the pattern, must be called before Start.
+func (r *HttpRouter) Handle(pattern string, handler http.Handler) {
+	r.mux.Handle(pattern, handler)
+}
+
 func (r *HttpRouter) Start() error {
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", r.port))
 	if err != nil {
@@ -0,0 +1,246 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/api"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/service/apitoken"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
+)
+
+const (
+	WastesAPIPrefix = "/api/v1/"
+
+	bearerPrefix = "Bearer "
+	maxBodySize  = 1 << 20
+)
+
+//go:generate mockery --name=authenticator --dir . --output ./mocks --exported
+type authenticator interface {
+	Authenticate(ctx context.Context, token string) (int64, error)
+}
+
+// WastesHandler is a JSON gateway to the public wastes API:
+//
+//	GET    /api/v1/wastes?from=&to=&category=&limit=&offset=
+//	POST   /api/v1/wastes
+//	PUT    /api/v1/wastes/{id}
+//	DELETE /api/v1/wastes/{id}
+//	GET    /api/v1/limit
+//	PUT    /api/v1/limit
+//	GET    /api/v1/report?from=&to=
+//
+// Dates in the query are in RFC 3339 format, bodies are JSON representation
+// of the messages of wastes.proto.
+type WastesHandler struct {
+	service api.WastesServiceServer
+	auth    authenticator
+	logger  log.Logger
+}
+
+func NewWastesHandler(service api.WastesServiceServer, auth authenticator, logger log.Logger) *WastesHandler {
+	return &WastesHandler{
+		service: service,
+		auth:    auth,
+		logger:  logger.With(log.ComponentKey, "Wastes http handler"),
+	}
+}
+
+func (h *WastesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, err := h.authenticate(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, WastesAPIPrefix), "/")
+	parts := strings.Split(path, "/")
+
+	var resp proto.Message
+	switch {
+	case path == "wastes" && r.Method == http.MethodGet:
+		resp, err = h.listWastes(ctx, r)
+	case path == "wastes" && r.Method == http.MethodPost:
+		req := &api.AddWasteRequest{}
+		if err = readBody(r, req); err == nil {
+			resp, err = h.service.AddWaste(ctx, req)
+		}
+	case len(parts) == 2 && parts[0] == "wastes" && r.Method == http.MethodPut:
+		req := &api.UpdateWasteRequest{}
+		if err = readBody(r, req); err == nil {
+			req.Id = parts[1]
+			resp, err = h.service.UpdateWaste(ctx, req)
+		}
+	case len(parts) == 2 && parts[0] == "wastes" && r.Method == http.MethodDelete:
+		resp, err = h.service.DeleteWaste(ctx, &api.DeleteWasteRequest{Id: parts[1]})
+	case path == "limit" && r.Method == http.MethodGet:
+		resp, err = h.service.GetLimit(ctx, &api.GetLimitRequest{})
+	case path == "limit" && r.Method == http.MethodPut:
+		req := &api.SetLimitRequest{}
+		if err = readBody(r, req); err == nil {
+			resp, err = h.service.SetLimit(ctx, req)
+		}
+	case path == "report" && r.Method == http.MethodGet:
+		resp, err = h.getReport(ctx, r)
+	default:
+		err = status.Error(codes.NotFound, "unknown method")
+	}
+
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	h.writeResponse(w, resp)
+}
+
+func (h *WastesHandler) authenticate(r *http.Request) (context.Context, error) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), bearerPrefix)
+
+	userID, err := h.auth.Authenticate(r.Context(), token)
+	if errors.Is(err, apitoken.ErrInvalidToken) {
+		return nil, status.Error(codes.Unauthenticated, "invalid api token")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to authenticate: %v", err)
+	}
+
+	return apitoken.ContextWithUserID(r.Context(), userID), nil
+}
+
+func (h *WastesHandler) listWastes(ctx context.Context, r *http.Request) (proto.Message, error) {
+	query := r.URL.Query()
+	req := &api.ListWastesRequest{
+		Category: query.Get("category"),
+	}
+
+	var err error
+	if req.From, err = parseTimestamp(query.Get("from")); err != nil {
+		return nil, err
+	}
+	if req.To, err = parseTimestamp(query.Get("to")); err != nil {
+		return nil, err
+	}
+	if req.Limit, err = parseInt32(query.Get("limit")); err != nil {
+		return nil, err
+	}
+	if req.Offset, err = parseInt32(query.Get("offset")); err != nil {
+		return nil, err
+	}
+
+	return h.service.ListWastes(ctx, req)
+}
+
+func (h *WastesHandler) getReport(ctx context.Context, r *http.Request) (proto.Message, error) {
+	query := r.URL.Query()
+	req := &api.GetReportRequest{}
+
+	var err error
+	if req.From, err = parseTimestamp(query.Get("from")); err != nil {
+		return nil, err
+	}
+	if req.To, err = parseTimestamp(query.Get("to")); err != nil {
+		return nil, err
+	}
+
+	return h.service.GetReport(ctx, req)
+}
+
+func (h *WastesHandler) writeResponse(w http.ResponseWriter, resp proto.Message) {
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		h.writeError(w, status.Errorf(codes.Internal, "failed to marshal response: %v", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.WithError(err).Warn("failed to write response")
+	}
+}
+
+func (h *WastesHandler) writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+
+	code := httpStatus(st.Code())
+	if code == http.StatusInternalServerError {
+		h.logger.WithError(err).Error("failed to handle wastes api request")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	body, _ := json.Marshal(map[string]string{"error": st.Message()})
+	if _, err := w.Write(body); err != nil {
+		h.logger.WithError(err).Warn("failed to write response")
+	}
+}
+
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func readBody(r *http.Request, msg proto.Message) error {
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to read body: %v", err)
+	}
+
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid body: %v", err)
+	}
+
+	return nil
+}
+
+func parseTimestamp(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid date %q", value)
+	}
+
+	return timestamppb.New(t), nil
+}
+
+func parseInt32(value string) (int32, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	result, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid number %q", value)
+	}
+
+	return int32(result), nil
+}
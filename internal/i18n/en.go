@@ -0,0 +1,217 @@
+package i18n
+
+var englishMessages = map[Key]message{
+	KeyInternalError:  {other: "Internal error"},
+	KeySentAsDocument: {other: "The message is too long, so it has been sent as a file"},
+	KeyTooManyRequests: {
+		one:   "Too many requests, try again in %d second",
+		other: "Too many requests, try again in %d seconds",
+	},
+	KeyIncorrectFormat: {other: "Incorrect format"},
+	KeyBusy:            {other: "The bot is busy, the message has not been handled, try again in a few seconds"},
+
+	KeyHelpTitle: {other: "This bot keeps track of your expenses by categories"},
+	KeyHelpCommands: {other: `/add - add a new expense
+/setLimit - set the monthly limit
+/getLimit - show the current monthly limit
+/week - report of expenses for the last week
+/month - report of expenses for the last month
+/year - report of expenses for the last year
+(the currency and the tag of the report can be set after the command, for example /week EUR #vacation, or expenses can be grouped by tags: /week by:tag)
+/currency - change the currency
+/language - change the language
+/rates - exchange rates to the chosen currency
+/convert - convert the amount from one currency to another, for example /convert 50 USD EUR
+/find - search expenses by category, amount and date, for example /find taxi from:03.2024
+/claims - reimbursable expenses and the document to claim them
+/split - split the expense with other users
+/settle - balances of debts and confirmation of their payment
+/goal - savings goals and progress
+/status - status of the requested reports
+/token - get the token for the API`},
+	KeyIncorrectContext: {other: "Unknown state of the user, the state has been reset to the default one"},
+
+	KeyAddResponse: {other: `To add an expense send the message in the format:
+
+<Category name>
+<Amount, for example 1,500.50, 120+80 or 12 USD for the expense in another currency>
+<Date in the format DD.MM.YYYY> (optional)
+<Note and tags, for example lunch with colleagues #work> (optional)
+
+To mark the expense as reimbursable add !claim to the note`},
+	KeySuccessfulAddWaste: {other: "The expense has been added"},
+	KeyWarningLimit:       {other: "Left until the monthly limit is exceeded: %s"},
+	KeyLimitExceeded:      {other: "The monthly limit is exceeded by %s"},
+
+	KeySetLimitResponse:   {other: "Enter the limit in the current currency, for example 15,000 or 15000.50"},
+	KeySuccessfulSetLimit: {other: "The monthly limit has been set"},
+	KeyGetLimit:           {other: "Current monthly limit: %s"},
+	KeyNullLimit:          {other: "The monthly limit is not set"},
+
+	KeyChooseCurrency:           {other: "Choose the currency on the keyboard or send the ISO 4217 code of another currency, for example GBP"},
+	KeySuccessfulChangeCurrency: {other: "The currency has been changed to %s"},
+	KeyCurrencyNotSupported:     {other: "Exchange rates of %s are not available, choose another currency"},
+	KeyStaleRates:               {other: "Exchange rates were updated %s ago and may be outdated"},
+
+	KeyRatesHeader:      {other: "Exchange rates to %s:"},
+	KeyRatesUpdatedAt:   {other: "Updated %s"},
+	KeyRatesNotReceived: {other: "Exchange rates have not been received yet, try later"},
+	KeyConvertResponse: {other: `Send the amount and currencies separated by spaces, for example 50 USD EUR.
+If the second currency is not set, the amount is converted to the chosen currency`},
+	KeyUnknownCurrency:  {other: "Unknown currency %s, use the ISO 4217 code, for example USD"},
+	KeyRateNotAvailable: {other: "Exchange rate of %s is not available"},
+	KeyOtherCurrency:    {other: "The amount must be in the chosen currency %s, it can be changed with /currency"},
+
+	KeyFindUsage: {other: `Send the query after the command, for example /find taxi from:03.2024 to:03.2024
+
+Words without prefixes are searched in the name of the category and the note, you can also set:
+cat:<category> - the exact name of the category
+#<tag> - expenses with the tag
+from:<date> and to:<date> - the period in the format DD.MM.YYYY or MM.YYYY
+min:<amount> and max:<amount> - the range of amounts in the chosen currency
+page:<number> - the page of the results`},
+	KeyFindNothing:  {other: "Nothing has been found"},
+	KeyFindTotal:    {other: "Found expenses: %d for %s"},
+	KeyFindPage:     {other: "Page %d of %d"},
+	KeyFindPrevious: {other: "« Previous"},
+	KeyFindNext:     {other: "Next »"},
+
+	KeyClaimsUsage: {other: `Commands of reimbursable expenses:
+/claims - expenses waiting to be claimed and reimbursed
+/claims report from:<date> to:<date> - document with reimbursable expenses for the period, the date is in the format DD.MM.YYYY or MM.YYYY, the period ends today by default
+
+To mark an expense as reimbursable add !claim to the note when adding the expense`},
+	KeyClaimsNothing:          {other: "No expenses waiting for reimbursement. To mark an expense as reimbursable add !claim to the note when adding the expense"},
+	KeyClaimsPending:          {other: "Waiting to be claimed: %d for %s"},
+	KeyClaimsClaimed:          {other: "Claimed and waiting for reimbursement: %d for %s"},
+	KeyClaimsMore:             {other: "and %d more"},
+	KeyClaimsReportHint:       {other: "Document for the claim: /claims report from:01.03.2024 to:31.03.2024"},
+	KeyClaimsMarkClaimed:      {other: "Claimed: %s %s"},
+	KeyClaimsMarkReimbursed:   {other: "Reimbursed: %s %s"},
+	KeyClaimsMarkedClaimed:    {other: "Marked as claimed: %d"},
+	KeyClaimsMarkedReimbursed: {other: "Marked as reimbursed: %d"},
+
+	KeySplitResponse: {other: `To split an expense send the message in the format:
+
+<Category name>
+<Amount you have paid, for example 3,000>
+<Participants, for example @anna @bob or with amounts @anna:500 @bob>
+<Date in the format DD.MM.YYYY> (optional)
+<Note and tags> (optional)
+
+Participants without the amount split the rest equally with you, only your share is added to your expenses`},
+	KeySplitUnknownUser:  {other: "User @%s is not found, the user must send a message to the bot at least once"},
+	KeySplitYourself:     {other: "You can not be a participant, your share is calculated automatically"},
+	KeySplitExceeded:     {other: "The shares of the participants exceed the amount of the expense"},
+	KeySplitSuccess:      {other: "The expense has been split, your share: %s. Debts of the participants:"},
+	KeySplitNotNotified:  {other: "Failed to notify: %s"},
+	KeySplitNotification: {other: "%s has split the expense \"%s\", your share: %s\nBalances of debts: /settle"},
+
+	KeySettleUsage: {other: `/settle - balances of debts with other users
+/settle @username - confirm that the user has paid the debt back`},
+	KeySettleNothing:      {other: "No unsettled debts"},
+	KeySettleBalances:     {other: "Unsettled debts:"},
+	KeySettleOwesYou:      {other: "%s owes you %s"},
+	KeySettleYouOwe:       {other: "You owe %s %s"},
+	KeySettleButton:       {other: "%s has paid back"},
+	KeySettleNoDebts:      {other: "No unsettled debts with %s"},
+	KeySettleOnlyCreditor: {other: "You owe %s, the settlement is confirmed by the one who is paid back"},
+	KeySettleSuccess:      {other: "Debts with %s are settled for %s"},
+	KeySettleNotification: {other: "%s has confirmed that you have paid back the debt of %s"},
+
+	KeyGoalUsage: {other: `Savings goals:
+/goal - goals and progress
+/goal new <name> <amount> <date DD.MM.YYYY> - create the goal, for example /goal new Vacation 100000 01.06.2027
+/goal add <name> <amount> - save money for the goal
+/goal income <amount> - monthly income to check if the money is enough for the goals
+/goal delete <name> - delete the goal
+
+The amounts are in the chosen currency`},
+	KeyGoalsHeader:        {other: "Savings goals:"},
+	KeyGoalCreated:        {other: "The goal \"%s\" has been created"},
+	KeyGoalExists:         {other: "The goal \"%s\" already exists"},
+	KeyGoalNotFound:       {other: "The goal \"%s\" is not found"},
+	KeyGoalDeadlinePassed: {other: "The deadline of the goal must be in the future"},
+	KeyGoalContributed:    {other: "%s has been saved for the goal \"%s\""},
+	KeyGoalDeleted:        {other: "The goal \"%s\" has been deleted"},
+	KeyGoalIncomeSet:      {other: "The monthly income has been saved: %s"},
+	KeyGoalIncome:         {other: "Monthly income: %s"},
+	KeyGoalNoIncome:       {other: "Set the monthly income with /goal income <amount> to check if the money is enough for the goals"},
+	KeyGoalMonthly:        {other: "Save %s a month until %s"},
+	KeyGoalReached:        {other: "The goal is reached"},
+	KeyGoalOverdue:        {other: "The deadline %s has passed"},
+
+	KeyGoalNudgeHeader:   {other: "Savings goals for this month:"},
+	KeyGoalNudgeGoal:     {other: "%s: %s %d%%, save %s until %s"},
+	KeyGoalNudgeFree:     {other: "Income minus expenses for the last month: %s, to save for all goals: %s"},
+	KeyGoalNudgeEnough:   {other: "The free money is enough for all goals"},
+	KeyGoalNudgeShortage: {other: "%s a month is missing, try to cut expenses or move the deadlines of the goals"},
+
+	KeyChooseLanguage:           {other: "Choose the language on the keyboard"},
+	KeySuccessfulChangeLanguage: {other: "The language has been changed to English"},
+
+	KeyToken: {other: "Token for the API: "},
+	KeyTokenUsage: {other: "The previous token is no longer valid. " +
+		"Pass the token in the header Authorization: Bearer <token>"},
+
+	KeyGeneratingReport: {other: "The report is being generated..."},
+	KeyNoPendingReports: {other: "No reports in progress"},
+	KeyPendingReports:   {other: "Requested reports:"},
+	KeyPendingReport:    {other: "Report %s: %s (requested %s ago)"},
+	KeyMinutesAgo: {
+		one:   "%d minute",
+		other: "%d minutes",
+	},
+	KeyHoursAgo: {
+		one:   "%d hour",
+		other: "%d hours",
+	},
+	KeyDaysAgo: {
+		one:   "%d day",
+		other: "%d days",
+	},
+
+	KeyReportStatusQueued:     {other: "queued"},
+	KeyReportStatusProcessing: {other: "in progress"},
+	KeyReportStatusFailed:     {other: "failed"},
+
+	KeyPeriodWeek:      {other: "for the week"},
+	KeyPeriodMonth:     {other: "for the month"},
+	KeyPeriodYear:      {other: "for the year"},
+	KeyPeriodLastWeek:  {other: "for the last week"},
+	KeyPeriodLastMonth: {other: "for the last month"},
+	KeyPeriodLastYear:  {other: "for the last year"},
+	KeyPeriodClaims:    {other: "of reimbursable expenses"},
+
+	KeyReportFailed: {other: "Failed to generate the report %s, try to request it later"},
+	KeyReportSlow:   {other: "The report %s takes longer than usual, it will be sent as soon as it is ready"},
+
+	KeyReportHeader:   {other: "Report of expenses %s:"},
+	KeyWasteNotFound:  {other: "No expenses found for the period"},
+	KeyReportCategory: {other: "CATEGORY"},
+	KeyReportSpent:    {other: "SPENT"},
+	KeyReportTotal:    {other: "TOTAL"},
+
+	KeyReportByCurrency: {other: "Expenses by entered currency:"},
+	KeyReportCurrency:   {other: "CURRENCY"},
+	KeyReportEntered:    {other: "ENTERED"},
+	KeyReportConverted:  {other: "IN REPORT CURRENCY"},
+
+	KeyReportTagHeader:  {other: "Report of expenses tagged #%s %s:"},
+	KeyReportTagsHeader: {other: "Report by tags %s:"},
+	KeyReportTag:        {other: "TAG"},
+	KeyReportTagsNote:   {other: "Expenses with several tags are counted in each of them"},
+
+	KeyClaimsNotFound:        {other: "No reimbursable expenses found for the period"},
+	KeyClaimReportCaption:    {other: "Reimbursable expenses from %s to %s for %s"},
+	KeyClaimColumnDate:       {other: "Date"},
+	KeyClaimColumnCategory:   {other: "Category"},
+	KeyClaimColumnNote:       {other: "Note"},
+	KeyClaimColumnAmount:     {other: "Amount, %s"},
+	KeyClaimColumnEntered:    {other: "Entered amount"},
+	KeyClaimColumnCurrency:   {other: "Currency"},
+	KeyClaimColumnStatus:     {other: "Status"},
+	KeyClaimStatusPending:    {other: "pending"},
+	KeyClaimStatusClaimed:    {other: "claimed"},
+	KeyClaimStatusReimbursed: {other: "reimbursed"},
+}
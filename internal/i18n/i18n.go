@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"fmt"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+// Key identifies the text in the catalogue of messages.
+type Key string
+
+// message is the text in one language, plural forms are used by Plural,
+// other form is used if the needed form is not set.
+type message struct {
+	one   string
+	few   string
+	many  string
+	other string
+}
+
+var catalogue = map[enums.Language]map[Key]message{
+	enums.LanguageRussian: russianMessages,
+	enums.LanguageEnglish: englishMessages,
+}
+
+// languageNames are names of the languages in the languages themselves.
+var languageNames = map[enums.Language]string{
+	enums.LanguageRussian: "Русский",
+	enums.LanguageEnglish: "English",
+}
+
+// Localizer returns texts of the messages in one language,
+// the texts missing in the language are taken from the default language.
+type Localizer struct {
+	language enums.Language
+}
+
+func New(language enums.Language) *Localizer {
+	if _, ok := catalogue[language]; !ok {
+		language = enums.DefaultLanguage
+	}
+
+	return &Localizer{
+		language: language,
+	}
+}
+
+func (l *Localizer) Language() enums.Language {
+	return l.language
+}
+
+func (l *Localizer) Get(key Key) string {
+	return l.get(key).other
+}
+
+func (l *Localizer) Getf(key Key, args ...interface{}) string {
+	return fmt.Sprintf(l.Get(key), args...)
+}
+
+// Plural returns the text with the number n in the plural form for the number.
+func (l *Localizer) Plural(key Key, n int) string {
+	msg := l.get(key)
+
+	var form string
+	switch pluralForm(l.language, n) {
+	case formOne:
+		form = msg.one
+	case formFew:
+		form = msg.few
+	case formMany:
+		form = msg.many
+	}
+	if form == "" {
+		form = msg.other
+	}
+
+	return fmt.Sprintf(form, n)
+}
+
+func (l *Localizer) get(key Key) message {
+	if msg, ok := catalogue[l.language][key]; ok {
+		return msg
+	}
+	if msg, ok := catalogue[enums.DefaultLanguage][key]; ok {
+		return msg
+	}
+
+	return message{other: string(key)}
+}
+
+// LanguageName returns the name of the language in the language itself.
+func LanguageName(language enums.Language) string {
+	return languageNames[language]
+}
+
+// ParseLanguageName returns the language by its name or code.
+func ParseLanguageName(name string) (enums.Language, bool) {
+	for language, languageName := range languageNames {
+		if languageName == name {
+			return language, true
+		}
+	}
+
+	return enums.ParseLanguage(name)
+}
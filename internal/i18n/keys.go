@@ -0,0 +1,153 @@
+package i18n
+
+// Keys of the messages of the bot.
+const (
+	KeyInternalError   Key = "internal_error"
+	KeyTooManyRequests Key = "too_many_requests"
+	KeyIncorrectFormat Key = "incorrect_format"
+	KeySentAsDocument  Key = "sent_as_document"
+	KeyBusy            Key = "busy"
+
+	KeyHelpTitle        Key = "help_title"
+	KeyHelpCommands     Key = "help_commands"
+	KeyIncorrectContext Key = "incorrect_context"
+
+	KeyAddResponse        Key = "add_response"
+	KeySuccessfulAddWaste Key = "successful_add_waste"
+	KeyWarningLimit       Key = "warning_limit"
+	KeyLimitExceeded      Key = "limit_exceeded"
+
+	KeySetLimitResponse   Key = "set_limit_response"
+	KeySuccessfulSetLimit Key = "successful_set_limit"
+	KeyGetLimit           Key = "get_limit"
+	KeyNullLimit          Key = "null_limit"
+
+	KeyChooseCurrency           Key = "choose_currency"
+	KeySuccessfulChangeCurrency Key = "successful_change_currency"
+	KeyCurrencyNotSupported     Key = "currency_not_supported"
+	KeyStaleRates               Key = "stale_rates"
+
+	KeyRatesHeader      Key = "rates_header"
+	KeyRatesUpdatedAt   Key = "rates_updated_at"
+	KeyRatesNotReceived Key = "rates_not_received"
+	KeyConvertResponse  Key = "convert_response"
+	KeyUnknownCurrency  Key = "unknown_currency"
+	KeyRateNotAvailable Key = "rate_not_available"
+	KeyOtherCurrency    Key = "other_currency"
+
+	KeyFindUsage    Key = "find_usage"
+	KeyFindNothing  Key = "find_nothing"
+	KeyFindTotal    Key = "find_total"
+	KeyFindPage     Key = "find_page"
+	KeyFindPrevious Key = "find_previous"
+	KeyFindNext     Key = "find_next"
+
+	KeyClaimsUsage            Key = "claims_usage"
+	KeyClaimsNothing          Key = "claims_nothing"
+	KeyClaimsPending          Key = "claims_pending"
+	KeyClaimsClaimed          Key = "claims_claimed"
+	KeyClaimsMore             Key = "claims_more"
+	KeyClaimsReportHint       Key = "claims_report_hint"
+	KeyClaimsMarkClaimed      Key = "claims_mark_claimed"
+	KeyClaimsMarkReimbursed   Key = "claims_mark_reimbursed"
+	KeyClaimsMarkedClaimed    Key = "claims_marked_claimed"
+	KeyClaimsMarkedReimbursed Key = "claims_marked_reimbursed"
+
+	KeySplitResponse     Key = "split_response"
+	KeySplitUnknownUser  Key = "split_unknown_user"
+	KeySplitYourself     Key = "split_yourself"
+	KeySplitExceeded     Key = "split_exceeded"
+	KeySplitSuccess      Key = "split_success"
+	KeySplitNotNotified  Key = "split_not_notified"
+	KeySplitNotification Key = "split_notification"
+
+	KeySettleUsage        Key = "settle_usage"
+	KeySettleNothing      Key = "settle_nothing"
+	KeySettleBalances     Key = "settle_balances"
+	KeySettleOwesYou      Key = "settle_owes_you"
+	KeySettleYouOwe       Key = "settle_you_owe"
+	KeySettleButton       Key = "settle_button"
+	KeySettleNoDebts      Key = "settle_no_debts"
+	KeySettleOnlyCreditor Key = "settle_only_creditor"
+	KeySettleSuccess      Key = "settle_success"
+	KeySettleNotification Key = "settle_notification"
+
+	KeyGoalUsage          Key = "goal_usage"
+	KeyGoalsHeader        Key = "goals_header"
+	KeyGoalCreated        Key = "goal_created"
+	KeyGoalExists         Key = "goal_exists"
+	KeyGoalNotFound       Key = "goal_not_found"
+	KeyGoalDeadlinePassed Key = "goal_deadline_passed"
+	KeyGoalContributed    Key = "goal_contributed"
+	KeyGoalDeleted        Key = "goal_deleted"
+	KeyGoalIncomeSet      Key = "goal_income_set"
+	KeyGoalIncome         Key = "goal_income"
+	KeyGoalNoIncome       Key = "goal_no_income"
+	KeyGoalMonthly        Key = "goal_monthly"
+	KeyGoalReached        Key = "goal_reached"
+	KeyGoalOverdue        Key = "goal_overdue"
+
+	KeyGoalNudgeHeader   Key = "goal_nudge_header"
+	KeyGoalNudgeGoal     Key = "goal_nudge_goal"
+	KeyGoalNudgeFree     Key = "goal_nudge_free"
+	KeyGoalNudgeEnough   Key = "goal_nudge_enough"
+	KeyGoalNudgeShortage Key = "goal_nudge_shortage"
+
+	KeyChooseLanguage           Key = "choose_language"
+	KeySuccessfulChangeLanguage Key = "successful_change_language"
+
+	KeyToken      Key = "token"
+	KeyTokenUsage Key = "token_usage"
+
+	KeyGeneratingReport Key = "generating_report"
+	KeyNoPendingReports Key = "no_pending_reports"
+	KeyPendingReports   Key = "pending_reports"
+	KeyPendingReport    Key = "pending_report"
+	KeyMinutesAgo       Key = "minutes_ago"
+	KeyHoursAgo         Key = "hours_ago"
+	KeyDaysAgo          Key = "days_ago"
+
+	KeyReportStatusQueued     Key = "report_status_queued"
+	KeyReportStatusProcessing Key = "report_status_processing"
+	KeyReportStatusFailed     Key = "report_status_failed"
+
+	KeyPeriodWeek      Key = "period_week"
+	KeyPeriodMonth     Key = "period_month"
+	KeyPeriodYear      Key = "period_year"
+	KeyPeriodLastWeek  Key = "period_last_week"
+	KeyPeriodLastMonth Key = "period_last_month"
+	KeyPeriodLastYear  Key = "period_last_year"
+	KeyPeriodClaims    Key = "period_claims"
+
+	KeyReportFailed Key = "report_failed"
+	KeyReportSlow   Key = "report_slow"
+
+	KeyReportHeader   Key = "report_header"
+	KeyWasteNotFound  Key = "waste_not_found"
+	KeyReportCategory Key = "report_category"
+	KeyReportSpent    Key = "report_spent"
+	KeyReportTotal    Key = "report_total"
+
+	KeyReportByCurrency Key = "report_by_currency"
+	KeyReportCurrency   Key = "report_currency"
+	KeyReportEntered    Key = "report_entered"
+	KeyReportConverted  Key = "report_converted"
+
+	KeyReportTagHeader  Key = "report_tag_header"
+	KeyReportTagsHeader Key = "report_tags_header"
+	KeyReportTag        Key = "report_tag"
+	KeyReportTagsNote   Key = "report_tags_note"
+
+	KeyClaimsNotFound        Key = "claims_not_found"
+	KeyClaimReportCaption    Key = "claim_report_caption"
+	KeyClaimColumnDate       Key = "claim_column_date"
+	KeyClaimColumnCategory   Key = "claim_column_category"
+	KeyClaimColumnNote       Key = "claim_column_note"
+	KeyClaimColumnAmount     Key = "claim_column_amount"
+	KeyClaimColumnEntered    Key = "claim_column_entered"
+	KeyClaimColumnCurrency   Key = "claim_column_currency"
+	KeyClaimColumnStatus     Key = "claim_column_status"
+	KeyClaimStatusPending    Key = "claim_status_pending"
+	KeyClaimStatusClaimed    Key = "claim_status_claimed"
+	KeyClaimStatusReimbursed Key = "claim_status_reimbursed"
+)
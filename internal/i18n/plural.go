@@ -0,0 +1,37 @@
+package i18n
+
+import "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+
+type form int
+
+const (
+	formOther form = iota
+	formOne
+	formFew
+	formMany
+)
+
+// pluralForm chooses the plural form by the rules of CLDR for integers.
+func pluralForm(language enums.Language, n int) form {
+	if n < 0 {
+		n = -n
+	}
+
+	switch language {
+	case enums.LanguageRussian:
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return formOne
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return formFew
+		default:
+			return formMany
+		}
+
+	default:
+		if n == 1 {
+			return formOne
+		}
+		return formOther
+	}
+}
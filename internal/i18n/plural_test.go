@@ -0,0 +1,74 @@
+package i18n
+
+import (
+	"testing"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+func TestPluralForm(t *testing.T) {
+	tests := []struct {
+		language enums.Language
+		n        int
+		want     form
+	}{
+		{language: enums.LanguageRussian, n: 0, want: formMany},
+		{language: enums.LanguageRussian, n: 1, want: formOne},
+		{language: enums.LanguageRussian, n: 2, want: formFew},
+		{language: enums.LanguageRussian, n: 4, want: formFew},
+		{language: enums.LanguageRussian, n: 5, want: formMany},
+		{language: enums.LanguageRussian, n: 11, want: formMany},
+		{language: enums.LanguageRussian, n: 12, want: formMany},
+		{language: enums.LanguageRussian, n: 14, want: formMany},
+		{language: enums.LanguageRussian, n: 21, want: formOne},
+		{language: enums.LanguageRussian, n: 22, want: formFew},
+		{language: enums.LanguageRussian, n: 111, want: formMany},
+		{language: enums.LanguageRussian, n: 112, want: formMany},
+		{language: enums.LanguageRussian, n: 101, want: formOne},
+		{language: enums.LanguageRussian, n: -3, want: formFew},
+		{language: enums.LanguageEnglish, n: 0, want: formOther},
+		{language: enums.LanguageEnglish, n: 1, want: formOne},
+		{language: enums.LanguageEnglish, n: 2, want: formOther},
+		{language: enums.LanguageEnglish, n: 21, want: formOther},
+		{language: enums.LanguageEnglish, n: -1, want: formOne},
+	}
+
+	for _, tt := range tests {
+		if got := pluralForm(tt.language, tt.n); got != tt.want {
+			t.Errorf("pluralForm(%s, %d) = %d, want %d", tt.language, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestPlural(t *testing.T) {
+	tests := []struct {
+		language enums.Language
+		n        int
+		want     string
+	}{
+		{language: enums.LanguageRussian, n: 1, want: "1 день"},
+		{language: enums.LanguageRussian, n: 3, want: "3 дня"},
+		{language: enums.LanguageRussian, n: 11, want: "11 дней"},
+		{language: enums.LanguageRussian, n: 25, want: "25 дней"},
+		{language: enums.LanguageEnglish, n: 1, want: "1 day"},
+		{language: enums.LanguageEnglish, n: 3, want: "3 days"},
+		// the unknown language falls back to the default one
+		{language: "de", n: 2, want: "2 дня"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := New(tt.language).Plural(KeyDaysAgo, tt.n); got != tt.want {
+				t.Errorf("Plural(KeyDaysAgo, %d) = %q, want %q", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPluralFallsBackToOther(t *testing.T) {
+	// the message without plural forms, like the missing one, is used for every number
+	localizer := New(enums.LanguageRussian)
+	if got, want := localizer.Plural(Key("missing_%d"), 5), "missing_5"; got != want {
+		t.Errorf("Plural() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,221 @@
+package i18n
+
+var russianMessages = map[Key]message{
+	KeyInternalError:  {other: "Внутренняя ошибка"},
+	KeySentAsDocument: {other: "Сообщение слишком длинное, поэтому оно отправлено файлом"},
+	KeyTooManyRequests: {
+		one:  "Слишком много запросов, попробуйте еще раз через %d секунду",
+		few:  "Слишком много запросов, попробуйте еще раз через %d секунды",
+		many: "Слишком много запросов, попробуйте еще раз через %d секунд",
+	},
+	KeyIncorrectFormat: {other: "Неправильный формат"},
+	KeyBusy:            {other: "Бот перегружен, сообщение не обработано, попробуйте еще раз через несколько секунд"},
+
+	KeyHelpTitle: {other: "Данный бот предназначен для ведения трат по категориям"},
+	KeyHelpCommands: {other: `/add - для добавления новой траты
+/setLimit - установить лимит на месяц
+/getLimit - узнать текущий лимит на месяц
+/week - отчет по тратам за последнюю неделю
+/month - отчет по тратам за последний месяц
+/year - отчет по тратам за последний год
+(после команды отчета можно указать валюту и тег, например /week EUR #отпуск, или сгруппировать траты по тегам: /week by:tag)
+/currency - сменить валюту
+/language - сменить язык
+/rates - курсы валют к выбранной валюте
+/convert - перевести сумму из одной валюты в другую, например /convert 50 USD EUR
+/find - поиск трат по категории, сумме и дате, например /find такси from:03.2024
+/claims - возмещаемые траты и документ для их подачи
+/split - разделить трату с другими пользователями
+/settle - балансы долгов и подтверждение их возврата
+/goal - цели накоплений и прогресс
+/status - статус запрошенных отчетов
+/token - получить токен для доступа к API`},
+	KeyIncorrectContext: {other: "Неизвестное состояние пользователя, состояние сброшено до стандартного"},
+
+	KeyAddResponse: {other: `Для добавления траты введите сообщение в формате:
+
+<Название категории>
+<Сумма траты, например 1 500,50, 120+80 или 12 USD для траты в другой валюте>
+<Дата траты в формате DD.MM.YYYY> (необязательно)
+<Заметка и теги, например обед с коллегами #работа> (необязательно)
+
+Чтобы отметить трату как возмещаемую, добавьте !claim в заметку`},
+	KeySuccessfulAddWaste: {other: "Трата успешно добавлена"},
+	KeyWarningLimit:       {other: "До превышения лимита за текущий месяц осталось: %s"},
+	KeyLimitExceeded:      {other: "Лимит на текущий месяц превышен на %s"},
+
+	KeySetLimitResponse:   {other: "Введите желаемый лимит в текущей валюте, например 15 000 или 15000,50"},
+	KeySuccessfulSetLimit: {other: "Лимит трат за месяц успешно установлен"},
+	KeyGetLimit:           {other: "Текущий лимит на месяц: %s"},
+	KeyNullLimit:          {other: "Лимит на месяц не установлен"},
+
+	KeyChooseCurrency:           {other: "Выберите валюту из предложенных на клавиатуре или отправьте код другой валюты по ISO 4217, например GBP"},
+	KeySuccessfulChangeCurrency: {other: "Валюта успешно изменена на %s"},
+	KeyCurrencyNotSupported:     {other: "Курс валюты %s недоступен, выберите другую валюту"},
+	KeyStaleRates:               {other: "Курсы валют обновлялись %s назад и могут быть неактуальны"},
+
+	KeyRatesHeader:      {other: "Курсы валют к %s:"},
+	KeyRatesUpdatedAt:   {other: "Обновлено %s"},
+	KeyRatesNotReceived: {other: "Курсы валют еще не получены, попробуйте позже"},
+	KeyConvertResponse: {other: `Введите сумму и валюты через пробел, например 50 USD EUR.
+Если вторая валюта не указана, сумма переводится в выбранную валюту`},
+	KeyUnknownCurrency:  {other: "Неизвестная валюта %s, используйте код по ISO 4217, например USD"},
+	KeyRateNotAvailable: {other: "Курс валюты %s недоступен"},
+	KeyOtherCurrency:    {other: "Сумма должна быть в выбранной валюте %s, сменить валюту можно командой /currency"},
+
+	KeyFindUsage: {other: `Введите запрос после команды, например /find такси from:03.2024 to:03.2024
+
+Слова без префиксов ищутся в названии категории и заметке, также можно указать:
+cat:<категория> - точное название категории
+#<тег> - траты с тегом
+from:<дата> и to:<дата> - период в формате DD.MM.YYYY или MM.YYYY
+min:<сумма> и max:<сумма> - диапазон сумм в выбранной валюте
+page:<номер> - номер страницы результатов`},
+	KeyFindNothing:  {other: "По запросу ничего не найдено"},
+	KeyFindTotal:    {other: "Найдено трат: %d на сумму %s"},
+	KeyFindPage:     {other: "Страница %d из %d"},
+	KeyFindPrevious: {other: "« Назад"},
+	KeyFindNext:     {other: "Далее »"},
+
+	KeyClaimsUsage: {other: `Команды возмещаемых трат:
+/claims - траты, ожидающие подачи и возмещения
+/claims report from:<дата> to:<дата> - документ с возмещаемыми тратами за период, дата в формате DD.MM.YYYY или MM.YYYY, по умолчанию период заканчивается сегодня
+
+Чтобы отметить трату как возмещаемую, добавьте !claim в заметку при добавлении траты`},
+	KeyClaimsNothing:          {other: "Нет трат, ожидающих возмещения. Чтобы отметить трату как возмещаемую, добавьте !claim в заметку при добавлении траты"},
+	KeyClaimsPending:          {other: "Ожидают подачи: %d на сумму %s"},
+	KeyClaimsClaimed:          {other: "Поданы и ожидают возмещения: %d на сумму %s"},
+	KeyClaimsMore:             {other: "и еще %d"},
+	KeyClaimsReportHint:       {other: "Документ для подачи: /claims report from:01.03.2024 to:31.03.2024"},
+	KeyClaimsMarkClaimed:      {other: "Подана: %s %s"},
+	KeyClaimsMarkReimbursed:   {other: "Возмещена: %s %s"},
+	KeyClaimsMarkedClaimed:    {other: "Отмечено как поданные: %d"},
+	KeyClaimsMarkedReimbursed: {other: "Отмечено как возмещенные: %d"},
+
+	KeySplitResponse: {other: `Для разделения траты введите сообщение в формате:
+
+<Название категории>
+<Сумма, которую вы заплатили, например 3 000>
+<Участники, например @anna @bob или с суммами @anna:500 @bob>
+<Дата траты в формате DD.MM.YYYY> (необязательно)
+<Заметка и теги> (необязательно)
+
+Участники без суммы делят остаток поровну с вами, в ваши траты добавляется только ваша доля`},
+	KeySplitUnknownUser:  {other: "Пользователь @%s не найден, он должен хотя бы раз написать боту"},
+	KeySplitYourself:     {other: "Нельзя указать себя участником, ваша доля считается автоматически"},
+	KeySplitExceeded:     {other: "Доли участников превышают сумму траты"},
+	KeySplitSuccess:      {other: "Трата разделена, ваша доля: %s. Долги участников:"},
+	KeySplitNotNotified:  {other: "Не удалось отправить уведомление: %s"},
+	KeySplitNotification: {other: "%s разделил(а) трату «%s», ваша доля: %s\nБаланс долгов: /settle"},
+
+	KeySettleUsage: {other: `/settle - балансы долгов с другими пользователями
+/settle @username - подтвердить, что пользователь вернул долг`},
+	KeySettleNothing:      {other: "Нет непогашенных долгов"},
+	KeySettleBalances:     {other: "Непогашенные долги:"},
+	KeySettleOwesYou:      {other: "%s должен(на) вам %s"},
+	KeySettleYouOwe:       {other: "Вы должны %s %s"},
+	KeySettleButton:       {other: "%s вернул(а) долг"},
+	KeySettleNoDebts:      {other: "Нет непогашенных долгов с %s"},
+	KeySettleOnlyCreditor: {other: "Вы должны %s, расчет подтверждает тот, кому вернули долг"},
+	KeySettleSuccess:      {other: "Долги с %s погашены на сумму %s"},
+	KeySettleNotification: {other: "%s подтвердил(а), что вы вернули долг %s"},
+
+	KeyGoalUsage: {other: `Цели накоплений:
+/goal - цели и прогресс
+/goal new <название> <сумма> <дата DD.MM.YYYY> - создать цель, например /goal new Отпуск 100000 01.06.2027
+/goal add <название> <сумма> - отложить деньги на цель
+/goal income <сумма> - доход в месяц для расчета, хватает ли денег на цели
+/goal delete <название> - удалить цель
+
+Суммы указываются в выбранной валюте`},
+	KeyGoalsHeader:        {other: "Цели накоплений:"},
+	KeyGoalCreated:        {other: "Цель «%s» создана"},
+	KeyGoalExists:         {other: "Цель «%s» уже существует"},
+	KeyGoalNotFound:       {other: "Цель «%s» не найдена"},
+	KeyGoalDeadlinePassed: {other: "Срок цели должен быть в будущем"},
+	KeyGoalContributed:    {other: "Отложено %s на цель «%s»"},
+	KeyGoalDeleted:        {other: "Цель «%s» удалена"},
+	KeyGoalIncomeSet:      {other: "Доход в месяц сохранен: %s"},
+	KeyGoalIncome:         {other: "Доход в месяц: %s"},
+	KeyGoalNoIncome:       {other: "Укажите доход в месяц командой /goal income <сумма>, чтобы узнать, хватает ли денег на цели"},
+	KeyGoalMonthly:        {other: "Нужно откладывать %s в месяц до %s"},
+	KeyGoalReached:        {other: "Цель достигнута"},
+	KeyGoalOverdue:        {other: "Срок цели %s прошел"},
+
+	KeyGoalNudgeHeader:   {other: "Цели накоплений на этот месяц:"},
+	KeyGoalNudgeGoal:     {other: "%s: %s %d%%, отложить %s до %s"},
+	KeyGoalNudgeFree:     {other: "Доход минус траты за прошлый месяц: %s, нужно отложить на все цели: %s"},
+	KeyGoalNudgeEnough:   {other: "Свободных денег хватает на все цели"},
+	KeyGoalNudgeShortage: {other: "Не хватает %s в месяц, попробуйте сократить траты или перенести сроки целей"},
+
+	KeyChooseLanguage:           {other: "Выберите язык из предложенных на клавиатуре"},
+	KeySuccessfulChangeLanguage: {other: "Язык успешно изменен на русский"},
+
+	KeyToken: {other: "Токен для доступа к API: "},
+	KeyTokenUsage: {other: "Предыдущий токен больше не действует. " +
+		"Передавайте токен в заголовке Authorization: Bearer <токен>"},
+
+	KeyGeneratingReport: {other: "Отчет генерируется..."},
+	KeyNoPendingReports: {other: "Нет отчетов в обработке"},
+	KeyPendingReports:   {other: "Запрошенные отчеты:"},
+	KeyPendingReport:    {other: "Отчет %s: %s (запрошен %s назад)"},
+	KeyMinutesAgo: {
+		one:  "%d минуту",
+		few:  "%d минуты",
+		many: "%d минут",
+	},
+	KeyHoursAgo: {
+		one:  "%d час",
+		few:  "%d часа",
+		many: "%d часов",
+	},
+	KeyDaysAgo: {
+		one:  "%d день",
+		few:  "%d дня",
+		many: "%d дней",
+	},
+
+	KeyReportStatusQueued:     {other: "в очереди"},
+	KeyReportStatusProcessing: {other: "формируется"},
+	KeyReportStatusFailed:     {other: "не удалось сформировать"},
+
+	KeyPeriodWeek:      {other: "за неделю"},
+	KeyPeriodMonth:     {other: "за месяц"},
+	KeyPeriodYear:      {other: "за год"},
+	KeyPeriodLastWeek:  {other: "за последнюю неделю"},
+	KeyPeriodLastMonth: {other: "за последний месяц"},
+	KeyPeriodLastYear:  {other: "за последний год"},
+	KeyPeriodClaims:    {other: "по возмещаемым тратам"},
+
+	KeyReportFailed: {other: "Не удалось сформировать отчет %s, попробуйте запросить его позже"},
+	KeyReportSlow:   {other: "Отчет %s формируется дольше обычного, он будет отправлен как только будет готов"},
+
+	KeyReportHeader:   {other: "Отчет по тратам %s:"},
+	KeyWasteNotFound:  {other: "Траты за указанный период не найдены"},
+	KeyReportCategory: {other: "КАТЕГОРИЯ"},
+	KeyReportSpent:    {other: "ПОТРАЧЕНО"},
+	KeyReportTotal:    {other: "СУММА"},
+
+	KeyReportByCurrency: {other: "Траты по валютам ввода:"},
+	KeyReportCurrency:   {other: "ВАЛЮТА"},
+	KeyReportEntered:    {other: "ВВЕДЕНО"},
+	KeyReportConverted:  {other: "В ВАЛЮТЕ ОТЧЕТА"},
+
+	KeyReportTagHeader:  {other: "Отчет по тратам с тегом #%s %s:"},
+	KeyReportTagsHeader: {other: "Отчет по тегам %s:"},
+	KeyReportTag:        {other: "ТЕГ"},
+	KeyReportTagsNote:   {other: "Траты с несколькими тегами учтены в каждом из них"},
+
+	KeyClaimsNotFound:        {other: "За период не найдено возмещаемых трат"},
+	KeyClaimReportCaption:    {other: "Возмещаемые траты с %s по %s на сумму %s"},
+	KeyClaimColumnDate:       {other: "Дата"},
+	KeyClaimColumnCategory:   {other: "Категория"},
+	KeyClaimColumnNote:       {other: "Заметка"},
+	KeyClaimColumnAmount:     {other: "Сумма, %s"},
+	KeyClaimColumnEntered:    {other: "Введенная сумма"},
+	KeyClaimColumnCurrency:   {other: "Валюта"},
+	KeyClaimColumnStatus:     {other: "Статус"},
+	KeyClaimStatusPending:    {other: "ожидает подачи"},
+	KeyClaimStatusClaimed:    {other: "подана"},
+	KeyClaimStatusReimbursed: {other: "возмещена"},
+}
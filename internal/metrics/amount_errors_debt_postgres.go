@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+//go:generate mockery --name=debtRepository --dir . --output ./mocks --exported
+type debtRepository interface {
+	AddSplitWaste(ctx context.Context, userID int64, waste *models.Waste, debts []*models.Debt) error
+	GetBalances(ctx context.Context, userID int64) ([]*models.Balance, error)
+	SettleDebts(ctx context.Context, debtIDs []uuid.UUID) (int, error)
+}
+
+type DebtRepositoryAmountErrorsDecorator struct {
+	debtRepo    debtRepository
+	countErrors *prometheus.CounterVec
+}
+
+func NewDebtRepositoryAmountErrorsDecorator(debtRepo debtRepository) *DebtRepositoryAmountErrorsDecorator {
+	return &DebtRepositoryAmountErrorsDecorator{
+		debtRepo: debtRepo,
+		countErrors: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "count_errors_debt_repository",
+			Help: "Count of errors in DebtRepository methods",
+		}, []string{"method"}),
+	}
+}
+
+func (d *DebtRepositoryAmountErrorsDecorator) AddSplitWaste(ctx context.Context, userID int64, waste *models.Waste, debts []*models.Debt) error {
+	err := d.debtRepo.AddSplitWaste(ctx, userID, waste, debts)
+	if err != nil {
+		d.countErrors.WithLabelValues("AddSplitWaste").Inc()
+	}
+	return err
+}
+
+func (d *DebtRepositoryAmountErrorsDecorator) GetBalances(ctx context.Context, userID int64) ([]*models.Balance, error) {
+	res, err := d.debtRepo.GetBalances(ctx, userID)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetBalances").Inc()
+	}
+	return res, err
+}
+
+func (d *DebtRepositoryAmountErrorsDecorator) SettleDebts(ctx context.Context, debtIDs []uuid.UUID) (int, error) {
+	res, err := d.debtRepo.SettleDebts(ctx, debtIDs)
+	if err != nil {
+		d.countErrors.WithLabelValues("SettleDebts").Inc()
+	}
+	return res, err
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+)
+
+//go:generate mockery --name=exchangeRateRepository --dir . --output ./mocks --exported
+type exchangeRateRepository interface {
+	SaveRates(ctx context.Context, base string, rates map[string]money.Rate, updatedAt time.Time) error
+	GetRates(ctx context.Context, base string) (*models.ExchangeRates, error)
+}
+
+type ExchangeRateRepositoryAmountErrorsDecorator struct {
+	exchangeRateRepo exchangeRateRepository
+	countErrors      *prometheus.CounterVec
+}
+
+func NewExchangeRateRepositoryAmountErrorsDecorator(
+	exchangeRateRepo exchangeRateRepository,
+) *ExchangeRateRepositoryAmountErrorsDecorator {
+	return &ExchangeRateRepositoryAmountErrorsDecorator{
+		exchangeRateRepo: exchangeRateRepo,
+		countErrors: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "count_errors_exchange_rate_repository",
+			Help: "Count of errors in ExchangeRateRepository methods",
+		}, []string{"method"}),
+	}
+}
+
+func (d *ExchangeRateRepositoryAmountErrorsDecorator) SaveRates(
+	ctx context.Context,
+	base string,
+	rates map[string]money.Rate,
+	updatedAt time.Time,
+) error {
+	err := d.exchangeRateRepo.SaveRates(ctx, base, rates, updatedAt)
+	if err != nil {
+		d.countErrors.WithLabelValues("SaveRates").Inc()
+	}
+	return err
+}
+
+func (d *ExchangeRateRepositoryAmountErrorsDecorator) GetRates(ctx context.Context, base string) (*models.ExchangeRates, error) {
+	res, err := d.exchangeRateRepo.GetRates(ctx, base)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetRates").Inc()
+	}
+	return res, err
+}
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+//go:generate mockery --name=goalRepository --dir . --output ./mocks --exported
+type goalRepository interface {
+	AddGoal(ctx context.Context, goal *models.Goal) (*models.Goal, error)
+	GetGoals(ctx context.Context, userID int64) ([]*models.Goal, error)
+	GetGoalByName(ctx context.Context, userID int64, name string) (*models.Goal, error)
+	AddToGoal(ctx context.Context, userID int64, id int, amount int64) (*models.Goal, error)
+	DeleteGoal(ctx context.Context, userID int64, id int) error
+	GetActiveGoals(ctx context.Context, after time.Time, month string) ([]*models.Goal, error)
+	ClaimNudge(ctx context.Context, userID int64, month string) (bool, error)
+	ReleaseNudge(ctx context.Context, userID int64, month string) error
+}
+
+type GoalRepositoryAmountErrorsDecorator struct {
+	goalRepo    goalRepository
+	countErrors *prometheus.CounterVec
+}
+
+func NewGoalRepositoryAmountErrorsDecorator(goalRepo goalRepository) *GoalRepositoryAmountErrorsDecorator {
+	return &GoalRepositoryAmountErrorsDecorator{
+		goalRepo: goalRepo,
+		countErrors: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "count_errors_goal_repository",
+			Help: "Count of errors in GoalRepository methods",
+		}, []string{"method"}),
+	}
+}
+
+func (d *GoalRepositoryAmountErrorsDecorator) AddGoal(ctx context.Context, goal *models.Goal) (*models.Goal, error) {
+	res, err := d.goalRepo.AddGoal(ctx, goal)
+	if err != nil {
+		d.countErrors.WithLabelValues("AddGoal").Inc()
+	}
+	return res, err
+}
+
+func (d *GoalRepositoryAmountErrorsDecorator) GetGoals(ctx context.Context, userID int64) ([]*models.Goal, error) {
+	res, err := d.goalRepo.GetGoals(ctx, userID)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetGoals").Inc()
+	}
+	return res, err
+}
+
+func (d *GoalRepositoryAmountErrorsDecorator) GetGoalByName(ctx context.Context, userID int64, name string) (*models.Goal, error) {
+	res, err := d.goalRepo.GetGoalByName(ctx, userID, name)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetGoalByName").Inc()
+	}
+	return res, err
+}
+
+func (d *GoalRepositoryAmountErrorsDecorator) AddToGoal(ctx context.Context, userID int64, id int, amount int64) (*models.Goal, error) {
+	res, err := d.goalRepo.AddToGoal(ctx, userID, id, amount)
+	if err != nil {
+		d.countErrors.WithLabelValues("AddToGoal").Inc()
+	}
+	return res, err
+}
+
+func (d *GoalRepositoryAmountErrorsDecorator) DeleteGoal(ctx context.Context, userID int64, id int) error {
+	err := d.goalRepo.DeleteGoal(ctx, userID, id)
+	if err != nil {
+		d.countErrors.WithLabelValues("DeleteGoal").Inc()
+	}
+	return err
+}
+
+func (d *GoalRepositoryAmountErrorsDecorator) GetActiveGoals(ctx context.Context, after time.Time, month string) ([]*models.Goal, error) {
+	res, err := d.goalRepo.GetActiveGoals(ctx, after, month)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetActiveGoals").Inc()
+	}
+	return res, err
+}
+
+func (d *GoalRepositoryAmountErrorsDecorator) ClaimNudge(ctx context.Context, userID int64, month string) (bool, error) {
+	res, err := d.goalRepo.ClaimNudge(ctx, userID, month)
+	if err != nil {
+		d.countErrors.WithLabelValues("ClaimNudge").Inc()
+	}
+	return res, err
+}
+
+func (d *GoalRepositoryAmountErrorsDecorator) ReleaseNudge(ctx context.Context, userID int64, month string) error {
+	err := d.goalRepo.ReleaseNudge(ctx, userID, month)
+	if err != nil {
+		d.countErrors.WithLabelValues("ReleaseNudge").Inc()
+	}
+	return err
+}
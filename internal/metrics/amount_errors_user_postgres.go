@@ -6,15 +6,29 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 //go:generate mockery --name=userRepository --dir . --output ./mocks --exported
 type userRepository interface {
 	UserExists(ctx context.Context, id int64) (bool, error)
 	AddUser(ctx context.Context, user *models.User) (*models.User, error)
+	UpdateNames(ctx context.Context, user *models.User) error
 
 	SetWasteLimit(ctx context.Context, id int64, limit uint64) (*models.User, error)
 	GetWasteLimit(ctx context.Context, id int64) (*uint64, error)
+	ClearWasteLimit(ctx context.Context, id int64) (*models.User, error)
+
+	GetLanguage(ctx context.Context, id int64) (enums.Language, error)
+	SetLanguage(ctx context.Context, id int64, language enums.Language) error
+	GetCurrencies(ctx context.Context, id int64) ([]string, error)
+	SetCurrencies(ctx context.Context, id int64, currencies []string) error
+	GetUsedCurrencies(ctx context.Context) ([]string, error)
+	SetAPITokenHash(ctx context.Context, id int64, tokenHash string) error
+	GetUserIDByAPITokenHash(ctx context.Context, tokenHash string) (int64, error)
+	GetUserByUserName(ctx context.Context, userName string) (*models.User, error)
+	SetIncome(ctx context.Context, id int64, income int64) error
+	GetIncome(ctx context.Context, id int64) (*int64, error)
 }
 
 type UserRepositoryAmountErrorsDecorator struct {
@@ -48,6 +62,14 @@ func (d *UserRepositoryAmountErrorsDecorator) AddUser(ctx context.Context, user
 	return res, err
 }
 
+func (d *UserRepositoryAmountErrorsDecorator) UpdateNames(ctx context.Context, user *models.User) error {
+	err := d.userRepo.UpdateNames(ctx, user)
+	if err != nil {
+		d.countErrors.WithLabelValues("UpdateNames").Inc()
+	}
+	return err
+}
+
 func (d *UserRepositoryAmountErrorsDecorator) SetWasteLimit(ctx context.Context, id int64, limit uint64) (*models.User, error) {
 	res, err := d.userRepo.SetWasteLimit(ctx, id, limit)
 	if err != nil {
@@ -63,3 +85,91 @@ func (d *UserRepositoryAmountErrorsDecorator) GetWasteLimit(ctx context.Context,
 	}
 	return res, err
 }
+
+func (d *UserRepositoryAmountErrorsDecorator) ClearWasteLimit(ctx context.Context, id int64) (*models.User, error) {
+	res, err := d.userRepo.ClearWasteLimit(ctx, id)
+	if err != nil {
+		d.countErrors.WithLabelValues("ClearWasteLimit").Inc()
+	}
+	return res, err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) GetLanguage(ctx context.Context, id int64) (enums.Language, error) {
+	res, err := d.userRepo.GetLanguage(ctx, id)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetLanguage").Inc()
+	}
+	return res, err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) SetLanguage(ctx context.Context, id int64, language enums.Language) error {
+	err := d.userRepo.SetLanguage(ctx, id, language)
+	if err != nil {
+		d.countErrors.WithLabelValues("SetLanguage").Inc()
+	}
+	return err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) GetCurrencies(ctx context.Context, id int64) ([]string, error) {
+	res, err := d.userRepo.GetCurrencies(ctx, id)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetCurrencies").Inc()
+	}
+	return res, err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) SetCurrencies(ctx context.Context, id int64, currencies []string) error {
+	err := d.userRepo.SetCurrencies(ctx, id, currencies)
+	if err != nil {
+		d.countErrors.WithLabelValues("SetCurrencies").Inc()
+	}
+	return err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) GetUsedCurrencies(ctx context.Context) ([]string, error) {
+	res, err := d.userRepo.GetUsedCurrencies(ctx)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetUsedCurrencies").Inc()
+	}
+	return res, err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) SetAPITokenHash(ctx context.Context, id int64, tokenHash string) error {
+	err := d.userRepo.SetAPITokenHash(ctx, id, tokenHash)
+	if err != nil {
+		d.countErrors.WithLabelValues("SetAPITokenHash").Inc()
+	}
+	return err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) GetUserIDByAPITokenHash(ctx context.Context, tokenHash string) (int64, error) {
+	res, err := d.userRepo.GetUserIDByAPITokenHash(ctx, tokenHash)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetUserIDByAPITokenHash").Inc()
+	}
+	return res, err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) GetUserByUserName(ctx context.Context, userName string) (*models.User, error) {
+	res, err := d.userRepo.GetUserByUserName(ctx, userName)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetUserByUserName").Inc()
+	}
+	return res, err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) SetIncome(ctx context.Context, id int64, income int64) error {
+	err := d.userRepo.SetIncome(ctx, id, income)
+	if err != nil {
+		d.countErrors.WithLabelValues("SetIncome").Inc()
+	}
+	return err
+}
+
+func (d *UserRepositoryAmountErrorsDecorator) GetIncome(ctx context.Context, id int64) (*int64, error) {
+	res, err := d.userRepo.GetIncome(ctx, id)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetIncome").Inc()
+	}
+	return res, err
+}
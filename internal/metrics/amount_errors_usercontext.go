@@ -14,6 +14,8 @@ type userContextService interface {
 	GetContext(ctx context.Context, userID int64) (enums.UserContext, error)
 	SetCurrency(ctx context.Context, userID int64, currency string) error
 	GetCurrency(ctx context.Context, userID int64) (string, error)
+	SetSearchQuery(ctx context.Context, userID int64, query string) error
+	GetSearchQuery(ctx context.Context, userID int64) (string, error)
 }
 
 type UserContextServiceAmountErrorsDecorator struct {
@@ -62,3 +64,19 @@ func (d *UserContextServiceAmountErrorsDecorator) GetCurrency(ctx context.Contex
 	}
 	return res, err
 }
+
+func (d *UserContextServiceAmountErrorsDecorator) SetSearchQuery(ctx context.Context, userID int64, query string) error {
+	err := d.service.SetSearchQuery(ctx, userID, query)
+	if err != nil {
+		d.countErrors.WithLabelValues("SetSearchQuery").Inc()
+	}
+	return err
+}
+
+func (d *UserContextServiceAmountErrorsDecorator) GetSearchQuery(ctx context.Context, userID int64) (string, error) {
+	res, err := d.service.GetSearchQuery(ctx, userID)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetSearchQuery").Inc()
+	}
+	return res, err
+}
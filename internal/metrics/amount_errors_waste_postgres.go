@@ -4,9 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 //go:generate mockery --name=wasteRepository --dir . --output ./mocks --exported
@@ -14,9 +16,24 @@ type wasteRepository interface {
 	GetReportLastWeek(ctx context.Context, userID int64) ([]*models.CategoryReport, error)
 	GetReportLastMonth(ctx context.Context, userID int64) ([]*models.CategoryReport, error)
 	GetReportLastYear(ctx context.Context, userID int64) ([]*models.CategoryReport, error)
+	GetCurrencyReportLastWeek(ctx context.Context, userID int64) ([]*models.CurrencyReport, error)
+	GetCurrencyReportLastMonth(ctx context.Context, userID int64) ([]*models.CurrencyReport, error)
+	GetCurrencyReportLastYear(ctx context.Context, userID int64) ([]*models.CurrencyReport, error)
+	GetReportByTagLastWeek(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error)
+	GetReportByTagLastMonth(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error)
+	GetReportByTagLastYear(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error)
+	GetTagReportLastWeek(ctx context.Context, userID int64) ([]*models.TagReport, error)
+	GetTagReportLastMonth(ctx context.Context, userID int64) ([]*models.TagReport, error)
+	GetTagReportLastYear(ctx context.Context, userID int64) ([]*models.TagReport, error)
 	SumOfWastesAfterDate(ctx context.Context, userID int64, date time.Time) (int64, error)
+	GetReportBetweenDates(ctx context.Context, userID int64, from time.Time, to time.Time) ([]*models.CategoryReport, error)
 
+	ListWastes(ctx context.Context, userID int64, filter models.WasteFilter) ([]*models.Waste, int, error)
+	SumOfFilteredWastes(ctx context.Context, userID int64, filter models.WasteFilter) (int64, error)
 	AddWasteToUser(ctx context.Context, userID int64, waste *models.Waste) (*models.Waste, error)
+	UpdateWaste(ctx context.Context, userID int64, waste *models.Waste) (*models.Waste, error)
+	UpdateClaimStatus(ctx context.Context, userID int64, id uuid.UUID, from enums.ClaimStatus, to enums.ClaimStatus) (int, error)
+	DeleteWaste(ctx context.Context, userID int64, id uuid.UUID) error
 }
 
 type WasteRepositoryAmountErrorsDecorator struct {
@@ -58,6 +75,78 @@ func (d *WasteRepositoryAmountErrorsDecorator) GetReportLastYear(ctx context.Con
 	return res, err
 }
 
+func (d *WasteRepositoryAmountErrorsDecorator) GetCurrencyReportLastWeek(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	res, err := d.wasteRepo.GetCurrencyReportLastWeek(ctx, userID)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetCurrencyReportLastWeek").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) GetCurrencyReportLastMonth(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	res, err := d.wasteRepo.GetCurrencyReportLastMonth(ctx, userID)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetCurrencyReportLastMonth").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) GetCurrencyReportLastYear(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	res, err := d.wasteRepo.GetCurrencyReportLastYear(ctx, userID)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetCurrencyReportLastYear").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) GetReportByTagLastWeek(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	res, err := d.wasteRepo.GetReportByTagLastWeek(ctx, userID, tag)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetReportByTagLastWeek").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) GetReportByTagLastMonth(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	res, err := d.wasteRepo.GetReportByTagLastMonth(ctx, userID, tag)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetReportByTagLastMonth").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) GetReportByTagLastYear(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	res, err := d.wasteRepo.GetReportByTagLastYear(ctx, userID, tag)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetReportByTagLastYear").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) GetTagReportLastWeek(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	res, err := d.wasteRepo.GetTagReportLastWeek(ctx, userID)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetTagReportLastWeek").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) GetTagReportLastMonth(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	res, err := d.wasteRepo.GetTagReportLastMonth(ctx, userID)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetTagReportLastMonth").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) GetTagReportLastYear(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	res, err := d.wasteRepo.GetTagReportLastYear(ctx, userID)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetTagReportLastYear").Inc()
+	}
+	return res, err
+}
+
 func (d *WasteRepositoryAmountErrorsDecorator) SumOfWastesAfterDate(ctx context.Context, userID int64, date time.Time) (int64, error) {
 	res, err := d.wasteRepo.SumOfWastesAfterDate(ctx, userID, date)
 	if err != nil {
@@ -73,3 +162,51 @@ func (d *WasteRepositoryAmountErrorsDecorator) AddWasteToUser(ctx context.Contex
 	}
 	return res, err
 }
+
+func (d *WasteRepositoryAmountErrorsDecorator) GetReportBetweenDates(ctx context.Context, userID int64, from time.Time, to time.Time) ([]*models.CategoryReport, error) {
+	res, err := d.wasteRepo.GetReportBetweenDates(ctx, userID, from, to)
+	if err != nil {
+		d.countErrors.WithLabelValues("GetReportBetweenDates").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) ListWastes(ctx context.Context, userID int64, filter models.WasteFilter) ([]*models.Waste, int, error) {
+	res, total, err := d.wasteRepo.ListWastes(ctx, userID, filter)
+	if err != nil {
+		d.countErrors.WithLabelValues("ListWastes").Inc()
+	}
+	return res, total, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) SumOfFilteredWastes(ctx context.Context, userID int64, filter models.WasteFilter) (int64, error) {
+	res, err := d.wasteRepo.SumOfFilteredWastes(ctx, userID, filter)
+	if err != nil {
+		d.countErrors.WithLabelValues("SumOfFilteredWastes").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) UpdateWaste(ctx context.Context, userID int64, waste *models.Waste) (*models.Waste, error) {
+	res, err := d.wasteRepo.UpdateWaste(ctx, userID, waste)
+	if err != nil {
+		d.countErrors.WithLabelValues("UpdateWaste").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) UpdateClaimStatus(ctx context.Context, userID int64, id uuid.UUID, from enums.ClaimStatus, to enums.ClaimStatus) (int, error) {
+	res, err := d.wasteRepo.UpdateClaimStatus(ctx, userID, id, from, to)
+	if err != nil {
+		d.countErrors.WithLabelValues("UpdateClaimStatus").Inc()
+	}
+	return res, err
+}
+
+func (d *WasteRepositoryAmountErrorsDecorator) DeleteWaste(ctx context.Context, userID int64, id uuid.UUID) error {
+	err := d.wasteRepo.DeleteWaste(ctx, userID, id)
+	if err != nil {
+		d.countErrors.WithLabelValues("DeleteWaste").Inc()
+	}
+	return err
+}
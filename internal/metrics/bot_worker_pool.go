@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// BotWorkerPoolMetrics collects the state of the queues of the bot workers.
+type BotWorkerPoolMetrics struct {
+	queueDepth      *prometheus.GaugeVec
+	droppedMessages prometheus.Counter
+}
+
+func NewBotWorkerPoolMetrics() *BotWorkerPoolMetrics {
+	return &BotWorkerPoolMetrics{
+		queueDepth: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "bot_worker_queue_depth",
+			Help: "Amount of messages waiting in the queue of the bot worker",
+		}, []string{"worker"}),
+		droppedMessages: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "bot_dropped_messages_count",
+			Help: "Count of messages dropped because the queue of the bot worker is full",
+		}),
+	}
+}
+
+func (m *BotWorkerPoolMetrics) SetQueueDepth(worker int, depth int) {
+	m.queueDepth.WithLabelValues(strconv.Itoa(worker)).Set(float64(depth))
+}
+
+func (m *BotWorkerPoolMetrics) IncDropped() {
+	m.droppedMessages.Inc()
+}
@@ -0,0 +1,23 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type ratesAgeSource interface {
+	RatesAge() (time.Duration, bool)
+}
+
+// NewExchangeRatesAgeGauge exposes the time since the last successful update of exchange rates.
+func NewExchangeRatesAgeGauge(source ratesAgeSource) prometheus.GaugeFunc {
+	return promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "exchange_rates_age_seconds",
+		Help: "Time since the last successful update of exchange rates",
+	}, func() float64 {
+		age, _ := source.RatesAge()
+		return age.Seconds()
+	})
+}
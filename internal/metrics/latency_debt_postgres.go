@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+type DebtRepositoryLatencyDecorator struct {
+	debtRepo debtRepository
+	latency  *prometheus.HistogramVec
+}
+
+func NewDebtRepositoryLatencyDecorator(debtRepo debtRepository) *DebtRepositoryLatencyDecorator {
+	return &DebtRepositoryLatencyDecorator{
+		debtRepo: debtRepo,
+		latency: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "latency_debt_repository",
+			Help:    "Duration of DebtRepository methods",
+			Buckets: []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1.0, 2.0},
+		}, []string{"method"}),
+	}
+}
+
+func (d *DebtRepositoryLatencyDecorator) AddSplitWaste(ctx context.Context, userID int64, waste *models.Waste, debts []*models.Debt) error {
+	startTime := time.Now()
+	err := d.debtRepo.AddSplitWaste(ctx, userID, waste, debts)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("AddSplitWaste").Observe(duration.Seconds())
+
+	return err
+}
+
+func (d *DebtRepositoryLatencyDecorator) GetBalances(ctx context.Context, userID int64) ([]*models.Balance, error) {
+	startTime := time.Now()
+	res, err := d.debtRepo.GetBalances(ctx, userID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetBalances").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *DebtRepositoryLatencyDecorator) SettleDebts(ctx context.Context, debtIDs []uuid.UUID) (int, error) {
+	startTime := time.Now()
+	res, err := d.debtRepo.SettleDebts(ctx, debtIDs)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SettleDebts").Observe(duration.Seconds())
+
+	return res, err
+}
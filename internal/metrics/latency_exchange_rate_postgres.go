@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+)
+
+type ExchangeRateRepositoryLatencyDecorator struct {
+	exchangeRateRepo exchangeRateRepository
+	latency          *prometheus.HistogramVec
+}
+
+func NewExchangeRateRepositoryLatencyDecorator(
+	exchangeRateRepo exchangeRateRepository,
+) *ExchangeRateRepositoryLatencyDecorator {
+	return &ExchangeRateRepositoryLatencyDecorator{
+		exchangeRateRepo: exchangeRateRepo,
+		latency: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "latency_exchange_rate_repository",
+			Help:    "Duration of ExchangeRateRepository methods",
+			Buckets: []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1.0, 2.0},
+		}, []string{"method"}),
+	}
+}
+
+func (d *ExchangeRateRepositoryLatencyDecorator) SaveRates(
+	ctx context.Context,
+	base string,
+	rates map[string]money.Rate,
+	updatedAt time.Time,
+) error {
+	startTime := time.Now()
+	err := d.exchangeRateRepo.SaveRates(ctx, base, rates, updatedAt)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SaveRates").Observe(duration.Seconds())
+
+	return err
+}
+
+func (d *ExchangeRateRepositoryLatencyDecorator) GetRates(ctx context.Context, base string) (*models.ExchangeRates, error) {
+	startTime := time.Now()
+	res, err := d.exchangeRateRepo.GetRates(ctx, base)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetRates").Observe(duration.Seconds())
+
+	return res, err
+}
@@ -0,0 +1,106 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+type GoalRepositoryLatencyDecorator struct {
+	goalRepo goalRepository
+	latency  *prometheus.HistogramVec
+}
+
+func NewGoalRepositoryLatencyDecorator(goalRepo goalRepository) *GoalRepositoryLatencyDecorator {
+	return &GoalRepositoryLatencyDecorator{
+		goalRepo: goalRepo,
+		latency: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "latency_goal_repository",
+			Help:    "Duration of GoalRepository methods",
+			Buckets: []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1.0, 2.0},
+		}, []string{"method"}),
+	}
+}
+
+func (d *GoalRepositoryLatencyDecorator) AddGoal(ctx context.Context, goal *models.Goal) (*models.Goal, error) {
+	startTime := time.Now()
+	res, err := d.goalRepo.AddGoal(ctx, goal)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("AddGoal").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *GoalRepositoryLatencyDecorator) GetGoals(ctx context.Context, userID int64) ([]*models.Goal, error) {
+	startTime := time.Now()
+	res, err := d.goalRepo.GetGoals(ctx, userID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetGoals").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *GoalRepositoryLatencyDecorator) GetGoalByName(ctx context.Context, userID int64, name string) (*models.Goal, error) {
+	startTime := time.Now()
+	res, err := d.goalRepo.GetGoalByName(ctx, userID, name)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetGoalByName").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *GoalRepositoryLatencyDecorator) AddToGoal(ctx context.Context, userID int64, id int, amount int64) (*models.Goal, error) {
+	startTime := time.Now()
+	res, err := d.goalRepo.AddToGoal(ctx, userID, id, amount)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("AddToGoal").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *GoalRepositoryLatencyDecorator) DeleteGoal(ctx context.Context, userID int64, id int) error {
+	startTime := time.Now()
+	err := d.goalRepo.DeleteGoal(ctx, userID, id)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("DeleteGoal").Observe(duration.Seconds())
+
+	return err
+}
+
+func (d *GoalRepositoryLatencyDecorator) GetActiveGoals(ctx context.Context, after time.Time, month string) ([]*models.Goal, error) {
+	startTime := time.Now()
+	res, err := d.goalRepo.GetActiveGoals(ctx, after, month)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetActiveGoals").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *GoalRepositoryLatencyDecorator) ClaimNudge(ctx context.Context, userID int64, month string) (bool, error) {
+	startTime := time.Now()
+	res, err := d.goalRepo.ClaimNudge(ctx, userID, month)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("ClaimNudge").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *GoalRepositoryLatencyDecorator) ReleaseNudge(ctx context.Context, userID int64, month string) error {
+	startTime := time.Now()
+	err := d.goalRepo.ReleaseNudge(ctx, userID, month)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("ReleaseNudge").Observe(duration.Seconds())
+
+	return err
+}
@@ -15,6 +15,13 @@ type telegramClient interface {
 	SendMessageWithoutRemovingKeyboard(ctx context.Context, userID int64, text string) error
 	SendKeyboard(ctx context.Context, userID int64, text string, rows [][]string) error
 	GetUpdatesChan() <-chan *models.Message
+	MarkHandled(message *models.Message)
+
+	SendFormattedMessage(ctx context.Context, message *models.OutgoingMessage) (int, error)
+	SendPhoto(ctx context.Context, file *models.OutgoingFile) (int, error)
+	SendDocument(ctx context.Context, file *models.OutgoingFile) (int, error)
+	EditMessage(ctx context.Context, messageID int, message *models.OutgoingMessage) error
+	AnswerCallback(ctx context.Context, callbackID string) error
 }
 
 type TelegramClientLatencyDecorator struct {
@@ -63,6 +70,60 @@ func (d *TelegramClientLatencyDecorator) SendKeyboard(ctx context.Context, userI
 	return err
 }
 
+func (d *TelegramClientLatencyDecorator) SendFormattedMessage(ctx context.Context, message *models.OutgoingMessage) (int, error) {
+	startTime := time.Now()
+	res, err := d.tgClient.SendFormattedMessage(ctx, message)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SendFormattedMessage").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *TelegramClientLatencyDecorator) SendPhoto(ctx context.Context, file *models.OutgoingFile) (int, error) {
+	startTime := time.Now()
+	res, err := d.tgClient.SendPhoto(ctx, file)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SendPhoto").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *TelegramClientLatencyDecorator) SendDocument(ctx context.Context, file *models.OutgoingFile) (int, error) {
+	startTime := time.Now()
+	res, err := d.tgClient.SendDocument(ctx, file)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SendDocument").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *TelegramClientLatencyDecorator) EditMessage(ctx context.Context, messageID int, message *models.OutgoingMessage) error {
+	startTime := time.Now()
+	err := d.tgClient.EditMessage(ctx, messageID, message)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("EditMessage").Observe(duration.Seconds())
+
+	return err
+}
+
+func (d *TelegramClientLatencyDecorator) AnswerCallback(ctx context.Context, callbackID string) error {
+	startTime := time.Now()
+	err := d.tgClient.AnswerCallback(ctx, callbackID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("AnswerCallback").Observe(duration.Seconds())
+
+	return err
+}
+
 func (d *TelegramClientLatencyDecorator) GetUpdatesChan() <-chan *models.Message {
 	return d.tgClient.GetUpdatesChan()
 }
+
+func (d *TelegramClientLatencyDecorator) MarkHandled(message *models.Message) {
+	d.tgClient.MarkHandled(message)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 type UserRepositoryLatencyDecorator struct {
@@ -45,6 +46,16 @@ func (d *UserRepositoryLatencyDecorator) AddUser(ctx context.Context, user *mode
 	return res, err
 }
 
+func (d *UserRepositoryLatencyDecorator) UpdateNames(ctx context.Context, user *models.User) error {
+	startTime := time.Now()
+	err := d.userRepo.UpdateNames(ctx, user)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("UpdateNames").Observe(duration.Seconds())
+
+	return err
+}
+
 func (d *UserRepositoryLatencyDecorator) SetWasteLimit(ctx context.Context, id int64, limit uint64) (*models.User, error) {
 	startTime := time.Now()
 	res, err := d.userRepo.SetWasteLimit(ctx, id, limit)
@@ -64,3 +75,113 @@ func (d *UserRepositoryLatencyDecorator) GetWasteLimit(ctx context.Context, id i
 
 	return res, err
 }
+
+func (d *UserRepositoryLatencyDecorator) ClearWasteLimit(ctx context.Context, id int64) (*models.User, error) {
+	startTime := time.Now()
+	res, err := d.userRepo.ClearWasteLimit(ctx, id)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("ClearWasteLimit").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *UserRepositoryLatencyDecorator) GetLanguage(ctx context.Context, id int64) (enums.Language, error) {
+	startTime := time.Now()
+	res, err := d.userRepo.GetLanguage(ctx, id)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetLanguage").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *UserRepositoryLatencyDecorator) SetLanguage(ctx context.Context, id int64, language enums.Language) error {
+	startTime := time.Now()
+	err := d.userRepo.SetLanguage(ctx, id, language)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SetLanguage").Observe(duration.Seconds())
+
+	return err
+}
+
+func (d *UserRepositoryLatencyDecorator) GetCurrencies(ctx context.Context, id int64) ([]string, error) {
+	startTime := time.Now()
+	res, err := d.userRepo.GetCurrencies(ctx, id)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetCurrencies").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *UserRepositoryLatencyDecorator) SetCurrencies(ctx context.Context, id int64, currencies []string) error {
+	startTime := time.Now()
+	err := d.userRepo.SetCurrencies(ctx, id, currencies)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SetCurrencies").Observe(duration.Seconds())
+
+	return err
+}
+
+func (d *UserRepositoryLatencyDecorator) GetUsedCurrencies(ctx context.Context) ([]string, error) {
+	startTime := time.Now()
+	res, err := d.userRepo.GetUsedCurrencies(ctx)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetUsedCurrencies").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *UserRepositoryLatencyDecorator) SetAPITokenHash(ctx context.Context, id int64, tokenHash string) error {
+	startTime := time.Now()
+	err := d.userRepo.SetAPITokenHash(ctx, id, tokenHash)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SetAPITokenHash").Observe(duration.Seconds())
+
+	return err
+}
+
+func (d *UserRepositoryLatencyDecorator) GetUserIDByAPITokenHash(ctx context.Context, tokenHash string) (int64, error) {
+	startTime := time.Now()
+	res, err := d.userRepo.GetUserIDByAPITokenHash(ctx, tokenHash)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetUserIDByAPITokenHash").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *UserRepositoryLatencyDecorator) GetUserByUserName(ctx context.Context, userName string) (*models.User, error) {
+	startTime := time.Now()
+	res, err := d.userRepo.GetUserByUserName(ctx, userName)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetUserByUserName").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *UserRepositoryLatencyDecorator) SetIncome(ctx context.Context, id int64, income int64) error {
+	startTime := time.Now()
+	err := d.userRepo.SetIncome(ctx, id, income)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SetIncome").Observe(duration.Seconds())
+
+	return err
+}
+
+func (d *UserRepositoryLatencyDecorator) GetIncome(ctx context.Context, id int64) (*int64, error) {
+	startTime := time.Now()
+	res, err := d.userRepo.GetIncome(ctx, id)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetIncome").Observe(duration.Seconds())
+
+	return res, err
+}
@@ -64,3 +64,23 @@ func (d *UserContextServiceLatencyDecorator) GetCurrency(ctx context.Context, us
 
 	return res, err
 }
+
+func (d *UserContextServiceLatencyDecorator) SetSearchQuery(ctx context.Context, userID int64, query string) error {
+	startTime := time.Now()
+	err := d.service.SetSearchQuery(ctx, userID, query)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SetSearchQuery").Observe(duration.Seconds())
+
+	return err
+}
+
+func (d *UserContextServiceLatencyDecorator) GetSearchQuery(ctx context.Context, userID int64) (string, error) {
+	startTime := time.Now()
+	res, err := d.service.GetSearchQuery(ctx, userID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetSearchQuery").Observe(duration.Seconds())
+
+	return res, err
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 type WasteRepositoryLatencyDecorator struct {
@@ -55,6 +57,96 @@ func (d *WasteRepositoryLatencyDecorator) GetReportLastYear(ctx context.Context,
 	return res, err
 }
 
+func (d *WasteRepositoryLatencyDecorator) GetCurrencyReportLastWeek(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetCurrencyReportLastWeek(ctx, userID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetCurrencyReportLastWeek").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) GetCurrencyReportLastMonth(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetCurrencyReportLastMonth(ctx, userID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetCurrencyReportLastMonth").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) GetCurrencyReportLastYear(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetCurrencyReportLastYear(ctx, userID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetCurrencyReportLastYear").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) GetReportByTagLastWeek(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetReportByTagLastWeek(ctx, userID, tag)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetReportByTagLastWeek").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) GetReportByTagLastMonth(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetReportByTagLastMonth(ctx, userID, tag)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetReportByTagLastMonth").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) GetReportByTagLastYear(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetReportByTagLastYear(ctx, userID, tag)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetReportByTagLastYear").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) GetTagReportLastWeek(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetTagReportLastWeek(ctx, userID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetTagReportLastWeek").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) GetTagReportLastMonth(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetTagReportLastMonth(ctx, userID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetTagReportLastMonth").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) GetTagReportLastYear(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetTagReportLastYear(ctx, userID)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetTagReportLastYear").Observe(duration.Seconds())
+
+	return res, err
+}
+
 func (d *WasteRepositoryLatencyDecorator) SumOfWastesAfterDate(ctx context.Context, userID int64, date time.Time) (int64, error) {
 	startTime := time.Now()
 	res, err := d.wasteRepo.SumOfWastesAfterDate(ctx, userID, date)
@@ -74,3 +166,63 @@ func (d *WasteRepositoryLatencyDecorator) AddWasteToUser(ctx context.Context, us
 
 	return res, err
 }
+
+func (d *WasteRepositoryLatencyDecorator) GetReportBetweenDates(ctx context.Context, userID int64, from time.Time, to time.Time) ([]*models.CategoryReport, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.GetReportBetweenDates(ctx, userID, from, to)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("GetReportBetweenDates").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) ListWastes(ctx context.Context, userID int64, filter models.WasteFilter) ([]*models.Waste, int, error) {
+	startTime := time.Now()
+	res, total, err := d.wasteRepo.ListWastes(ctx, userID, filter)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("ListWastes").Observe(duration.Seconds())
+
+	return res, total, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) SumOfFilteredWastes(ctx context.Context, userID int64, filter models.WasteFilter) (int64, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.SumOfFilteredWastes(ctx, userID, filter)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("SumOfFilteredWastes").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) UpdateWaste(ctx context.Context, userID int64, waste *models.Waste) (*models.Waste, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.UpdateWaste(ctx, userID, waste)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("UpdateWaste").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) UpdateClaimStatus(ctx context.Context, userID int64, id uuid.UUID, from enums.ClaimStatus, to enums.ClaimStatus) (int, error) {
+	startTime := time.Now()
+	res, err := d.wasteRepo.UpdateClaimStatus(ctx, userID, id, from, to)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("UpdateClaimStatus").Observe(duration.Seconds())
+
+	return res, err
+}
+
+func (d *WasteRepositoryLatencyDecorator) DeleteWaste(ctx context.Context, userID int64, id uuid.UUID) error {
+	startTime := time.Now()
+	err := d.wasteRepo.DeleteWaste(ctx, userID, id)
+	duration := time.Since(startTime)
+
+	d.latency.WithLabelValues("DeleteWaste").Observe(duration.Seconds())
+
+	return err
+}
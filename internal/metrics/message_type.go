@@ -1,6 +1,11 @@
 package metrics
 
+import "strings"
+
 func messageType(message string, commands []string) string {
+	// arguments of the command are not the part of the type
+	message, _, _ = strings.Cut(message, " ")
+
 	for _, v := range commands {
 		if message == "/"+v {
 			return v + " command"
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// TelegramOutboxMetrics collects results of sending messages to telegram.
+type TelegramOutboxMetrics struct {
+	retries      *prometheus.CounterVec
+	failures     prometheus.Counter
+	deduplicated prometheus.Counter
+}
+
+func NewTelegramOutboxMetrics() *TelegramOutboxMetrics {
+	return &TelegramOutboxMetrics{
+		retries: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "telegram_outbox_retries_count",
+			Help: "Count of retries of sending messages to telegram",
+		}, []string{"reason"}),
+		failures: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "telegram_outbox_failures_count",
+			Help: "Count of messages which have not been sent to telegram",
+		}),
+		deduplicated: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "telegram_outbox_deduplicated_count",
+			Help: "Count of messages which have not been sent again by idempotency key",
+		}),
+	}
+}
+
+func (m *TelegramOutboxMetrics) IncRetries(reason string) {
+	m.retries.WithLabelValues(reason).Inc()
+}
+
+func (m *TelegramOutboxMetrics) IncFailures() {
+	m.failures.Inc()
+}
+
+func (m *TelegramOutboxMetrics) IncDeduplicated() {
+	m.deduplicated.Inc()
+}
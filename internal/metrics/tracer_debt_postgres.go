@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type DebtRepositoryTracerDecorator struct {
+	debtRepo debtRepository
+	tracer   trace.Tracer
+}
+
+func NewDebtRepositoryTracerDecorator(
+	debtRepo debtRepository,
+	tracerProvider *tracesdk.TracerProvider,
+) *DebtRepositoryTracerDecorator {
+	return &DebtRepositoryTracerDecorator{
+		debtRepo: debtRepo,
+		tracer:   tracerProvider.Tracer("debt-repository"),
+	}
+}
+
+func (d *DebtRepositoryTracerDecorator) AddSplitWaste(ctx context.Context, userID int64, waste *models.Waste, debts []*models.Debt) error {
+	ctxTrace, span := d.tracer.Start(ctx, "AddSplitWaste")
+	defer span.End()
+
+	return d.debtRepo.AddSplitWaste(ctxTrace, userID, waste, debts)
+}
+
+func (d *DebtRepositoryTracerDecorator) GetBalances(ctx context.Context, userID int64) ([]*models.Balance, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetBalances")
+	defer span.End()
+
+	return d.debtRepo.GetBalances(ctxTrace, userID)
+}
+
+func (d *DebtRepositoryTracerDecorator) SettleDebts(ctx context.Context, debtIDs []uuid.UUID) (int, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "SettleDebts")
+	defer span.End()
+
+	return d.debtRepo.SettleDebts(ctxTrace, debtIDs)
+}
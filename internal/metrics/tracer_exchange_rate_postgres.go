@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ExchangeRateRepositoryTracerDecorator struct {
+	exchangeRateRepo exchangeRateRepository
+	tracer           trace.Tracer
+}
+
+func NewExchangeRateRepositoryTracerDecorator(
+	exchangeRateRepo exchangeRateRepository,
+	tracerProvider *tracesdk.TracerProvider,
+) *ExchangeRateRepositoryTracerDecorator {
+	return &ExchangeRateRepositoryTracerDecorator{
+		exchangeRateRepo: exchangeRateRepo,
+		tracer:           tracerProvider.Tracer("exchange-rate-repository"),
+	}
+}
+
+func (d *ExchangeRateRepositoryTracerDecorator) SaveRates(
+	ctx context.Context,
+	base string,
+	rates map[string]money.Rate,
+	updatedAt time.Time,
+) error {
+	ctxTrace, span := d.tracer.Start(ctx, "SaveRates")
+	defer span.End()
+
+	return d.exchangeRateRepo.SaveRates(ctxTrace, base, rates, updatedAt)
+}
+
+func (d *ExchangeRateRepositoryTracerDecorator) GetRates(ctx context.Context, base string) (*models.ExchangeRates, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetRates")
+	defer span.End()
+
+	return d.exchangeRateRepo.GetRates(ctxTrace, base)
+}
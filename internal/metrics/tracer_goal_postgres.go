@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GoalRepositoryTracerDecorator struct {
+	goalRepo goalRepository
+	tracer   trace.Tracer
+}
+
+func NewGoalRepositoryTracerDecorator(
+	goalRepo goalRepository,
+	tracerProvider *tracesdk.TracerProvider,
+) *GoalRepositoryTracerDecorator {
+	return &GoalRepositoryTracerDecorator{
+		goalRepo: goalRepo,
+		tracer:   tracerProvider.Tracer("goal-repository"),
+	}
+}
+
+func (d *GoalRepositoryTracerDecorator) AddGoal(ctx context.Context, goal *models.Goal) (*models.Goal, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "AddGoal")
+	defer span.End()
+
+	return d.goalRepo.AddGoal(ctxTrace, goal)
+}
+
+func (d *GoalRepositoryTracerDecorator) GetGoals(ctx context.Context, userID int64) ([]*models.Goal, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetGoals")
+	defer span.End()
+
+	return d.goalRepo.GetGoals(ctxTrace, userID)
+}
+
+func (d *GoalRepositoryTracerDecorator) GetGoalByName(ctx context.Context, userID int64, name string) (*models.Goal, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetGoalByName")
+	defer span.End()
+
+	return d.goalRepo.GetGoalByName(ctxTrace, userID, name)
+}
+
+func (d *GoalRepositoryTracerDecorator) AddToGoal(ctx context.Context, userID int64, id int, amount int64) (*models.Goal, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "AddToGoal")
+	defer span.End()
+
+	return d.goalRepo.AddToGoal(ctxTrace, userID, id, amount)
+}
+
+func (d *GoalRepositoryTracerDecorator) DeleteGoal(ctx context.Context, userID int64, id int) error {
+	ctxTrace, span := d.tracer.Start(ctx, "DeleteGoal")
+	defer span.End()
+
+	return d.goalRepo.DeleteGoal(ctxTrace, userID, id)
+}
+
+func (d *GoalRepositoryTracerDecorator) GetActiveGoals(ctx context.Context, after time.Time, month string) ([]*models.Goal, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetActiveGoals")
+	defer span.End()
+
+	return d.goalRepo.GetActiveGoals(ctxTrace, after, month)
+}
+
+func (d *GoalRepositoryTracerDecorator) ClaimNudge(ctx context.Context, userID int64, month string) (bool, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "ClaimNudge")
+	defer span.End()
+
+	return d.goalRepo.ClaimNudge(ctxTrace, userID, month)
+}
+
+func (d *GoalRepositoryTracerDecorator) ReleaseNudge(ctx context.Context, userID int64, month string) error {
+	ctxTrace, span := d.tracer.Start(ctx, "ReleaseNudge")
+	defer span.End()
+
+	return d.goalRepo.ReleaseNudge(ctxTrace, userID, month)
+}
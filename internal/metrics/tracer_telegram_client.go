@@ -41,6 +41,45 @@ func (d *TelegramClientTracerDecorator) SendKeyboard(ctx context.Context, userID
 	return d.tgClient.SendKeyboard(ctxTrace, userID, text, rows)
 }
 
+func (d *TelegramClientTracerDecorator) SendFormattedMessage(ctx context.Context, message *models.OutgoingMessage) (int, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "SendFormattedMessage")
+	defer span.End()
+
+	return d.tgClient.SendFormattedMessage(ctxTrace, message)
+}
+
+func (d *TelegramClientTracerDecorator) SendPhoto(ctx context.Context, file *models.OutgoingFile) (int, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "SendPhoto")
+	defer span.End()
+
+	return d.tgClient.SendPhoto(ctxTrace, file)
+}
+
+func (d *TelegramClientTracerDecorator) SendDocument(ctx context.Context, file *models.OutgoingFile) (int, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "SendDocument")
+	defer span.End()
+
+	return d.tgClient.SendDocument(ctxTrace, file)
+}
+
+func (d *TelegramClientTracerDecorator) EditMessage(ctx context.Context, messageID int, message *models.OutgoingMessage) error {
+	ctxTrace, span := d.tracer.Start(ctx, "EditMessage")
+	defer span.End()
+
+	return d.tgClient.EditMessage(ctxTrace, messageID, message)
+}
+
+func (d *TelegramClientTracerDecorator) AnswerCallback(ctx context.Context, callbackID string) error {
+	ctxTrace, span := d.tracer.Start(ctx, "AnswerCallback")
+	defer span.End()
+
+	return d.tgClient.AnswerCallback(ctxTrace, callbackID)
+}
+
 func (d *TelegramClientTracerDecorator) GetUpdatesChan() <-chan *models.Message {
 	return d.tgClient.GetUpdatesChan()
 }
+
+func (d *TelegramClientTracerDecorator) MarkHandled(message *models.Message) {
+	d.tgClient.MarkHandled(message)
+}
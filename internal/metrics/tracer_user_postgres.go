@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -34,6 +35,13 @@ func (d *UserRepositoryTracerDecorator) AddUser(ctx context.Context, user *model
 	return d.userRepo.AddUser(ctxTrace, user)
 }
 
+func (d *UserRepositoryTracerDecorator) UpdateNames(ctx context.Context, user *models.User) error {
+	ctxTrace, span := d.tracer.Start(ctx, "UpdateNames")
+	defer span.End()
+
+	return d.userRepo.UpdateNames(ctxTrace, user)
+}
+
 func (d *UserRepositoryTracerDecorator) SetWasteLimit(ctx context.Context, id int64, limit uint64) (*models.User, error) {
 	ctxTrace, span := d.tracer.Start(ctx, "SetWasteLimit")
 	defer span.End()
@@ -47,3 +55,80 @@ func (d *UserRepositoryTracerDecorator) GetWasteLimit(ctx context.Context, id in
 
 	return d.userRepo.GetWasteLimit(ctxTrace, id)
 }
+
+func (d *UserRepositoryTracerDecorator) ClearWasteLimit(ctx context.Context, id int64) (*models.User, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "ClearWasteLimit")
+	defer span.End()
+
+	return d.userRepo.ClearWasteLimit(ctxTrace, id)
+}
+
+func (d *UserRepositoryTracerDecorator) GetLanguage(ctx context.Context, id int64) (enums.Language, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetLanguage")
+	defer span.End()
+
+	return d.userRepo.GetLanguage(ctxTrace, id)
+}
+
+func (d *UserRepositoryTracerDecorator) SetLanguage(ctx context.Context, id int64, language enums.Language) error {
+	ctxTrace, span := d.tracer.Start(ctx, "SetLanguage")
+	defer span.End()
+
+	return d.userRepo.SetLanguage(ctxTrace, id, language)
+}
+
+func (d *UserRepositoryTracerDecorator) GetCurrencies(ctx context.Context, id int64) ([]string, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetCurrencies")
+	defer span.End()
+
+	return d.userRepo.GetCurrencies(ctxTrace, id)
+}
+
+func (d *UserRepositoryTracerDecorator) SetCurrencies(ctx context.Context, id int64, currencies []string) error {
+	ctxTrace, span := d.tracer.Start(ctx, "SetCurrencies")
+	defer span.End()
+
+	return d.userRepo.SetCurrencies(ctxTrace, id, currencies)
+}
+
+func (d *UserRepositoryTracerDecorator) GetUsedCurrencies(ctx context.Context) ([]string, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetUsedCurrencies")
+	defer span.End()
+
+	return d.userRepo.GetUsedCurrencies(ctxTrace)
+}
+
+func (d *UserRepositoryTracerDecorator) SetAPITokenHash(ctx context.Context, id int64, tokenHash string) error {
+	ctxTrace, span := d.tracer.Start(ctx, "SetAPITokenHash")
+	defer span.End()
+
+	return d.userRepo.SetAPITokenHash(ctxTrace, id, tokenHash)
+}
+
+func (d *UserRepositoryTracerDecorator) GetUserIDByAPITokenHash(ctx context.Context, tokenHash string) (int64, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetUserIDByAPITokenHash")
+	defer span.End()
+
+	return d.userRepo.GetUserIDByAPITokenHash(ctxTrace, tokenHash)
+}
+
+func (d *UserRepositoryTracerDecorator) GetUserByUserName(ctx context.Context, userName string) (*models.User, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetUserByUserName")
+	defer span.End()
+
+	return d.userRepo.GetUserByUserName(ctxTrace, userName)
+}
+
+func (d *UserRepositoryTracerDecorator) SetIncome(ctx context.Context, id int64, income int64) error {
+	ctxTrace, span := d.tracer.Start(ctx, "SetIncome")
+	defer span.End()
+
+	return d.userRepo.SetIncome(ctxTrace, id, income)
+}
+
+func (d *UserRepositoryTracerDecorator) GetIncome(ctx context.Context, id int64) (*int64, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetIncome")
+	defer span.End()
+
+	return d.userRepo.GetIncome(ctxTrace, id)
+}
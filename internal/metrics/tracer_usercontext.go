@@ -47,3 +47,17 @@ func (d *UserContextServiceTracerDecorator) GetCurrency(ctx context.Context, use
 
 	return d.service.GetCurrency(ctxTrace, userID)
 }
+
+func (d *UserContextServiceTracerDecorator) SetSearchQuery(ctx context.Context, userID int64, query string) error {
+	ctxTrace, span := d.tracer.Start(ctx, "SetSearchQuery")
+	defer span.End()
+
+	return d.service.SetSearchQuery(ctxTrace, userID, query)
+}
+
+func (d *UserContextServiceTracerDecorator) GetSearchQuery(ctx context.Context, userID int64) (string, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetSearchQuery")
+	defer span.End()
+
+	return d.service.GetSearchQuery(ctxTrace, userID)
+}
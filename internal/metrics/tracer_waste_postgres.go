@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -42,6 +44,69 @@ func (d *WasteRepositoryTracerDecorator) GetReportLastYear(ctx context.Context,
 	return d.wasteRepo.GetReportLastYear(ctxTrace, userID)
 }
 
+func (d *WasteRepositoryTracerDecorator) GetCurrencyReportLastWeek(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetCurrencyReportLastWeek")
+	defer span.End()
+
+	return d.wasteRepo.GetCurrencyReportLastWeek(ctxTrace, userID)
+}
+
+func (d *WasteRepositoryTracerDecorator) GetCurrencyReportLastMonth(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetCurrencyReportLastMonth")
+	defer span.End()
+
+	return d.wasteRepo.GetCurrencyReportLastMonth(ctxTrace, userID)
+}
+
+func (d *WasteRepositoryTracerDecorator) GetCurrencyReportLastYear(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetCurrencyReportLastYear")
+	defer span.End()
+
+	return d.wasteRepo.GetCurrencyReportLastYear(ctxTrace, userID)
+}
+
+func (d *WasteRepositoryTracerDecorator) GetReportByTagLastWeek(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetReportByTagLastWeek")
+	defer span.End()
+
+	return d.wasteRepo.GetReportByTagLastWeek(ctxTrace, userID, tag)
+}
+
+func (d *WasteRepositoryTracerDecorator) GetReportByTagLastMonth(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetReportByTagLastMonth")
+	defer span.End()
+
+	return d.wasteRepo.GetReportByTagLastMonth(ctxTrace, userID, tag)
+}
+
+func (d *WasteRepositoryTracerDecorator) GetReportByTagLastYear(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetReportByTagLastYear")
+	defer span.End()
+
+	return d.wasteRepo.GetReportByTagLastYear(ctxTrace, userID, tag)
+}
+
+func (d *WasteRepositoryTracerDecorator) GetTagReportLastWeek(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetTagReportLastWeek")
+	defer span.End()
+
+	return d.wasteRepo.GetTagReportLastWeek(ctxTrace, userID)
+}
+
+func (d *WasteRepositoryTracerDecorator) GetTagReportLastMonth(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetTagReportLastMonth")
+	defer span.End()
+
+	return d.wasteRepo.GetTagReportLastMonth(ctxTrace, userID)
+}
+
+func (d *WasteRepositoryTracerDecorator) GetTagReportLastYear(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetTagReportLastYear")
+	defer span.End()
+
+	return d.wasteRepo.GetTagReportLastYear(ctxTrace, userID)
+}
+
 func (d *WasteRepositoryTracerDecorator) SumOfWastesAfterDate(ctx context.Context, userID int64, date time.Time) (int64, error) {
 	ctxTrace, span := d.tracer.Start(ctx, "SumOfWastesAfterDate")
 	defer span.End()
@@ -55,3 +120,45 @@ func (d *WasteRepositoryTracerDecorator) AddWasteToUser(ctx context.Context, use
 
 	return d.wasteRepo.AddWasteToUser(ctxTrace, userID, waste)
 }
+
+func (d *WasteRepositoryTracerDecorator) GetReportBetweenDates(ctx context.Context, userID int64, from time.Time, to time.Time) ([]*models.CategoryReport, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "GetReportBetweenDates")
+	defer span.End()
+
+	return d.wasteRepo.GetReportBetweenDates(ctxTrace, userID, from, to)
+}
+
+func (d *WasteRepositoryTracerDecorator) ListWastes(ctx context.Context, userID int64, filter models.WasteFilter) ([]*models.Waste, int, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "ListWastes")
+	defer span.End()
+
+	return d.wasteRepo.ListWastes(ctxTrace, userID, filter)
+}
+
+func (d *WasteRepositoryTracerDecorator) SumOfFilteredWastes(ctx context.Context, userID int64, filter models.WasteFilter) (int64, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "SumOfFilteredWastes")
+	defer span.End()
+
+	return d.wasteRepo.SumOfFilteredWastes(ctxTrace, userID, filter)
+}
+
+func (d *WasteRepositoryTracerDecorator) UpdateWaste(ctx context.Context, userID int64, waste *models.Waste) (*models.Waste, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "UpdateWaste")
+	defer span.End()
+
+	return d.wasteRepo.UpdateWaste(ctxTrace, userID, waste)
+}
+
+func (d *WasteRepositoryTracerDecorator) UpdateClaimStatus(ctx context.Context, userID int64, id uuid.UUID, from enums.ClaimStatus, to enums.ClaimStatus) (int, error) {
+	ctxTrace, span := d.tracer.Start(ctx, "UpdateClaimStatus")
+	defer span.End()
+
+	return d.wasteRepo.UpdateClaimStatus(ctxTrace, userID, id, from, to)
+}
+
+func (d *WasteRepositoryTracerDecorator) DeleteWaste(ctx context.Context, userID int64, id uuid.UUID) error {
+	ctxTrace, span := d.tracer.Start(ctx, "DeleteWaste")
+	defer span.End()
+
+	return d.wasteRepo.DeleteWaste(ctxTrace, userID, id)
+}
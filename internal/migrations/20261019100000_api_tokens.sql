@@ -0,0 +1,4 @@
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "api_token_hash" character varying NULL;
+-- create index "users_api_token_hash_key" to table: "users"
+CREATE UNIQUE INDEX "users_api_token_hash_key" ON "users" ("api_token_hash");
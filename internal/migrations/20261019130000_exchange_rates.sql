@@ -0,0 +1,2 @@
+-- create "exchange_rates" table
+CREATE TABLE "exchange_rates" ("currency" character varying NOT NULL, "base" character varying NOT NULL, "rate" character varying NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("currency"));
@@ -0,0 +1,2 @@
+-- modify "wastes" table
+ALTER TABLE "wastes" ADD COLUMN "currency" character varying NULL, ADD COLUMN "original_cost" bigint NULL;
@@ -0,0 +1,8 @@
+-- modify "wastes" table
+ALTER TABLE "wastes" ADD COLUMN "note" character varying NULL;
+-- create "tags" table
+CREATE TABLE "tags" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, PRIMARY KEY ("id"));
+-- create index "tags_name_key" to table: "tags"
+CREATE UNIQUE INDEX "tags_name_key" ON "tags" ("name");
+-- create "tag_wastes" table
+CREATE TABLE "tag_wastes" ("tag_id" bigint NOT NULL, "waste_id" uuid NOT NULL, PRIMARY KEY ("tag_id", "waste_id"), CONSTRAINT "tag_wastes_tag_id" FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON DELETE CASCADE, CONSTRAINT "tag_wastes_waste_id" FOREIGN KEY ("waste_id") REFERENCES "wastes" ("id") ON DELETE CASCADE);
@@ -0,0 +1,2 @@
+-- modify "wastes" table
+ALTER TABLE "wastes" ADD COLUMN "claim_status" character varying NULL;
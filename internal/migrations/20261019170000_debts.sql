@@ -0,0 +1,6 @@
+-- create "debts" table
+CREATE TABLE "debts" ("id" uuid NOT NULL, "amount" bigint NOT NULL, "category" character varying NOT NULL, "created_at" timestamptz NOT NULL, "settled_at" timestamptz NULL, "creditor_id" bigint NOT NULL, "debtor_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "debts_users_credits" FOREIGN KEY ("creditor_id") REFERENCES "users" ("id") ON DELETE NO ACTION, CONSTRAINT "debts_users_debts" FOREIGN KEY ("debtor_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "debt_creditor_id_debtor_id" to table: "debts"
+CREATE INDEX "debt_creditor_id_debtor_id" ON "debts" ("creditor_id", "debtor_id");
+-- create index "debt_debtor_id" to table: "debts"
+CREATE INDEX "debt_debtor_id" ON "debts" ("debtor_id");
@@ -0,0 +1,6 @@
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "income" bigint NULL;
+-- create "goals" table
+CREATE TABLE "goals" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "target" bigint NOT NULL, "saved" bigint NOT NULL DEFAULT 0, "deadline" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "goals_users_goals" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "goal_user_id_name" to table: "goals"
+CREATE UNIQUE INDEX "goal_user_id_name" ON "goals" ("user_id", "name");
@@ -0,0 +1,2 @@
+-- the original costs were kept with two fractional digits, convert them to minor units of currencies without them
+UPDATE "wastes" SET "original_cost" = ROUND("original_cost" / 100.0) WHERE "currency" IN ('JPY', 'KRW', 'VND');
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/google/uuid"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+)
+
+// Debt is the share of the split waste which the debtor owes the creditor,
+// the amount is in the default currency.
+type Debt struct {
+	*ent.Debt
+}
+
+func NewDebt(creditorID int64, debtorID int64, amount int64, category string) *Debt {
+	return &Debt{
+		Debt: &ent.Debt{
+			CreditorID: creditorID,
+			DebtorID:   debtorID,
+			Amount:     amount,
+			Category:   category,
+		},
+	}
+}
+
+// Balance is the sum of unsettled debts between the user and another user in the default currency,
+// it is positive if the other user owes the user and negative if the user owes the other user.
+type Balance struct {
+	UserID    int64
+	UserName  string
+	FirstName string
+	Amount    int64
+	// DebtIDs are the unsettled debts summed in the balance.
+	DebtIDs []uuid.UUID
+}
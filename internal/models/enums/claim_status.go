@@ -0,0 +1,14 @@
+package enums
+
+// ClaimStatus is the status of the waste which is reimbursed by the employer,
+// the waste without status is not reimbursable.
+type ClaimStatus string
+
+const (
+	// ClaimStatusPending is the reimbursable waste which has not been claimed yet.
+	ClaimStatusPending ClaimStatus = "pending"
+	// ClaimStatusClaimed is the waste which has been handed to accounting.
+	ClaimStatusClaimed ClaimStatus = "claimed"
+	// ClaimStatusReimbursed is the waste which has been paid back.
+	ClaimStatusReimbursed ClaimStatus = "reimbursed"
+)
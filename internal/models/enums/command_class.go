@@ -0,0 +1,19 @@
+package enums
+
+// CommandClass groups commands with the same cost for rate limiting.
+type CommandClass string
+
+const (
+	CommandClassDefault CommandClass = "default"
+	// CommandClassReport is for heavy commands which send requests to the report service.
+	CommandClassReport CommandClass = "report"
+)
+
+func GetCommandClass(command CommandType) CommandClass {
+	switch command {
+	case CommandTypeWeekReport, CommandTypeMonthReport, CommandTypeYearReport:
+		return CommandClassReport
+	default:
+		return CommandClassDefault
+	}
+}
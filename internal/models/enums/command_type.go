@@ -12,6 +12,16 @@ const (
 	CommandTypeMonthReport CommandType = "/month"
 	CommandTypeYearReport  CommandType = "/year"
 	CommandTypeCurrency    CommandType = "/currency"
+	CommandTypeLanguage    CommandType = "/language"
+	CommandTypeStatus      CommandType = "/status"
+	CommandTypeToken       CommandType = "/token"
+	CommandTypeRates       CommandType = "/rates"
+	CommandTypeConvert     CommandType = "/convert"
+	CommandTypeFind        CommandType = "/find"
+	CommandTypeClaims      CommandType = "/claims"
+	CommandTypeSplit       CommandType = "/split"
+	CommandTypeSettle      CommandType = "/settle"
+	CommandTypeGoal        CommandType = "/goal"
 
 	CommandTypeUnknown CommandType = ""
 )
@@ -32,6 +42,26 @@ func ParseCommandType(command string) (CommandType, error) {
 		return CommandTypeYearReport, nil
 	case string(CommandTypeCurrency):
 		return CommandTypeCurrency, nil
+	case string(CommandTypeLanguage):
+		return CommandTypeLanguage, nil
+	case string(CommandTypeStatus):
+		return CommandTypeStatus, nil
+	case string(CommandTypeToken):
+		return CommandTypeToken, nil
+	case string(CommandTypeRates):
+		return CommandTypeRates, nil
+	case string(CommandTypeConvert):
+		return CommandTypeConvert, nil
+	case string(CommandTypeFind):
+		return CommandTypeFind, nil
+	case string(CommandTypeClaims):
+		return CommandTypeClaims, nil
+	case string(CommandTypeSplit):
+		return CommandTypeSplit, nil
+	case string(CommandTypeSettle):
+		return CommandTypeSettle, nil
+	case string(CommandTypeGoal):
+		return CommandTypeGoal, nil
 	default:
 		return CommandTypeUnknown, fmt.Errorf("Unknown command type")
 	}
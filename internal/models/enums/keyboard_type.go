@@ -0,0 +1,12 @@
+package enums
+
+type KeyboardType int
+
+const (
+	// KeyboardRemove removes the reply keyboard of the user.
+	KeyboardRemove KeyboardType = iota
+	// KeyboardKeep keeps the current reply keyboard of the user.
+	KeyboardKeep
+	KeyboardReply
+	KeyboardInline
+)
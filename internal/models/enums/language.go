@@ -0,0 +1,32 @@
+package enums
+
+import "strings"
+
+type Language string
+
+const (
+	LanguageRussian Language = "ru"
+	LanguageEnglish Language = "en"
+
+	DefaultLanguage = LanguageRussian
+)
+
+// Languages are the languages which the bot can speak.
+var Languages = []Language{LanguageRussian, LanguageEnglish}
+
+// ParseLanguage returns the supported language by the IETF language tag
+// of telegram, for example "en-US" is English.
+func ParseLanguage(code string) (Language, bool) {
+	code = strings.ToLower(code)
+	if i := strings.IndexAny(code, "-_"); i >= 0 {
+		code = code[:i]
+	}
+
+	for _, language := range Languages {
+		if string(language) == code {
+			return language, true
+		}
+	}
+
+	return "", false
+}
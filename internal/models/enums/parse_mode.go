@@ -0,0 +1,10 @@
+package enums
+
+type ParseMode string
+
+const (
+	ParseModeMarkdown   ParseMode = "Markdown"
+	ParseModeMarkdownV2 ParseMode = "MarkdownV2"
+	ParseModeHTML       ParseMode = "HTML"
+	ParseModePlain      ParseMode = ""
+)
@@ -0,0 +1,10 @@
+package enums
+
+type ReportStatus string
+
+const (
+	ReportStatusQueued     ReportStatus = "queued"
+	ReportStatusProcessing ReportStatus = "processing"
+	ReportStatusDelivered  ReportStatus = "delivered"
+	ReportStatusFailed     ReportStatus = "failed"
+)
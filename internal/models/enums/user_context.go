@@ -7,4 +7,7 @@ const (
 	AddWaste
 	ChangeCurrency
 	SetLimit
+	ChangeLanguage
+	ConvertCurrency
+	SplitWaste
 )
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+)
+
+// ExchangeRates are the stored rates of currencies to the base currency.
+type ExchangeRates struct {
+	Base  string
+	Rates map[string]money.Rate
+	// UpdatedAt is the time of the oldest of the rates.
+	UpdatedAt time.Time
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+)
+
+// Goal is the savings goal of the user, the amounts are in the default currency.
+type Goal struct {
+	*ent.Goal
+}
+
+func NewGoal(userID int64, name string, target int64, deadline time.Time) *Goal {
+	return &Goal{
+		Goal: &ent.Goal{
+			UserID:   userID,
+			Name:     name,
+			Target:   target,
+			Deadline: deadline,
+		},
+	}
+}
+
+// Reached returns true if the saved amount is not less than the target.
+func (g *Goal) Reached() bool {
+	return g.Saved >= g.Target
+}
+
+// Percent returns the saved part of the target from 0 to 100.
+func (g *Goal) Percent() int {
+	if g.Reached() {
+		return 100
+	}
+	if g.Saved <= 0 {
+		return 0
+	}
+
+	return int(g.Saved * 100 / g.Target)
+}
+
+// MonthsLeft returns the amount of months until the deadline including the current one,
+// zero if the deadline has passed.
+func (g *Goal) MonthsLeft(now time.Time) int {
+	if !now.Before(g.Deadline) {
+		return 0
+	}
+
+	months := (g.Deadline.Year()-now.Year())*12 + int(g.Deadline.Month()-now.Month())
+	if g.Deadline.Day() > now.Day() || months == 0 {
+		// the part of the month is counted as the whole month
+		months++
+	}
+
+	return months
+}
+
+// MonthlySaving returns the amount to save every month to reach the goal by the deadline,
+// the rest of the target if the deadline has passed.
+func (g *Goal) MonthlySaving(now time.Time) int64 {
+	if g.Reached() {
+		return 0
+	}
+
+	rest := g.Target - g.Saved
+	months := int64(g.MonthsLeft(now))
+	if months == 0 {
+		return rest
+	}
+
+	return (rest + months - 1) / months
+}
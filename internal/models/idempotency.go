@@ -0,0 +1,17 @@
+package models
+
+import "context"
+
+type idempotencyKey struct{}
+
+// ContextWithIdempotencyKey returns the context for sending the message only once,
+// the messages sent with the same key after the first one are not sent again.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the key of the message, empty if it is not set.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKey{}).(string)
+	return key
+}
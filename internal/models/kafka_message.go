@@ -1,6 +1,21 @@
 package models
 
+// Headers of the messages forwarded to the dead letter topic.
+const (
+	HeaderFailureReason     = "failure-reason"
+	HeaderFailureError      = "failure-error"
+	HeaderAttempts          = "attempts"
+	HeaderOriginalTopic     = "original-topic"
+	HeaderOriginalPartition = "original-partition"
+	HeaderOriginalOffset    = "original-offset"
+)
+
 type KafkaMessage struct {
 	Key     []byte
 	Message []byte
+	Headers map[string]string
+
+	Topic     string
+	Partition int
+	Offset    int64
 }
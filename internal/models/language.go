@@ -0,0 +1,25 @@
+package models
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+type languageKey struct{}
+
+// ContextWithLanguage returns the context for sending the messages to the user speaking the language,
+// it is used for the texts added by the telegram client, like the caption of the long message sent as a file.
+func ContextWithLanguage(ctx context.Context, language enums.Language) context.Context {
+	return context.WithValue(ctx, languageKey{}, language)
+}
+
+// LanguageFromContext returns the language of the user, the default language if it is not set.
+func LanguageFromContext(ctx context.Context) enums.Language {
+	language, ok := ctx.Value(languageKey{}).(enums.Language)
+	if !ok {
+		return enums.DefaultLanguage
+	}
+
+	return language
+}
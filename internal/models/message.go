@@ -7,6 +7,13 @@ type Message struct {
 	From *User
 	Date time.Time
 	Text string
+
+	// CallbackID is set for the message made from the pressed inline button,
+	// then ID is the identifier of the message with the button and Text is the data of the button.
+	CallbackID string
+	// UpdateID is the identifier of the telegram update of the message,
+	// it is confirmed to telegram when the message is handled.
+	UpdateID int
 }
 
 func NewMessage(id int, from *User, date int, text string) *Message {
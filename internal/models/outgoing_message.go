@@ -0,0 +1,44 @@
+package models
+
+import "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+
+type KeyboardButton struct {
+	Text string
+
+	// CallbackData and URL are used only by inline keyboard.
+	CallbackData string
+	URL          string
+}
+
+type Keyboard struct {
+	Type    enums.KeyboardType
+	Rows    [][]KeyboardButton
+	OneTime bool
+}
+
+// OutgoingMessage is a text message sent to the user,
+// nil keyboard removes the reply keyboard of the user.
+type OutgoingMessage struct {
+	UserID    int64
+	Text      string
+	ParseMode enums.ParseMode
+	Keyboard  *Keyboard
+}
+
+// OutgoingFile is a photo or a document sent to the user,
+// the file is uploaded from Content or downloaded by telegram from URL.
+type OutgoingFile struct {
+	UserID    int64
+	FileName  string
+	Content   []byte
+	URL       string
+	Caption   string
+	ParseMode enums.ParseMode
+	Keyboard  *Keyboard
+}
+
+// SendResult is a result of sending one message of the batch.
+type SendResult struct {
+	MessageID int
+	Err       error
+}
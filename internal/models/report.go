@@ -4,3 +4,17 @@ type CategoryReport struct {
 	Sum      int64  `json:"sum"`
 	Category string `json:"category"`
 }
+
+// TagReport is the sum of wastes with the tag.
+type TagReport struct {
+	Tag string
+	Sum int64
+}
+
+// CurrencyReport is the sum of wastes entered in the currency,
+// the currency is empty for wastes without the original currency.
+type CurrencyReport struct {
+	Currency    string
+	Sum         int64
+	OriginalSum int64
+}
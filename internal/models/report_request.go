@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/requests"
+)
+
+// ReportRequest is a status of the report request sent to the report service.
+type ReportRequest struct {
+	ID     string
+	UserID int64
+	Period requests.Period
+	Status enums.ReportStatus
+	// Language is the language of the user for notifications about the request.
+	Language  enums.Language
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// SlowNotified is true if the user has been notified that the report is taking too long.
+	SlowNotified bool
+}
@@ -1,17 +1,48 @@
 package requests
 
+import (
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
 type Period int
 
 const (
 	PeriodWeek Period = iota
 	PeriodMonth
 	PeriodYear
+	// PeriodClaims is the claim report of reimbursable wastes between From and To, sent as the document.
+	PeriodClaims
 )
 
 //easyjson:json
 type GetReport struct {
-	UserID              int64   `json:"user_id"`
-	Period              Period  `json:"period"`
-	CurrencyExchange    float64 `json:"currency_exchange"`
-	CurrencyDesignation string  `json:"currency_designation"`
+	RequestID string `json:"request_id"`
+	UserID    int64  `json:"user_id"`
+	Period    Period `json:"period"`
+	// CurrencyExchange is the approximate rate, it is used only if CurrencyRate is not set
+	// by the bot of the previous version.
+	CurrencyExchange float64 `json:"currency_exchange"`
+	// CurrencyRate is the exact decimal rate of Currency to the default currency, like "0.0123",
+	// it is used if the report service has not the stored rate of Currency.
+	CurrencyRate string `json:"currency_rate"`
+	// Currency is the currency of the report.
+	Currency            string `json:"currency"`
+	CurrencyDesignation string `json:"currency_designation"`
+	// BaseCurrency is the default currency in which wastes are stored,
+	// the stored rates are not used if it is empty.
+	BaseCurrency string `json:"base_currency"`
+	// NotCached is set for reports in the currency chosen for the request,
+	// they are not cached as the answer to the command.
+	NotCached bool `json:"not_cached"`
+	// Tag limits the report to wastes with the tag, it is the name without "#".
+	Tag string `json:"tag"`
+	// GroupByTag groups the report by tags instead of categories.
+	GroupByTag bool `json:"group_by_tag"`
+	// From and To are the dates of the claim report.
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// Language is the language of the report, the default language if it is empty.
+	Language enums.Language `json:"language"`
 }
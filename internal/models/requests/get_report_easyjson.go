@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	enums "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 // suppress unused package warning
@@ -36,14 +37,38 @@ func easyjson59e4e729DecodeGitlabOzonDevStepanovAoDevTelegramBotInternalModelsRe
 			continue
 		}
 		switch key {
+		case "request_id":
+			out.RequestID = string(in.String())
 		case "user_id":
 			out.UserID = int64(in.Int64())
 		case "period":
 			out.Period = Period(in.Int())
 		case "currency_exchange":
 			out.CurrencyExchange = float64(in.Float64())
+		case "currency_rate":
+			out.CurrencyRate = string(in.String())
+		case "currency":
+			out.Currency = string(in.String())
 		case "currency_designation":
 			out.CurrencyDesignation = string(in.String())
+		case "base_currency":
+			out.BaseCurrency = string(in.String())
+		case "not_cached":
+			out.NotCached = bool(in.Bool())
+		case "tag":
+			out.Tag = string(in.String())
+		case "group_by_tag":
+			out.GroupByTag = bool(in.Bool())
+		case "from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.From).UnmarshalJSON(data))
+			}
+		case "to":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.To).UnmarshalJSON(data))
+			}
+		case "language":
+			out.Language = enums.Language(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -59,8 +84,13 @@ func easyjson59e4e729EncodeGitlabOzonDevStepanovAoDevTelegramBotInternalModelsRe
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"request_id\":"
 		out.RawString(prefix[1:])
+		out.String(string(in.RequestID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
 		out.Int64(int64(in.UserID))
 	}
 	{
@@ -73,11 +103,56 @@ func easyjson59e4e729EncodeGitlabOzonDevStepanovAoDevTelegramBotInternalModelsRe
 		out.RawString(prefix)
 		out.Float64(float64(in.CurrencyExchange))
 	}
+	{
+		const prefix string = ",\"currency_rate\":"
+		out.RawString(prefix)
+		out.String(string(in.CurrencyRate))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
 	{
 		const prefix string = ",\"currency_designation\":"
 		out.RawString(prefix)
 		out.String(string(in.CurrencyDesignation))
 	}
+	{
+		const prefix string = ",\"base_currency\":"
+		out.RawString(prefix)
+		out.String(string(in.BaseCurrency))
+	}
+	{
+		const prefix string = ",\"not_cached\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.NotCached))
+	}
+	{
+		const prefix string = ",\"tag\":"
+		out.RawString(prefix)
+		out.String(string(in.Tag))
+	}
+	{
+		const prefix string = ",\"group_by_tag\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.GroupByTag))
+	}
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix)
+		out.Raw((in.From).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.Raw((in.To).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
 	out.RawByte('}')
 }
 
@@ -1,12 +1,19 @@
 package models
 
-import "gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+import (
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
 
 type User struct {
 	*ent.User
+
+	// LanguageCode is the language of the telegram client of the user,
+	// it is not stored and used if the user has not chosen the language.
+	LanguageCode string
 }
 
-func NewUser(id int64, firstName string, lastName string, userName string) *User {
+func NewUser(id int64, firstName string, lastName string, userName string, languageCode string) *User {
 	return &User{
 		User: &ent.User{
 			ID:        id,
@@ -14,5 +21,22 @@ func NewUser(id int64, firstName string, lastName string, userName string) *User
 			LastName:  lastName,
 			UserName:  userName,
 		},
+		LanguageCode: languageCode,
+	}
+}
+
+// GetLanguage returns the language chosen by the user, otherwise the language
+// of the telegram client if it is supported, otherwise the default language.
+func (u *User) GetLanguage() enums.Language {
+	if u.Language != nil {
+		if language, ok := enums.ParseLanguage(*u.Language); ok {
+			return language
+		}
 	}
+
+	if language, ok := enums.ParseLanguage(u.LanguageCode); ok {
+		return language
+	}
+
+	return enums.DefaultLanguage
 }
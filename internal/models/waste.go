@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 type Waste struct {
@@ -19,3 +20,54 @@ func NewWaste(category string, cost int64, date time.Time) *Waste {
 		},
 	}
 }
+
+// WithOriginal sets the amount and the currency entered by the user.
+func (w *Waste) WithOriginal(cost int64, currency string) *Waste {
+	w.OriginalCost = &cost
+	w.Currency = &currency
+	return w
+}
+
+// WithNote sets the note and the tags of the waste, tags are lowercase names without "#".
+func (w *Waste) WithNote(note string, tags []string) *Waste {
+	w.Note = note
+	w.Edges.Tags = make([]*ent.Tag, 0, len(tags))
+	for _, tag := range tags {
+		w.Edges.Tags = append(w.Edges.Tags, &ent.Tag{Name: tag})
+	}
+	return w
+}
+
+// WithClaimStatus marks the waste as reimbursable with the status.
+func (w *Waste) WithClaimStatus(status enums.ClaimStatus) *Waste {
+	w.ClaimStatus = &status
+	return w
+}
+
+// TagNames returns the names of the loaded tags of the waste.
+func (w *Waste) TagNames() []string {
+	names := make([]string, 0, len(w.Edges.Tags))
+	for _, tag := range w.Edges.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+// WasteFilter is a filter of wastes of the user, zero values are not applied.
+type WasteFilter struct {
+	From     time.Time
+	To       time.Time
+	Category string
+	// MinCost and MaxCost are the bounds of the cost in the default currency.
+	MinCost int64
+	MaxCost int64
+	// Text is searched in the category and the note ignoring the case.
+	Text string
+	// Tag is the name of the tag without "#".
+	Tag string
+	// ClaimStatuses limits the wastes to reimbursable ones with any of the statuses.
+	ClaimStatuses []enums.ClaimStatus
+
+	Limit  int
+	Offset int
+}
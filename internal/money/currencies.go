@@ -0,0 +1,203 @@
+package money
+
+import "strings"
+
+// defaultDigits is the exponent of minor units of the currencies missing in the table.
+const defaultDigits = 2
+
+// Currency is the currency of ISO 4217 with the symbol shown to users
+// and the exponent of minor units, like 2 for cents of USD or 0 for JPY.
+type Currency struct {
+	Code   string
+	Symbol string
+	Digits int
+}
+
+// currencies are the active currencies of ISO 4217 which can be chosen by users,
+// the code is shown if the currency has no commonly used symbol.
+var currencies = map[string]Currency{
+	"AED": {Code: "AED", Symbol: "د.إ", Digits: 2},
+	"AFN": {Code: "AFN", Digits: 2},
+	"ALL": {Code: "ALL", Digits: 2},
+	"AMD": {Code: "AMD", Symbol: "֏", Digits: 2},
+	"AOA": {Code: "AOA", Digits: 2},
+	"ARS": {Code: "ARS", Digits: 2},
+	"AUD": {Code: "AUD", Symbol: "A$", Digits: 2},
+	"AWG": {Code: "AWG", Digits: 2},
+	"AZN": {Code: "AZN", Symbol: "₼", Digits: 2},
+	"BAM": {Code: "BAM", Digits: 2},
+	"BBD": {Code: "BBD", Digits: 2},
+	"BDT": {Code: "BDT", Digits: 2},
+	"BGN": {Code: "BGN", Symbol: "лв", Digits: 2},
+	"BHD": {Code: "BHD", Digits: 3},
+	"BIF": {Code: "BIF", Digits: 0},
+	"BMD": {Code: "BMD", Digits: 2},
+	"BND": {Code: "BND", Digits: 2},
+	"BOB": {Code: "BOB", Digits: 2},
+	"BOV": {Code: "BOV", Digits: 2},
+	"BRL": {Code: "BRL", Symbol: "R$", Digits: 2},
+	"BSD": {Code: "BSD", Digits: 2},
+	"BTN": {Code: "BTN", Digits: 2},
+	"BWP": {Code: "BWP", Digits: 2},
+	"BYN": {Code: "BYN", Symbol: "Br", Digits: 2},
+	"BZD": {Code: "BZD", Digits: 2},
+	"CAD": {Code: "CAD", Symbol: "C$", Digits: 2},
+	"CDF": {Code: "CDF", Digits: 2},
+	"CHE": {Code: "CHE", Digits: 2},
+	"CHF": {Code: "CHF", Symbol: "CHF", Digits: 2},
+	"CHW": {Code: "CHW", Digits: 2},
+	"CLF": {Code: "CLF", Digits: 4},
+	"CLP": {Code: "CLP", Digits: 0},
+	"CNY": {Code: "CNY", Symbol: "¥", Digits: 2},
+	"COP": {Code: "COP", Digits: 2},
+	"COU": {Code: "COU", Digits: 2},
+	"CRC": {Code: "CRC", Digits: 2},
+	"CUP": {Code: "CUP", Digits: 2},
+	"CVE": {Code: "CVE", Digits: 2},
+	"CZK": {Code: "CZK", Symbol: "Kč", Digits: 2},
+	"DJF": {Code: "DJF", Digits: 0},
+	"DKK": {Code: "DKK", Symbol: "kr", Digits: 2},
+	"DOP": {Code: "DOP", Digits: 2},
+	"DZD": {Code: "DZD", Digits: 2},
+	"EGP": {Code: "EGP", Symbol: "E£", Digits: 2},
+	"ERN": {Code: "ERN", Digits: 2},
+	"ETB": {Code: "ETB", Digits: 2},
+	"EUR": {Code: "EUR", Symbol: "€", Digits: 2},
+	"FJD": {Code: "FJD", Digits: 2},
+	"FKP": {Code: "FKP", Digits: 2},
+	"GBP": {Code: "GBP", Symbol: "£", Digits: 2},
+	"GEL": {Code: "GEL", Symbol: "₾", Digits: 2},
+	"GHS": {Code: "GHS", Digits: 2},
+	"GIP": {Code: "GIP", Digits: 2},
+	"GMD": {Code: "GMD", Digits: 2},
+	"GNF": {Code: "GNF", Digits: 0},
+	"GTQ": {Code: "GTQ", Digits: 2},
+	"GYD": {Code: "GYD", Digits: 2},
+	"HKD": {Code: "HKD", Symbol: "HK$", Digits: 2},
+	"HNL": {Code: "HNL", Digits: 2},
+	"HTG": {Code: "HTG", Digits: 2},
+	"HUF": {Code: "HUF", Symbol: "Ft", Digits: 2},
+	"IDR": {Code: "IDR", Symbol: "Rp", Digits: 2},
+	"ILS": {Code: "ILS", Symbol: "₪", Digits: 2},
+	"INR": {Code: "INR", Symbol: "₹", Digits: 2},
+	"IQD": {Code: "IQD", Digits: 3},
+	"IRR": {Code: "IRR", Digits: 2},
+	"ISK": {Code: "ISK", Digits: 0},
+	"JMD": {Code: "JMD", Digits: 2},
+	"JOD": {Code: "JOD", Digits: 3},
+	"JPY": {Code: "JPY", Symbol: "JP¥", Digits: 0},
+	"KES": {Code: "KES", Digits: 2},
+	"KGS": {Code: "KGS", Symbol: "сом", Digits: 2},
+	"KHR": {Code: "KHR", Digits: 2},
+	"KMF": {Code: "KMF", Digits: 0},
+	"KPW": {Code: "KPW", Digits: 2},
+	"KRW": {Code: "KRW", Symbol: "₩", Digits: 0},
+	"KWD": {Code: "KWD", Digits: 3},
+	"KYD": {Code: "KYD", Digits: 2},
+	"KZT": {Code: "KZT", Symbol: "₸", Digits: 2},
+	"LAK": {Code: "LAK", Digits: 2},
+	"LBP": {Code: "LBP", Digits: 2},
+	"LKR": {Code: "LKR", Digits: 2},
+	"LRD": {Code: "LRD", Digits: 2},
+	"LSL": {Code: "LSL", Digits: 2},
+	"LYD": {Code: "LYD", Digits: 3},
+	"MAD": {Code: "MAD", Digits: 2},
+	"MDL": {Code: "MDL", Symbol: "L", Digits: 2},
+	"MGA": {Code: "MGA", Digits: 2},
+	"MKD": {Code: "MKD", Digits: 2},
+	"MMK": {Code: "MMK", Digits: 2},
+	"MNT": {Code: "MNT", Digits: 2},
+	"MOP": {Code: "MOP", Digits: 2},
+	"MRU": {Code: "MRU", Digits: 2},
+	"MUR": {Code: "MUR", Digits: 2},
+	"MVR": {Code: "MVR", Digits: 2},
+	"MWK": {Code: "MWK", Digits: 2},
+	"MXN": {Code: "MXN", Symbol: "Mex$", Digits: 2},
+	"MXV": {Code: "MXV", Digits: 2},
+	"MYR": {Code: "MYR", Digits: 2},
+	"MZN": {Code: "MZN", Digits: 2},
+	"NAD": {Code: "NAD", Digits: 2},
+	"NGN": {Code: "NGN", Digits: 2},
+	"NIO": {Code: "NIO", Digits: 2},
+	"NOK": {Code: "NOK", Symbol: "kr", Digits: 2},
+	"NPR": {Code: "NPR", Digits: 2},
+	"NZD": {Code: "NZD", Symbol: "NZ$", Digits: 2},
+	"OMR": {Code: "OMR", Digits: 3},
+	"PAB": {Code: "PAB", Digits: 2},
+	"PEN": {Code: "PEN", Digits: 2},
+	"PGK": {Code: "PGK", Digits: 2},
+	"PHP": {Code: "PHP", Digits: 2},
+	"PKR": {Code: "PKR", Digits: 2},
+	"PLN": {Code: "PLN", Symbol: "zł", Digits: 2},
+	"PYG": {Code: "PYG", Digits: 0},
+	"QAR": {Code: "QAR", Digits: 2},
+	"RON": {Code: "RON", Symbol: "lei", Digits: 2},
+	"RSD": {Code: "RSD", Symbol: "дин", Digits: 2},
+	"RUB": {Code: "RUB", Symbol: "Руб", Digits: 2},
+	"RWF": {Code: "RWF", Digits: 0},
+	"SAR": {Code: "SAR", Digits: 2},
+	"SBD": {Code: "SBD", Digits: 2},
+	"SCR": {Code: "SCR", Digits: 2},
+	"SDG": {Code: "SDG", Digits: 2},
+	"SEK": {Code: "SEK", Symbol: "kr", Digits: 2},
+	"SGD": {Code: "SGD", Symbol: "S$", Digits: 2},
+	"SHP": {Code: "SHP", Digits: 2},
+	"SLE": {Code: "SLE", Digits: 2},
+	"SOS": {Code: "SOS", Digits: 2},
+	"SRD": {Code: "SRD", Digits: 2},
+	"SSP": {Code: "SSP", Digits: 2},
+	"STN": {Code: "STN", Digits: 2},
+	"SVC": {Code: "SVC", Digits: 2},
+	"SYP": {Code: "SYP", Digits: 2},
+	"SZL": {Code: "SZL", Digits: 2},
+	"THB": {Code: "THB", Symbol: "฿", Digits: 2},
+	"TJS": {Code: "TJS", Symbol: "SM", Digits: 2},
+	"TMT": {Code: "TMT", Symbol: "m", Digits: 2},
+	"TND": {Code: "TND", Digits: 3},
+	"TOP": {Code: "TOP", Digits: 2},
+	"TRY": {Code: "TRY", Symbol: "₺", Digits: 2},
+	"TTD": {Code: "TTD", Digits: 2},
+	"TWD": {Code: "TWD", Digits: 2},
+	"TZS": {Code: "TZS", Digits: 2},
+	"UAH": {Code: "UAH", Symbol: "₴", Digits: 2},
+	"UGX": {Code: "UGX", Digits: 0},
+	"USD": {Code: "USD", Symbol: "$", Digits: 2},
+	"USN": {Code: "USN", Digits: 2},
+	"UYI": {Code: "UYI", Digits: 0},
+	"UYU": {Code: "UYU", Digits: 2},
+	"UYW": {Code: "UYW", Digits: 4},
+	"UZS": {Code: "UZS", Symbol: "сўм", Digits: 2},
+	"VED": {Code: "VED", Digits: 2},
+	"VES": {Code: "VES", Digits: 2},
+	"VND": {Code: "VND", Symbol: "₫", Digits: 0},
+	"VUV": {Code: "VUV", Digits: 0},
+	"WST": {Code: "WST", Digits: 2},
+	"XAF": {Code: "XAF", Digits: 0},
+	"XCD": {Code: "XCD", Digits: 2},
+	"XCG": {Code: "XCG", Digits: 2},
+	"XOF": {Code: "XOF", Digits: 0},
+	"XPF": {Code: "XPF", Digits: 0},
+	"YER": {Code: "YER", Digits: 2},
+	"ZAR": {Code: "ZAR", Symbol: "R", Digits: 2},
+	"ZMW": {Code: "ZMW", Digits: 2},
+	"ZWG": {Code: "ZWG", Digits: 2},
+}
+
+// LookupCurrency returns the currency by the code of ISO 4217 in any case,
+// the symbol of the currency without one is its code.
+func LookupCurrency(code string) (Currency, bool) {
+	currency, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+	if ok && currency.Symbol == "" {
+		currency.Symbol = currency.Code
+	}
+	return currency, ok
+}
+
+// Digits returns the exponent of minor units of the currency,
+// two digits are used for the unknown currencies.
+func Digits(code string) int {
+	if currency, ok := LookupCurrency(code); ok {
+		return currency.Digits
+	}
+	return defaultDigits
+}
@@ -0,0 +1,176 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("currencies of amounts are different")
+	ErrOverflow         = errors.New("amount of money is out of range")
+	ErrPrecision        = errors.New("amount is more precise than minor units of the currency")
+)
+
+// Rounding is the rule of rounding the amount to minor units.
+type Rounding int
+
+const (
+	// RoundHalfUp rounds the half away from zero, 0.5 -> 1, 1.5 -> 2.
+	RoundHalfUp Rounding = iota
+	// RoundHalfEven rounds the half to the nearest even number (banker's rounding), 0.5 -> 0, 1.5 -> 2.
+	RoundHalfEven
+)
+
+// Money is an exact amount of money in minor units of the currency.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+func New(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: currency,
+	}
+}
+
+// FromDecimal returns the amount with the digits of fraction in minor units of the currency,
+// like 150 with two digits is 1.50 and is 1500 minor units of KWD or 2 minor units of JPY
+// if the fraction is zero, otherwise ErrPrecision is returned.
+func FromDecimal(amount int64, digits int, currency string) (Money, error) {
+	scale := Digits(currency) - digits
+	if scale >= 0 {
+		numerator := new(big.Int).Mul(big.NewInt(amount), pow10(scale))
+		return divide(numerator, big.NewInt(1), currency, RoundHalfEven)
+	}
+
+	if amount%pow10(-scale).Int64() != 0 {
+		return Money{}, fmt.Errorf("%w: %d with %d digits in %s", ErrPrecision, amount, digits, currency)
+	}
+	return New(amount/pow10(-scale).Int64(), currency), nil
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	result := m.Amount + other.Amount
+	if (result > m.Amount) != (other.Amount > 0) {
+		return Money{}, ErrOverflow
+	}
+
+	return New(result, m.Currency), nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	return m.Add(New(-other.Amount, other.Currency))
+}
+
+// Cmp compares amounts of the same currency, returns -1, 0 or +1.
+func (m Money) Cmp(other Money) (int, error) {
+	if m.Currency != other.Currency {
+		return 0, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	switch {
+	case m.Amount < other.Amount:
+		return -1, nil
+	case m.Amount > other.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Exchange converts the amount from the base currency of the rate to the currency.
+func (m Money) Exchange(rate Rate, currency string, rounding Rounding) (Money, error) {
+	numerator := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(rate.value))
+	denominator := big.NewInt(rateScale)
+	rescale(numerator, denominator, Digits(currency)-Digits(m.Currency))
+	return divide(numerator, denominator, currency, rounding)
+}
+
+// ExchangeBack converts the amount from the currency of the rate to the base currency.
+func (m Money) ExchangeBack(rate Rate, baseCurrency string, rounding Rounding) (Money, error) {
+	numerator := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(rateScale))
+	denominator := big.NewInt(rate.value)
+	rescale(numerator, denominator, Digits(baseCurrency)-Digits(m.Currency))
+	return divide(numerator, denominator, baseCurrency, rounding)
+}
+
+// Decimal returns the amount in units of currency with the fractional digits of minor units,
+// like "-1500.05" for RUB, "1500" for JPY or "1.500" for KWD.
+func (m Money) Decimal() string {
+	sign := ""
+	amount := new(big.Int).SetInt64(m.Amount)
+	if amount.Sign() < 0 {
+		sign = "-"
+		amount.Neg(amount)
+	}
+
+	digits := Digits(m.Currency)
+	if digits == 0 {
+		return sign + amount.String()
+	}
+
+	units, minor := new(big.Int).QuoRem(amount, pow10(digits), new(big.Int))
+	return fmt.Sprintf("%s%s.%0*d", sign, units.String(), digits, minor.Int64())
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// rescale multiplies the fraction by 10^scale to move the amount between currencies
+// with different exponents of minor units.
+func rescale(numerator *big.Int, denominator *big.Int, scale int) {
+	if scale > 0 {
+		numerator.Mul(numerator, pow10(scale))
+	} else if scale < 0 {
+		denominator.Mul(denominator, pow10(-scale))
+	}
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+func divide(numerator *big.Int, denominator *big.Int, currency string, rounding Rounding) (Money, error) {
+	if denominator.Sign() == 0 {
+		return Money{}, ErrOverflow
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+
+	// the doubled remainder is compared with the divisor to find out the half
+	doubled := new(big.Int).Abs(remainder)
+	doubled.Lsh(doubled, 1)
+	half := doubled.Cmp(new(big.Int).Abs(denominator))
+
+	away := half > 0 ||
+		half == 0 && rounding == RoundHalfUp ||
+		half == 0 && rounding == RoundHalfEven && quotient.Bit(0) == 1
+	if away && remainder.Sign() != 0 {
+		if numerator.Sign()*denominator.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	if !quotient.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+
+	return New(quotient.Int64(), currency), nil
+}
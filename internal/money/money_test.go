@@ -0,0 +1,153 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestDivide(t *testing.T) {
+	tests := []struct {
+		name        string
+		numerator   *big.Int
+		denominator int64
+		rounding    Rounding
+		want        int64
+		wantErr     error
+	}{
+		{name: "exact", numerator: big.NewInt(10), denominator: 2, rounding: RoundHalfUp, want: 5},
+		{name: "below half", numerator: big.NewInt(10), denominator: 3, rounding: RoundHalfUp, want: 3},
+		{name: "above half", numerator: big.NewInt(11), denominator: 3, rounding: RoundHalfEven, want: 4},
+		{name: "half up", numerator: big.NewInt(5), denominator: 2, rounding: RoundHalfUp, want: 3},
+		{name: "half even to lower", numerator: big.NewInt(5), denominator: 2, rounding: RoundHalfEven, want: 2},
+		{name: "half even to upper", numerator: big.NewInt(7), denominator: 2, rounding: RoundHalfEven, want: 4},
+		{name: "negative half up", numerator: big.NewInt(-5), denominator: 2, rounding: RoundHalfUp, want: -3},
+		{name: "negative half even", numerator: big.NewInt(-5), denominator: 2, rounding: RoundHalfEven, want: -2},
+		{name: "negative above half", numerator: big.NewInt(-11), denominator: 3, rounding: RoundHalfEven, want: -4},
+		{name: "negative denominator", numerator: big.NewInt(5), denominator: -2, rounding: RoundHalfUp, want: -3},
+		{name: "zero denominator", numerator: big.NewInt(5), denominator: 0, wantErr: ErrOverflow},
+		{
+			name:        "out of range",
+			numerator:   new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(2)),
+			denominator: 1,
+			wantErr:     ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := divide(tt.numerator, big.NewInt(tt.denominator), "USD", tt.rounding)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("divide() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != New(tt.want, "USD") {
+				t.Errorf("divide() = %v, want %d USD", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExchange(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		rate     string
+		rounding Rounding
+		back     bool
+		currency string
+		want     int64
+	}{
+		{name: "exact", amount: 10000, rate: "92.1234", rounding: RoundHalfEven, want: 921234},
+		{name: "less than minor unit", amount: 1, rate: "0.01234567", rounding: RoundHalfUp, want: 0},
+		{name: "half up", amount: 50, rate: "0.01", rounding: RoundHalfUp, want: 1},
+		{name: "half even", amount: 50, rate: "0.01", rounding: RoundHalfEven, want: 0},
+		{name: "back", amount: 150, rate: "3", rounding: RoundHalfEven, back: true, want: 50},
+		{name: "back with rounding", amount: 100, rate: "3", rounding: RoundHalfEven, back: true, want: 33},
+		{name: "to no minor units", amount: 10050, rate: "150.5", rounding: RoundHalfEven, currency: "JPY", want: 15125},
+		{name: "to three digits", amount: 10000, rate: "0.3075", rounding: RoundHalfEven, currency: "KWD", want: 30750},
+		{name: "back to no minor units", amount: 1505, rate: "0.01", rounding: RoundHalfEven, back: true, currency: "JPY", want: 1505},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate(tt.rate)
+			if err != nil {
+				t.Fatalf("ParseRate(%q) error = %v", tt.rate, err)
+			}
+
+			currency := tt.currency
+			if currency == "" {
+				currency = "USD"
+			}
+
+			var got Money
+			if tt.back {
+				got, err = New(tt.amount, "EUR").ExchangeBack(rate, currency, tt.rounding)
+			} else {
+				got, err = New(tt.amount, "EUR").Exchange(rate, currency, tt.rounding)
+			}
+			if err != nil {
+				t.Fatalf("exchange error = %v", err)
+			}
+			if got != New(tt.want, currency) {
+				t.Errorf("exchange = %v, want %d %s", got, tt.want, currency)
+			}
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		want     string
+	}{
+		{amount: 0, currency: "USD", want: "0.00"},
+		{amount: 5, currency: "USD", want: "0.05"},
+		{amount: 150005, currency: "USD", want: "1500.05"},
+		{amount: -150005, currency: "USD", want: "-1500.05"},
+		{amount: math.MinInt64, currency: "USD", want: "-92233720368547758.08"},
+		{amount: 1500, currency: "JPY", want: "1500"},
+		{amount: -5, currency: "KRW", want: "-5"},
+		{amount: 1505, currency: "KWD", want: "1.505"},
+		{amount: 5, currency: "BHD", want: "0.005"},
+		{amount: 5, currency: "", want: "0.05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want+" "+tt.currency, func(t *testing.T) {
+			if got := New(tt.amount, tt.currency).Decimal(); got != tt.want {
+				t.Errorf("Decimal() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		currency string
+		want     int64
+		wantErr  error
+	}{
+		{name: "same digits", amount: 150, currency: "USD", want: 150},
+		{name: "more digits", amount: 150, currency: "KWD", want: 1500},
+		{name: "no minor units", amount: 150000, currency: "JPY", want: 1500},
+		{name: "fraction of no minor units", amount: 150050, currency: "JPY", wantErr: ErrPrecision},
+		{name: "out of range", amount: math.MaxInt64, currency: "KWD", wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromDecimal(tt.amount, 2, tt.currency)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FromDecimal() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != New(tt.want, tt.currency) {
+				t.Errorf("FromDecimal() = %v, want %d %s", got, tt.want, tt.currency)
+			}
+		})
+	}
+}
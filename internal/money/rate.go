@@ -0,0 +1,95 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RateDecimals is the precision of exchange rates.
+const RateDecimals = 8
+
+const rateScale = 100_000_000
+
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// maxRate keeps the products of amounts and rates in the reasonable range.
+const maxRate = math.MaxInt64 / rateScale
+
+// Rate is the positive exchange rate with fixed precision,
+// one unit of the base currency costs rate units of the currency.
+type Rate struct {
+	value int64
+}
+
+// IdentityRate is the rate of the currency to itself.
+var IdentityRate = Rate{value: rateScale}
+
+// NewRate returns the rate rounded to RateDecimals.
+func NewRate(value float64) (Rate, error) {
+	if math.IsNaN(value) || value <= 0 || value > maxRate {
+		return Rate{}, fmt.Errorf("%w: %v", ErrInvalidRate, value)
+	}
+
+	scaled := int64(math.Round(value * rateScale))
+	if scaled == 0 {
+		return Rate{}, fmt.Errorf("%w: %v is less than precision", ErrInvalidRate, value)
+	}
+
+	return Rate{value: scaled}, nil
+}
+
+// ParseRate parses the decimal rate like "92.1234" or "92,1234" without the loss of precision.
+func ParseRate(text string) (Rate, error) {
+	text = strings.TrimSpace(strings.Replace(text, ",", ".", 1))
+
+	integer, fraction, _ := strings.Cut(text, ".")
+	if integer == "" || len(fraction) > RateDecimals {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, text)
+	}
+	fraction += strings.Repeat("0", RateDecimals-len(fraction))
+
+	value, err := strconv.ParseInt(integer+fraction, 10, 64)
+	if err != nil || value <= 0 || value/rateScale > maxRate {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, text)
+	}
+
+	return Rate{value: value}, nil
+}
+
+func (r Rate) IsZero() bool {
+	return r.value == 0
+}
+
+func (r Rate) Float64() float64 {
+	return float64(r.value) / rateScale
+}
+
+// String returns the rate with all significant fractional digits, like "92.1234".
+func (r Rate) String() string {
+	fraction := strings.TrimRight(fmt.Sprintf("%08d", r.value%rateScale), "0")
+	if fraction == "" {
+		return strconv.FormatInt(r.value/rateScale, 10)
+	}
+
+	return strconv.FormatInt(r.value/rateScale, 10) + "." + fraction
+}
+
+// CrossRate returns how many units of the quote currency cost one unit of the base currency,
+// both rates are relative to the same currency.
+func CrossRate(base Rate, quote Rate) (Rate, error) {
+	if base.IsZero() || quote.IsZero() {
+		return Rate{}, fmt.Errorf("%w: zero rate", ErrInvalidRate)
+	}
+
+	numerator := new(big.Int).Mul(big.NewInt(quote.value), big.NewInt(rateScale))
+	result, err := divide(numerator, big.NewInt(base.value), "", RoundHalfUp)
+	if err != nil || result.Amount <= 0 || result.Amount/rateScale > maxRate {
+		return Rate{}, fmt.Errorf("%w: cross rate is out of range", ErrInvalidRate)
+	}
+
+	return Rate{value: result.Amount}, nil
+}
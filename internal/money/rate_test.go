@@ -0,0 +1,88 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    string
+		wantErr error
+	}{
+		{text: "92.1234", want: "92.1234"},
+		{text: "92,1234", want: "92.1234"},
+		{text: " 3 ", want: "3"},
+		{text: "0.00000001", want: "0.00000001"},
+		{text: "0.000000001", wantErr: ErrInvalidRate},
+		{text: "0", wantErr: ErrInvalidRate},
+		{text: "-1", wantErr: ErrInvalidRate},
+		{text: ".5", wantErr: ErrInvalidRate},
+		{text: "abc", wantErr: ErrInvalidRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseRate(tt.text)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseRate(%q) error = %v, want %v", tt.text, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseRate(%q) = %s, want %s", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    Rate
+		quote   Rate
+		want    string
+		wantErr error
+	}{
+		{name: "same rates", base: mustParseRate(t, "92.5"), quote: mustParseRate(t, "92.5"), want: "1"},
+		{name: "exact", base: mustParseRate(t, "2"), quote: mustParseRate(t, "5"), want: "2.5"},
+		{name: "rounded down", base: mustParseRate(t, "90"), quote: mustParseRate(t, "100"), want: "1.11111111"},
+		{name: "rounded up", base: mustParseRate(t, "3"), quote: mustParseRate(t, "2"), want: "0.66666667"},
+		{name: "zero base", base: Rate{}, quote: IdentityRate, wantErr: ErrInvalidRate},
+		{name: "zero quote", base: IdentityRate, quote: Rate{}, wantErr: ErrInvalidRate},
+		{
+			name:    "out of range",
+			base:    mustParseRate(t, "0.00000001"),
+			quote:   mustParseRate(t, "10000000000"),
+			wantErr: ErrInvalidRate,
+		},
+		{
+			name:    "less than precision",
+			base:    mustParseRate(t, "10000000000"),
+			quote:   mustParseRate(t, "0.00000001"),
+			wantErr: ErrInvalidRate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CrossRate(tt.base, tt.quote)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CrossRate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("CrossRate() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func mustParseRate(t *testing.T, text string) Rate {
+	t.Helper()
+
+	rate, err := ParseRate(text)
+	if err != nil {
+		t.Fatalf("ParseRate(%q) error = %v", text, err)
+	}
+
+	return rate
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/debt"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+type DebtRepository struct {
+	client *ent.Client
+}
+
+func NewDebtRepository(client *ent.Client) *DebtRepository {
+	return &DebtRepository{
+		client: client,
+	}
+}
+
+// AddSplitWaste saves the own share of the payer as the waste and the shares of the others as the debts
+// in one transaction, the waste is nil if the payer has paid only for the others.
+func (r *DebtRepository) AddSplitWaste(ctx context.Context, userID int64, waste *models.Waste, debts []*models.Debt) error {
+	// the tags are created before the transaction, the failed insert of the concurrent tag aborts it
+	var tagIDs []int
+	if waste != nil {
+		var err error
+		if tagIDs, err = getOrCreateTags(ctx, r.client, waste.TagNames()); err != nil {
+			return err
+		}
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	if waste != nil {
+		if err := createWaste(tx.Waste, userID, waste, tagIDs).Exec(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("create waste: %w", err))
+		}
+	}
+
+	builders := make([]*ent.DebtCreate, 0, len(debts))
+	for _, d := range debts {
+		builders = append(builders, tx.Debt.Create().
+			SetCreditorID(d.CreditorID).
+			SetDebtorID(d.DebtorID).
+			SetAmount(d.Amount).
+			SetCategory(d.Category))
+	}
+
+	if err := tx.Debt.CreateBulk(builders...).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("create debts: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+// GetBalances returns the non-zero balances of unsettled debts of the user with other users,
+// sorted by the usernames.
+func (r *DebtRepository) GetBalances(ctx context.Context, userID int64) ([]*models.Balance, error) {
+	debts, err := r.client.Debt.Query().
+		Where(
+			debt.SettledAtIsNil(),
+			debt.Or(debt.CreditorID(userID), debt.DebtorID(userID)),
+		).
+		WithCreditor().
+		WithDebtor().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[int64]*models.Balance)
+	for _, d := range debts {
+		other, amount := d.Edges.Debtor, d.Amount
+		if d.DebtorID == userID {
+			other, amount = d.Edges.Creditor, -d.Amount
+		}
+
+		balance, ok := balances[other.ID]
+		if !ok {
+			balance = &models.Balance{
+				UserID:    other.ID,
+				UserName:  other.UserName,
+				FirstName: other.FirstName,
+			}
+			balances[other.ID] = balance
+		}
+		balance.Amount += amount
+		balance.DebtIDs = append(balance.DebtIDs, d.ID)
+	}
+
+	result := make([]*models.Balance, 0, len(balances))
+	for _, balance := range balances {
+		if balance.Amount != 0 {
+			result = append(result, balance)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UserName < result[j].UserName
+	})
+
+	return result, nil
+}
+
+// SettleDebts marks the unsettled debts with the identifiers as settled, returns the amount of settled debts.
+// The debts are settled by the identifiers read with the balance, so the debts added after it are not settled.
+func (r *DebtRepository) SettleDebts(ctx context.Context, debtIDs []uuid.UUID) (int, error) {
+	return r.client.Debt.Update().
+		Where(
+			debt.SettledAtIsNil(),
+			debt.IDIn(debtIDs...),
+		).
+		SetSettledAt(time.Now()).
+		Save(ctx)
+}
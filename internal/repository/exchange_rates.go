@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/exchangerate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+)
+
+type ExchangeRateRepository struct {
+	client *ent.Client
+}
+
+func NewExchangeRateRepository(client *ent.Client) *ExchangeRateRepository {
+	return &ExchangeRateRepository{
+		client: client,
+	}
+}
+
+// SaveRates replaces the stored rates of the currencies.
+func (r *ExchangeRateRepository) SaveRates(
+	ctx context.Context,
+	base string,
+	rates map[string]money.Rate,
+	updatedAt time.Time,
+) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	currencies := make([]string, 0, len(rates))
+	for currency := range rates {
+		currencies = append(currencies, currency)
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	_, err = tx.ExchangeRate.Delete().
+		Where(exchangerate.IDIn(currencies...)).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("delete old rates: %w", err))
+	}
+
+	builders := make([]*ent.ExchangeRateCreate, 0, len(rates))
+	for currency, rate := range rates {
+		builders = append(builders, tx.ExchangeRate.Create().
+			SetID(currency).
+			SetBase(base).
+			SetRate(rate.String()).
+			SetUpdatedAt(updatedAt),
+		)
+	}
+
+	err = tx.ExchangeRate.CreateBulk(builders...).Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("create rates: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+// GetRates returns the stored rates to the base, rates to other bases are skipped.
+func (r *ExchangeRateRepository) GetRates(ctx context.Context, base string) (*models.ExchangeRates, error) {
+	stored, err := r.client.ExchangeRate.Query().
+		Where(exchangerate.Base(base)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ExchangeRates{
+		Base:  base,
+		Rates: make(map[string]money.Rate, len(stored)),
+	}
+	for _, model := range stored {
+		rate, err := money.ParseRate(model.Rate)
+		if err != nil {
+			continue
+		}
+
+		result.Rates[model.ID] = rate
+		if result.UpdatedAt.IsZero() || model.UpdatedAt.Before(result.UpdatedAt) {
+			result.UpdatedAt = model.UpdatedAt
+		}
+	}
+
+	return result, nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rollbackErr := tx.Rollback(); rollbackErr != nil {
+		return fmt.Errorf("%w: rollback: %v", err, rollbackErr)
+	}
+
+	return err
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/goal"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+)
+
+var ErrGoalNotFound = errors.New("goal not found")
+
+type GoalRepository struct {
+	client *ent.Client
+}
+
+func NewGoalRepository(client *ent.Client) *GoalRepository {
+	return &GoalRepository{
+		client: client,
+	}
+}
+
+func (r *GoalRepository) AddGoal(ctx context.Context, g *models.Goal) (*models.Goal, error) {
+	model, err := r.client.Goal.Create().
+		SetUserID(g.UserID).
+		SetName(g.Name).
+		SetTarget(g.Target).
+		SetDeadline(g.Deadline).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Goal{
+		Goal: model,
+	}, nil
+}
+
+// GetGoals returns the goals of the user from the nearest deadline.
+func (r *GoalRepository) GetGoals(ctx context.Context, userID int64) ([]*models.Goal, error) {
+	goals, err := r.client.Goal.Query().
+		Where(goal.UserID(userID)).
+		Order(ent.Asc(goal.FieldDeadline), ent.Asc(goal.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGoals(goals), nil
+}
+
+// GetGoalByName returns the goal of the user by the name ignoring the case,
+// ErrGoalNotFound is returned if the user has no such goal.
+func (r *GoalRepository) GetGoalByName(ctx context.Context, userID int64, name string) (*models.Goal, error) {
+	model, err := r.client.Goal.Query().
+		Where(goal.UserID(userID), goal.NameEqualFold(name)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrGoalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Goal{
+		Goal: model,
+	}, nil
+}
+
+// AddToGoal adds the amount to the saved amount of the goal of the user and returns the updated goal.
+func (r *GoalRepository) AddToGoal(ctx context.Context, userID int64, id int, amount int64) (*models.Goal, error) {
+	// the amount is added by the database, so the contributions sent at once are not lost
+	updated, err := r.client.Goal.Update().
+		Where(goal.ID(id), goal.UserID(userID)).
+		AddSaved(amount).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrGoalNotFound
+	}
+
+	model, err := r.client.Goal.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Goal{
+		Goal: model,
+	}, nil
+}
+
+func (r *GoalRepository) DeleteGoal(ctx context.Context, userID int64, id int) error {
+	deleted, err := r.client.Goal.Delete().
+		Where(goal.ID(id), goal.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrGoalNotFound
+	}
+
+	return nil
+}
+
+// GetActiveGoals returns the goals which are not reached and have the deadline after the date
+// of the users not nudged about the goals in the month, the users of the goals are loaded.
+func (r *GoalRepository) GetActiveGoals(ctx context.Context, after time.Time, month string) ([]*models.Goal, error) {
+	goals, err := r.client.Goal.Query().
+		Where(
+			goal.DeadlineGT(after),
+			goal.HasUserWith(notNudged(month)),
+			func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(goal.FieldSaved), s.C(goal.FieldTarget)))
+			},
+		).
+		WithUser().
+		Order(ent.Asc(goal.FieldUserID), ent.Asc(goal.FieldDeadline)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGoals(goals), nil
+}
+
+// ClaimNudge marks the user as nudged about the goals in the month,
+// false is returned if the user has already been nudged, for example, by another replica.
+func (r *GoalRepository) ClaimNudge(ctx context.Context, userID int64, month string) (bool, error) {
+	updated, err := r.client.User.Update().
+		Where(user.ID(userID), notNudged(month)).
+		SetGoalNudgeMonth(month).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return updated == 1, nil
+}
+
+// ReleaseNudge removes the mark of the month if the nudge has not been sent, so it can be sent again.
+func (r *GoalRepository) ReleaseNudge(ctx context.Context, userID int64, month string) error {
+	return r.client.User.Update().
+		Where(user.ID(userID), user.GoalNudgeMonth(month)).
+		ClearGoalNudgeMonth().
+		Exec(ctx)
+}
+
+func notNudged(month string) predicate.User {
+	return user.Or(user.GoalNudgeMonthIsNil(), user.GoalNudgeMonthNEQ(month))
+}
+
+func newGoals(goals []*ent.Goal) []*models.Goal {
+	result := make([]*models.Goal, 0, len(goals))
+	for _, g := range goals {
+		result = append(result, &models.Goal{
+			Goal: g,
+		})
+	}
+
+	return result
+}
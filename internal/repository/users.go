@@ -2,12 +2,17 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
+var ErrUserNotFound = errors.New("user not found")
+
 type UserRepository struct {
 	client *ent.Client
 }
@@ -35,6 +40,44 @@ func (r *UserRepository) AddUser(ctx context.Context, user *models.User) (*model
 	}, nil
 }
 
+// UpdateNames saves the names of the user changed in telegram. The new username is cleared
+// from other users, because usernames are unique in telegram and they have changed theirs.
+func (r *UserRepository) UpdateNames(ctx context.Context, u *models.User) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := tx.User.Update().
+		Where(
+			user.ID(u.ID),
+			user.Or(
+				user.FirstNameNEQ(u.FirstName),
+				user.LastNameNEQ(u.LastName),
+				user.UserNameNEQ(u.UserName),
+			),
+		).
+		SetFirstName(u.FirstName).
+		SetLastName(u.LastName).
+		SetUserName(u.UserName).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("update names: %w", err))
+	}
+
+	if updated > 0 && u.UserName != "" {
+		err = tx.User.Update().
+			Where(user.IDNEQ(u.ID), user.UserNameEqualFold(u.UserName)).
+			SetUserName("").
+			Exec(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("clear old usernames: %w", err))
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (r *UserRepository) UserExists(ctx context.Context, id int64) (bool, error) {
 	exists, err := r.client.User.Query().
 		Where(user.ID(id)).
@@ -84,3 +127,154 @@ func (r *UserRepository) GetWasteLimit(ctx context.Context, id int64) (*uint64,
 
 	return model.WasteLimit, nil
 }
+
+func (r *UserRepository) ClearWasteLimit(ctx context.Context, id int64) (*models.User, error) {
+	updated, err := r.client.User.
+		UpdateOneID(id).
+		ClearWasteLimit().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.User{
+		User: updated,
+	}, nil
+}
+
+// GetLanguage returns the language chosen by the user,
+// empty language if the user has not chosen it or does not exist.
+func (r *UserRepository) GetLanguage(ctx context.Context, id int64) (enums.Language, error) {
+	model, err := r.client.User.Query().
+		Select(user.FieldLanguage).
+		Where(user.ID(id)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if model.Language == nil {
+		return "", nil
+	}
+
+	return enums.Language(*model.Language), nil
+}
+
+func (r *UserRepository) SetLanguage(ctx context.Context, id int64, language enums.Language) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetLanguage(string(language)).
+		Exec(ctx)
+}
+
+// GetCurrencies returns the currencies added by the user to the list of offered currencies.
+func (r *UserRepository) GetCurrencies(ctx context.Context, id int64) ([]string, error) {
+	model, err := r.client.User.Query().
+		Select(user.FieldCurrencies).
+		Where(user.ID(id)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return model.Currencies, nil
+}
+
+func (r *UserRepository) SetCurrencies(ctx context.Context, id int64, currencies []string) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetCurrencies(currencies).
+		Exec(ctx)
+}
+
+// GetUsedCurrencies returns the currencies added by all users without duplicates.
+func (r *UserRepository) GetUsedCurrencies(ctx context.Context) ([]string, error) {
+	found, err := r.client.User.Query().
+		Select(user.FieldCurrencies).
+		Where(user.CurrenciesNotNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	result := make([]string, 0)
+	for _, model := range found {
+		for _, currency := range model.Currencies {
+			if _, ok := seen[currency]; ok {
+				continue
+			}
+
+			seen[currency] = struct{}{}
+			result = append(result, currency)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *UserRepository) SetAPITokenHash(ctx context.Context, id int64, tokenHash string) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetAPITokenHash(tokenHash).
+		Exec(ctx)
+}
+
+func (r *UserRepository) GetUserIDByAPITokenHash(ctx context.Context, tokenHash string) (int64, error) {
+	id, err := r.client.User.Query().
+		Where(user.APITokenHash(tokenHash)).
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, ErrUserNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetUserByUserName returns the user by the telegram username without "@", the case is ignored.
+func (r *UserRepository) GetUserByUserName(ctx context.Context, userName string) (*models.User, error) {
+	model, err := r.client.User.Query().
+		Where(user.UserNameEqualFold(userName)).
+		Only(ctx)
+	// usernames are updated on every message, so only the users silent since the change have the old ones
+	if ent.IsNotFound(err) || ent.IsNotSingular(err) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.User{
+		User: model,
+	}, nil
+}
+
+// SetIncome sets the monthly income of the user in the default currency.
+func (r *UserRepository) SetIncome(ctx context.Context, id int64, income int64) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetIncome(income).
+		Exec(ctx)
+}
+
+// GetIncome returns the monthly income of the user, nil if the income is not set.
+func (r *UserRepository) GetIncome(ctx context.Context, id int64) (*int64, error) {
+	model, err := r.client.User.Query().
+		Select(user.FieldIncome).
+		Where(user.ID(id)).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.Income, nil
+}
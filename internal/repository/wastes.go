@@ -3,12 +3,18 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/predicate"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/tag"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/user"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent/waste"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 )
 
 var ErrNotFound = errors.New("wastes not found")
@@ -108,16 +114,321 @@ func (r *WasteRepository) GetReportAfterDate(
 	return report, nil
 }
 
-func (r *WasteRepository) AddWasteToUser(
+func (r *WasteRepository) GetCurrencyReportLastWeek(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	return r.GetCurrencyReportAfterDate(ctx, userID, time.Now().Add(-weekDuration))
+}
+
+func (r *WasteRepository) GetCurrencyReportLastMonth(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	return r.GetCurrencyReportAfterDate(ctx, userID, time.Now().Add(-monthDuration))
+}
+
+func (r *WasteRepository) GetCurrencyReportLastYear(ctx context.Context, userID int64) ([]*models.CurrencyReport, error) {
+	return r.GetCurrencyReportAfterDate(ctx, userID, time.Now().Add(-yearDuration))
+}
+
+// GetCurrencyReportAfterDate returns the sums of wastes grouped by the currency entered by the user.
+func (r *WasteRepository) GetCurrencyReportAfterDate(
+	ctx context.Context, userID int64, date time.Time,
+) ([]*models.CurrencyReport, error) {
+	var rows []struct {
+		Currency    *string `json:"currency"`
+		Sum         int64   `json:"sum"`
+		OriginalSum *int64  `json:"original_sum"`
+	}
+	err := r.client.Waste.Query().
+		Where(waste.HasUserWith(user.ID(userID)), waste.DateGTE(date)).
+		GroupBy(waste.FieldCurrency).
+		Aggregate(
+			ent.As(ent.Sum(waste.FieldCost), "sum"),
+			ent.As(ent.Sum(waste.FieldOriginalCost), "original_sum"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]*models.CurrencyReport, 0, len(rows))
+	for _, row := range rows {
+		item := &models.CurrencyReport{
+			Sum:         row.Sum,
+			OriginalSum: row.Sum,
+		}
+		if row.Currency != nil && row.OriginalSum != nil {
+			item.Currency = *row.Currency
+			item.OriginalSum = *row.OriginalSum
+		}
+
+		report = append(report, item)
+	}
+
+	return report, nil
+}
+
+func (r *WasteRepository) GetReportByTagLastWeek(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	return r.GetReportByTagAfterDate(ctx, userID, time.Now().Add(-weekDuration), tag)
+}
+
+func (r *WasteRepository) GetReportByTagLastMonth(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	return r.GetReportByTagAfterDate(ctx, userID, time.Now().Add(-monthDuration), tag)
+}
+
+func (r *WasteRepository) GetReportByTagLastYear(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error) {
+	return r.GetReportByTagAfterDate(ctx, userID, time.Now().Add(-yearDuration), tag)
+}
+
+// GetReportByTagAfterDate returns the sums of wastes with the tag grouped by the category.
+func (r *WasteRepository) GetReportByTagAfterDate(
+	ctx context.Context, userID int64, date time.Time, tagName string,
+) ([]*models.CategoryReport, error) {
+	var report []*models.CategoryReport
+	err := r.client.Waste.Query().
+		Where(
+			waste.HasUserWith(user.ID(userID)),
+			waste.DateGTE(date),
+			waste.HasTagsWith(tag.Name(tagName)),
+		).
+		GroupBy(waste.FieldCategory).
+		Aggregate(ent.Sum(waste.FieldCost)).
+		Scan(ctx, &report)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (r *WasteRepository) GetTagReportLastWeek(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	return r.GetTagReportAfterDate(ctx, userID, time.Now().Add(-weekDuration))
+}
+
+func (r *WasteRepository) GetTagReportLastMonth(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	return r.GetTagReportAfterDate(ctx, userID, time.Now().Add(-monthDuration))
+}
+
+func (r *WasteRepository) GetTagReportLastYear(ctx context.Context, userID int64) ([]*models.TagReport, error) {
+	return r.GetTagReportAfterDate(ctx, userID, time.Now().Add(-yearDuration))
+}
+
+// GetTagReportAfterDate returns the sums of wastes grouped by the tag,
+// the waste with many tags is counted in each of them, wastes without tags are skipped.
+func (r *WasteRepository) GetTagReportAfterDate(
+	ctx context.Context, userID int64, date time.Time,
+) ([]*models.TagReport, error) {
+	wastes, err := r.client.Waste.Query().
+		Where(waste.HasUserWith(user.ID(userID)), waste.DateGTE(date), waste.HasTags()).
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sums := make(map[string]*models.TagReport)
+	report := make([]*models.TagReport, 0)
+	for _, model := range wastes {
+		for _, t := range model.Edges.Tags {
+			item, ok := sums[t.Name]
+			if !ok {
+				item = &models.TagReport{Tag: t.Name}
+				sums[t.Name] = item
+				report = append(report, item)
+			}
+			item.Sum += model.Cost
+		}
+	}
+
+	return report, nil
+}
+
+func (r *WasteRepository) GetReportBetweenDates(
+	ctx context.Context, userID int64, from time.Time, to time.Time,
+) ([]*models.CategoryReport, error) {
+	var report []*models.CategoryReport
+	err := r.client.Waste.Query().
+		Where(waste.HasUserWith(user.ID(userID)), waste.DateGTE(from), waste.DateLTE(to)).
+		GroupBy(waste.FieldCategory).
+		Aggregate(ent.Sum(waste.FieldCost)).
+		Scan(ctx, &report)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// ListWastes returns the wastes of the user matching the filter from the newest to the oldest
+// and the total amount of such wastes without limit and offset.
+func (r *WasteRepository) ListWastes(
+	ctx context.Context, userID int64, filter models.WasteFilter,
+) ([]*models.Waste, int, error) {
+	predicates := filterPredicates(userID, filter)
+
+	total, err := r.client.Waste.Query().
+		Where(predicates...).
+		Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := r.client.Waste.Query().
+		Where(predicates...).
+		WithTags().
+		Order(ent.Desc(waste.FieldDate)).
+		Offset(filter.Offset)
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	wastes, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*models.Waste, 0, len(wastes))
+	for _, v := range wastes {
+		result = append(result, &models.Waste{
+			Waste: v,
+		})
+	}
+
+	return result, total, nil
+}
+
+// SumOfFilteredWastes returns the sum of costs of all wastes of the user matching the filter,
+// limit and offset are not applied.
+func (r *WasteRepository) SumOfFilteredWastes(
+	ctx context.Context, userID int64, filter models.WasteFilter,
+) (int64, error) {
+	// wastes are grouped by the user to get the one row, there are no rows without wastes
+	var result []struct {
+		Sum        int64       `json:"sum"`
+		UserWastes interface{} `json:"user_wastes"`
+	}
+	err := r.client.Waste.Query().
+		Where(filterPredicates(userID, filter)...).
+		GroupBy(waste.UserColumn).
+		Aggregate(ent.Sum(waste.FieldCost)).
+		Scan(ctx, &result)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(result) == 0 {
+		return 0, nil
+	}
+
+	return result[0].Sum, nil
+}
+
+func filterPredicates(userID int64, filter models.WasteFilter) []predicate.Waste {
+	predicates := []predicate.Waste{waste.HasUserWith(user.ID(userID))}
+	if !filter.From.IsZero() {
+		predicates = append(predicates, waste.DateGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		predicates = append(predicates, waste.DateLTE(filter.To))
+	}
+	if filter.Category != "" {
+		predicates = append(predicates, waste.Category(filter.Category))
+	}
+	if filter.MinCost > 0 {
+		predicates = append(predicates, waste.CostGTE(filter.MinCost))
+	}
+	if filter.MaxCost > 0 {
+		predicates = append(predicates, waste.CostLTE(filter.MaxCost))
+	}
+	if filter.Text != "" {
+		predicates = append(predicates, waste.Or(
+			waste.CategoryContainsFold(filter.Text),
+			waste.NoteContainsFold(filter.Text),
+		))
+	}
+	if filter.Tag != "" {
+		predicates = append(predicates, waste.HasTagsWith(tag.Name(filter.Tag)))
+	}
+	if len(filter.ClaimStatuses) > 0 {
+		predicates = append(predicates, waste.ClaimStatusIn(filter.ClaimStatuses...))
+	}
+
+	return predicates
+}
+
+// UpdateWaste changes the cost, the category and the date of the waste of the user,
+// the entered amount is replaced by the original cost of the waste or cleared if it is not set,
+// so the waste is counted as entered in the default currency.
+func (r *WasteRepository) UpdateWaste(
 	ctx context.Context, userID int64, waste *models.Waste,
 ) (*models.Waste, error) {
-	model, err := r.client.Waste.
-		Create().
+	err := r.checkOwner(ctx, userID, waste.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := r.client.Waste.
+		UpdateOneID(waste.ID).
 		SetCost(waste.Cost).
 		SetCategory(waste.Category).
-		SetDate(waste.Date).
-		SetUserID(userID).
+		SetDate(waste.Date)
+	if waste.OriginalCost != nil && waste.Currency != nil {
+		update.SetOriginalCost(*waste.OriginalCost).SetCurrency(*waste.Currency)
+	} else {
+		update.ClearOriginalCost().ClearCurrency()
+	}
+
+	model, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Waste{
+		Waste: model,
+	}, nil
+}
+
+// UpdateClaimStatus changes the status of the reimbursable waste of the user if the waste has the status,
+// returns the amount of changed wastes.
+func (r *WasteRepository) UpdateClaimStatus(
+	ctx context.Context, userID int64, id uuid.UUID, from enums.ClaimStatus, to enums.ClaimStatus,
+) (int, error) {
+	return r.client.Waste.Update().
+		Where(waste.ID(id), waste.HasUserWith(user.ID(userID)), waste.ClaimStatus(from)).
+		SetClaimStatus(to).
 		Save(ctx)
+}
+
+func (r *WasteRepository) DeleteWaste(ctx context.Context, userID int64, id uuid.UUID) error {
+	err := r.checkOwner(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	return r.client.Waste.DeleteOneID(id).Exec(ctx)
+}
+
+// checkOwner returns ErrNotFound if the waste does not exist or belongs to another user.
+func (r *WasteRepository) checkOwner(ctx context.Context, userID int64, id uuid.UUID) error {
+	exists, err := r.client.Waste.Query().
+		Where(waste.ID(id), waste.HasUserWith(user.ID(userID))).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *WasteRepository) AddWasteToUser(
+	ctx context.Context, userID int64, waste *models.Waste,
+) (*models.Waste, error) {
+	tagIDs, err := getOrCreateTags(ctx, r.client, waste.TagNames())
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := createWaste(r.client.Waste, userID, waste, tagIDs).Save(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +438,62 @@ func (r *WasteRepository) AddWasteToUser(
 	}, nil
 }
 
+// createWaste returns the builder of the waste of the user, the client may belong to the transaction.
+func createWaste(client *ent.WasteClient, userID int64, waste *models.Waste, tagIDs []int) *ent.WasteCreate {
+	return client.
+		Create().
+		SetCost(waste.Cost).
+		SetCategory(waste.Category).
+		SetDate(waste.Date).
+		SetNillableCurrency(waste.Currency).
+		SetNillableOriginalCost(waste.OriginalCost).
+		SetNote(waste.Note).
+		SetNillableClaimStatus(waste.ClaimStatus).
+		AddTagIDs(tagIDs...).
+		SetUserID(userID)
+}
+
+// getOrCreateTags returns the identifiers of the tags, the missing tags are created.
+func getOrCreateTags(ctx context.Context, client *ent.Client, names []string) ([]int, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int, 0, len(names))
+	for _, name := range names {
+		id, err := client.Tag.Query().
+			Where(tag.Name(name)).
+			OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			id, err = createTag(ctx, client, name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get tag %q: %w", name, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// createTag creates the tag, the tag created concurrently by another request is returned.
+func createTag(ctx context.Context, client *ent.Client, name string) (int, error) {
+	created, err := client.Tag.Create().
+		SetName(name).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return client.Tag.Query().
+			Where(tag.Name(name)).
+			OnlyID(ctx)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return created.ID, nil
+}
+
 func (r *WasteRepository) SumOfWastesAfterDate(ctx context.Context, userID int64, date time.Time) (int64, error) {
 	var result []struct {
 		Sum        int64       `json:"sum"`
@@ -0,0 +1,73 @@
+package apitoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/repository"
+)
+
+const tokenLength = 32
+
+var ErrInvalidToken = errors.New("invalid api token")
+
+//go:generate mockery --name=userRepository --dir . --output ./mocks --exported
+type userRepository interface {
+	SetAPITokenHash(ctx context.Context, id int64, tokenHash string) error
+	GetUserIDByAPITokenHash(ctx context.Context, tokenHash string) (int64, error)
+}
+
+// Service issues api tokens for users and authenticates them,
+// only hashes of the tokens are stored in the repository.
+type Service struct {
+	userRepo userRepository
+}
+
+func NewService(userRepo userRepository) *Service {
+	return &Service{
+		userRepo: userRepo,
+	}
+}
+
+// Issue generates new token for the user, the previous token of the user becomes invalid.
+func (s *Service) Issue(ctx context.Context, userID int64) (string, error) {
+	raw := make([]byte, tokenLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	token := hex.EncodeToString(raw)
+
+	err := s.userRepo.SetAPITokenHash(ctx, userID, hashToken(token))
+	if err != nil {
+		return "", fmt.Errorf("failed to save token: %w", err)
+	}
+
+	return token, nil
+}
+
+// Authenticate returns the user of the token.
+func (s *Service) Authenticate(ctx context.Context, token string) (int64, error) {
+	if token == "" {
+		return 0, ErrInvalidToken
+	}
+
+	userID, err := s.userRepo.GetUserIDByAPITokenHash(ctx, hashToken(token))
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return 0, ErrInvalidToken
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user by token: %w", err)
+	}
+
+	return userID, nil
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,16 @@
+package apitoken
+
+import "context"
+
+type userIDKey struct{}
+
+// ContextWithUserID returns the context with authenticated user.
+func ContextWithUserID(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserIDFromContext returns the authenticated user of the context.
+func UserIDFromContext(ctx context.Context) (int64, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(int64)
+	return userID, ok
+}
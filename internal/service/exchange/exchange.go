@@ -3,81 +3,123 @@ package exchange
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
 )
 
 type Config struct {
-	Default            string `yaml:"default"`
-	DesignationDefault string `yaml:"designation_default"`
+	Default string `yaml:"default"`
 
-	Used            []string `yaml:"used"`
-	DesignationUsed []string `yaml:"designation_used"`
+	// Used are the currencies offered to all users, users can add other currencies to their lists.
+	Used []string `yaml:"used"`
 
 	UpdateTimeout time.Duration `yaml:"update_timeout"`
 	RetryTimeout  time.Duration `yaml:"retry_timeout"`
+
+	// StaleThreshold is the age of rates after which users are warned that rates are outdated.
+	StaleThreshold time.Duration `yaml:"stale_threshold"`
 }
 
-//go:generate mockery --name=exchangeClient --dir . --output ./mocks --exported
-type exchangeClient interface {
+// Provider is the source of exchange rates, providers are asked in order of priority.
+//
+//go:generate mockery --name=Provider --dir . --output ./mocks --exported
+type Provider interface {
+	Name() string
 	GetExchange(ctx context.Context, base string, symbols []string) (*models.ExchangeData, error)
 }
 
+//go:generate mockery --name=currencyRepository --dir . --output ./mocks --exported
+type currencyRepository interface {
+	GetUsedCurrencies(ctx context.Context) ([]string, error)
+}
+
+//go:generate mockery --name=rateRepository --dir . --output ./mocks --exported
+type rateRepository interface {
+	SaveRates(ctx context.Context, base string, rates map[string]money.Rate, updatedAt time.Time) error
+	GetRates(ctx context.Context, base string) (*models.ExchangeRates, error)
+}
+
 var (
-	ErrIncorrectConfig  = errors.New("different length of designations and currencies in a configs")
-	ErrCurrencyNotFound = errors.New("currency not found in repository")
-	ErrDataNotPrepared  = errors.New("currency exchange does not prepared")
+	ErrUnknownCurrency      = errors.New("unknown currency in a configs")
+	ErrNoProviders          = errors.New("no exchange providers")
+	ErrCurrencyNotFound     = errors.New("currency not found in repository")
+	ErrCurrencyNotSupported = errors.New("currency is not supported by exchange source")
 )
 
-// Service is updating data about exchange from external service each timeout.
+// Service is updating data about exchange from external service each timeout
+// for the currencies of the config and the currencies added by users.
+// The last received rates are stored, so the service works when the external service is down.
 type Service struct {
-	exchangeClient exchangeClient
-	config         Config
-	logger         log.Logger
-
-	data         map[string]float64
-	designations map[string]string
-	mutex        *sync.RWMutex
+	providers    []Provider
+	currencyRepo currencyRepository
+	rateRepo     rateRepository
+	config       Config
+	logger       log.Logger
+
+	// data is replaced on each update, so it can be read without the lock after it is taken
+	data map[string]money.Rate
+	// updatedAt is the time of the update of each rate, the rates missing in the answers
+	// of the providers keep the time of their last update
+	updatedAt map[string]time.Time
+	mutex     *sync.RWMutex
 
 	cancel context.CancelFunc
 	done   chan struct{}
 }
 
-func NewService(config Config, exchangeClient exchangeClient, logger log.Logger) (*Service, error) {
-	if len(config.Used) != len(config.DesignationUsed) {
-		return nil, ErrIncorrectConfig
+func NewService(
+	config Config,
+	providers []Provider,
+	currencyRepo currencyRepository,
+	rateRepo rateRepository,
+	logger log.Logger,
+) (*Service, error) {
+	if len(providers) == 0 {
+		return nil, ErrNoProviders
 	}
 
-	s := &Service{
-		exchangeClient: exchangeClient,
-		config:         config,
-		logger:         logger.With(log.ComponentKey, "Exchange service"),
-		mutex:          &sync.RWMutex{},
-	}
-
-	designations := make(map[string]string)
-	designations[config.Default] = config.DesignationDefault
-	for i := range config.Used {
-		designations[config.Used[i]] = config.DesignationUsed[i]
+	for _, currency := range append([]string{config.Default}, config.Used...) {
+		if _, ok := money.LookupCurrency(currency); !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCurrency, currency)
+		}
 	}
 
-	s.designations = designations
-
-	return s, nil
+	return &Service{
+		providers:    providers,
+		currencyRepo: currencyRepo,
+		rateRepo:     rateRepo,
+		config:       config,
+		logger:       logger.With(log.ComponentKey, "Exchange service"),
+		data:         map[string]money.Rate{config.Default: money.IdentityRate},
+		updatedAt:    make(map[string]time.Time),
+		mutex:        &sync.RWMutex{},
+	}, nil
 }
 
+// Start loads the stored rates and updates them, the failed update does not stop the start,
+// the stored rates are used until the next successful update.
 func (s *Service) Start() error {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	s.cancel = cancel
 	s.done = make(chan struct{})
 
-	go s.updateDataByTicker(ctx)
+	s.loadRates(ctx)
+
+	timeout := s.config.UpdateTimeout
+	if err := s.updateData(ctx); err != nil {
+		s.logger.WithError(err).Warn("failed to update exchanges data on start, stored rates are used")
+		timeout = s.config.RetryTimeout
+	}
+
+	go s.updateDataByTicker(ctx, timeout)
 
-	return s.updateData(ctx)
+	return nil
 }
 
 func (s *Service) Stop(ctx context.Context) error {
@@ -99,38 +141,83 @@ func (s *Service) GetUsedCurrencies() []string {
 	return s.config.Used
 }
 
+// GetDesignation returns the symbol of the currency shown to users.
 func (s *Service) GetDesignation(currency string) (string, error) {
-	s.mutex.RLock()
-	data := s.designations
-	s.mutex.RUnlock()
-
-	designation, ok := data[currency]
+	found, ok := money.LookupCurrency(currency)
 	if !ok {
 		return "", ErrCurrencyNotFound
 	}
 
-	return designation, nil
+	return found.Symbol, nil
 }
 
-func (s *Service) GetExchange(currency string) (float64, error) {
+// AddCurrency starts tracking the rate of the currency,
+// returns ErrCurrencyNotSupported if the exchange source does not know the currency.
+func (s *Service) AddCurrency(ctx context.Context, currency string) error {
+	if _, err := s.GetExchange(currency); err == nil {
+		return nil
+	}
+
+	rates, err := s.fetchRates(ctx, []string{currency})
+	if err != nil {
+		return err
+	}
+
+	rate, ok := rates[currency]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrCurrencyNotSupported, currency)
+	}
+
+	s.storeRates(map[string]money.Rate{currency: rate}, time.Now())
+	s.saveRates(ctx, map[string]money.Rate{currency: rate})
+
+	return nil
+}
+
+// UpdatedAt returns the time of the update of the oldest rate, so the rates are not shown as fresh
+// while any of them is missing in the answers of the providers, zero if rates have not been received yet.
+func (s *Service) UpdatedAt() time.Time {
 	s.mutex.RLock()
-	data := s.data
-	s.mutex.RUnlock()
+	defer s.mutex.RUnlock()
 
-	if data == nil {
-		return 0, ErrDataNotPrepared
+	var oldest time.Time
+	for _, updatedAt := range s.updatedAt {
+		if oldest.IsZero() || updatedAt.Before(oldest) {
+			oldest = updatedAt
+		}
+	}
+
+	return oldest
+}
+
+// RatesAge returns the time since the last successful update of rates
+// and whether it exceeds the stale threshold, zero if rates have not been received yet.
+func (s *Service) RatesAge() (time.Duration, bool) {
+	updatedAt := s.UpdatedAt()
+	if updatedAt.IsZero() {
+		return 0, false
 	}
 
+	age := time.Since(updatedAt)
+	return age, s.config.StaleThreshold > 0 && age > s.config.StaleThreshold
+}
+
+// GetExchange returns the rate of the currency to the default currency.
+func (s *Service) GetExchange(currency string) (money.Rate, error) {
+	s.mutex.RLock()
+	data := s.data
+	s.mutex.RUnlock()
+
 	exchange, ok := data[currency]
 	if !ok {
-		return 0, ErrCurrencyNotFound
+		return money.Rate{}, ErrCurrencyNotFound
 	}
 
 	return exchange, nil
 }
 
-func (s *Service) updateDataByTicker(ctx context.Context) {
-	ticker := time.NewTicker(s.config.UpdateTimeout)
+func (s *Service) updateDataByTicker(ctx context.Context, timeout time.Duration) {
+	ticker := time.NewTicker(timeout)
 
 	for {
 		select {
@@ -155,24 +242,162 @@ func (s *Service) updateDataByTicker(ctx context.Context) {
 func (s *Service) updateData(ctx context.Context) error {
 	s.logger.Info("try to update exchange data")
 
-	data, err := s.exchangeClient.GetExchange(ctx, s.config.Default, s.config.Used)
+	currencies, err := s.getCurrenciesInUse(ctx)
 	if err != nil {
 		return err
 	}
 
-	s.mutex.Lock()
-	if s.data == nil {
-		s.data = make(map[string]float64, len(data.Rates))
-	}
-	for key, value := range data.Rates {
-		s.data[key] = value
+	rates, err := s.fetchRates(ctx, currencies)
+	if err != nil {
+		return err
 	}
-	s.data[s.config.Default] = 1.0
-	s.mutex.Unlock()
+
+	s.storeRates(rates, time.Now())
+	s.saveRates(ctx, rates)
 
 	s.logger.
-		With("exchange data", data).
+		With("exchange data", rates).
 		Info("exchange data updated successfully")
 
 	return nil
 }
+
+// loadRates takes the stored rates, which are used until the first successful update.
+func (s *Service) loadRates(ctx context.Context) {
+	stored, err := s.rateRepo.GetRates(ctx, s.config.Default)
+	if err != nil {
+		s.logger.WithError(err).Warn("failed to load stored exchange rates")
+		return
+	}
+
+	if len(stored.Rates) == 0 {
+		return
+	}
+
+	// the stored time is the time of the oldest rate, so the loaded rates are not newer than they are
+	s.storeRates(stored.Rates, stored.UpdatedAt)
+
+	s.logger.
+		With("updated_at", stored.UpdatedAt).
+		Info("stored exchange rates loaded")
+}
+
+// storeRates replaces the data with the copy containing the rates,
+// the time of update is changed only for the passed rates.
+func (s *Service) storeRates(rates map[string]money.Rate, updatedAt time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	data := make(map[string]money.Rate, len(s.data)+len(rates))
+	for key, rate := range s.data {
+		data[key] = rate
+	}
+	for key, rate := range rates {
+		data[key] = rate
+		s.updatedAt[key] = updatedAt
+	}
+	data[s.config.Default] = money.IdentityRate
+	// the rate of the default currency is not received, it is always fresh
+	delete(s.updatedAt, s.config.Default)
+
+	s.data = data
+}
+
+// saveRates stores the rates for the next start, the failure only is logged,
+// because rates in memory are still valid.
+func (s *Service) saveRates(ctx context.Context, rates map[string]money.Rate) {
+	err := s.rateRepo.SaveRates(ctx, s.config.Default, rates, time.Now())
+	if err != nil {
+		s.logger.WithError(err).Warn("failed to save exchange rates")
+	}
+}
+
+// getCurrenciesInUse returns the union of the currencies of the config,
+// the currencies added by users and the currencies tracked since the last update.
+func (s *Service) getCurrenciesInUse(ctx context.Context) ([]string, error) {
+	added, err := s.currencyRepo.GetUsedCurrencies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get currencies of users: %w", err)
+	}
+
+	s.mutex.RLock()
+	tracked := make([]string, 0, len(s.data))
+	for currency := range s.data {
+		tracked = append(tracked, currency)
+	}
+	s.mutex.RUnlock()
+
+	seen := map[string]struct{}{s.config.Default: {}}
+	result := make([]string, 0, len(s.config.Used)+len(added)+len(tracked))
+	for _, list := range [][]string{s.config.Used, added, tracked} {
+		for _, currency := range list {
+			if _, ok := seen[currency]; ok {
+				continue
+			}
+
+			seen[currency] = struct{}{}
+			result = append(result, currency)
+		}
+	}
+
+	return result, nil
+}
+
+// fetchRates asks providers in order of priority, the currencies missing in the answer
+// of the provider are requested from the next one. The error is returned only if all providers failed.
+func (s *Service) fetchRates(ctx context.Context, currencies []string) (map[string]money.Rate, error) {
+	rates := make(map[string]money.Rate, len(currencies))
+	missing := currencies
+
+	var lastErr error
+	failed := 0
+	for _, provider := range s.providers {
+		if len(missing) == 0 {
+			break
+		}
+
+		data, err := provider.GetExchange(ctx, s.config.Default, missing)
+		if err != nil {
+			s.logger.WithError(err).
+				With("provider", provider.Name()).
+				Warn("failed to get exchange rates from provider")
+			lastErr = fmt.Errorf("provider %s: %w", provider.Name(), err)
+			failed++
+			continue
+		}
+
+		stillMissing := make([]string, 0, len(missing))
+		for _, currency := range missing {
+			value, ok := data.Rates[currency]
+			if !ok {
+				stillMissing = append(stillMissing, currency)
+				continue
+			}
+
+			rate, err := money.NewRate(value)
+			if err != nil {
+				s.logger.WithError(err).
+					With("provider", provider.Name()).
+					With("currency", currency).
+					Warn("skip invalid exchange rate")
+				stillMissing = append(stillMissing, currency)
+				continue
+			}
+
+			rates[currency] = rate
+		}
+		missing = stillMissing
+	}
+
+	if failed == len(s.providers) {
+		return nil, lastErr
+	}
+
+	if len(missing) > 0 {
+		s.logger.
+			With("currencies", missing).
+			Warn("exchange rates not found in any provider")
+	}
+
+	return rates, nil
+}
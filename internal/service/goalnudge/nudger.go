@@ -0,0 +1,336 @@
+package goalnudge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/ent"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
+)
+
+const dateLayout = "02.01.2006"
+
+// defaultCheckInterval is used if the interval is not set in the config.
+const defaultCheckInterval = time.Hour
+
+type Config struct {
+	CheckInterval time.Duration `yaml:"check_interval"`
+	// Day is the day of the month since which the nudges are sent.
+	Day int `yaml:"day"`
+	// DefaultCurrency is the currency of the stored amounts, it must be the same as in the bot.
+	DefaultCurrency string `yaml:"default_currency"`
+}
+
+//go:generate mockery --name=goalRepository --dir . --output ./mocks --exported
+type goalRepository interface {
+	GetActiveGoals(ctx context.Context, after time.Time, month string) ([]*models.Goal, error)
+	ClaimNudge(ctx context.Context, userID int64, month string) (bool, error)
+	ReleaseNudge(ctx context.Context, userID int64, month string) error
+}
+
+//go:generate mockery --name=wasteRepository --dir . --output ./mocks --exported
+type wasteRepository interface {
+	SumOfFilteredWastes(ctx context.Context, userID int64, filter models.WasteFilter) (int64, error)
+}
+
+//go:generate mockery --name=rateRepository --dir . --output ./mocks --exported
+type rateRepository interface {
+	GetRates(ctx context.Context, base string) (*models.ExchangeRates, error)
+}
+
+//go:generate mockery --name=userContextService --dir . --output ./mocks --exported
+type userContextService interface {
+	GetCurrency(ctx context.Context, userID int64) (string, error)
+}
+
+//go:generate mockery --name=telegramClient --dir . --output ./mocks --exported
+type telegramClient interface {
+	SendFormattedMessage(ctx context.Context, message *models.OutgoingMessage, command enums.CommandType) (int, error)
+}
+
+// Nudger sends the users the amounts to save for their goals once a month
+// and compares them with the income minus the wastes for the last month.
+// The month of the last nudge is stored for every user, so the users are not nudged again
+// after the restart and by the other replicas.
+type Nudger struct {
+	config             Config
+	goalRepo           goalRepository
+	wasteRepo          wasteRepository
+	rateRepo           rateRepository
+	userContextService userContextService
+	tgClient           telegramClient
+	formatter          *format.Formatter
+	logger             log.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewNudger(
+	config Config,
+	goalRepo goalRepository,
+	wasteRepo wasteRepository,
+	rateRepo rateRepository,
+	userContextService userContextService,
+	tgClient telegramClient,
+	formatter *format.Formatter,
+	logger log.Logger,
+) *Nudger {
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaultCheckInterval
+	}
+
+	return &Nudger{
+		config:             config,
+		goalRepo:           goalRepo,
+		wasteRepo:          wasteRepo,
+		rateRepo:           rateRepo,
+		userContextService: userContextService,
+		tgClient:           tgClient,
+		formatter:          formatter,
+		logger:             logger.With(log.ComponentKey, "Goal nudger"),
+	}
+}
+
+func (n *Nudger) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	n.cancel = cancel
+	n.done = make(chan struct{})
+
+	go n.run(ctx)
+
+	return nil
+}
+
+func (n *Nudger) Stop(ctx context.Context) error {
+	n.cancel()
+
+	select {
+	case <-n.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (n *Nudger) run(ctx context.Context) {
+	ticker := time.NewTicker(n.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := n.check(ctx, time.Now()); err != nil {
+				n.logger.WithError(err).Warn("failed to nudge users about goals")
+			}
+
+		case <-ctx.Done():
+			n.logger.WithError(ctx.Err()).Info("goal nudger has been stopped")
+			close(n.done)
+
+			return
+		}
+	}
+}
+
+// check sends the nudges to the users not nudged this month if the day has come.
+func (n *Nudger) check(ctx context.Context, now time.Time) error {
+	if now.Day() < n.config.Day {
+		return nil
+	}
+
+	month := now.Format("2006-01")
+	goals, err := n.goalRepo.GetActiveGoals(ctx, now, month)
+	if err != nil {
+		return fmt.Errorf("failed to get active goals: %w", err)
+	}
+
+	// the goals are ordered by the user
+	for start := 0; start < len(goals); {
+		end := start + 1
+		for end < len(goals) && goals[end].UserID == goals[start].UserID {
+			end++
+		}
+
+		if err := n.nudgeUser(ctx, goals[start].Edges.User, goals[start:end], now, month); err != nil {
+			n.logger.WithError(err).
+				With("user_id", goals[start].UserID).
+				Warn("failed to nudge user about goals")
+		}
+		start = end
+	}
+
+	return nil
+}
+
+// nudgeUser sends the nudge if the user is claimed for the month, the claim is released if sending fails.
+func (n *Nudger) nudgeUser(ctx context.Context, user *ent.User, goals []*models.Goal, now time.Time, month string) error {
+	claimed, err := n.goalRepo.ClaimNudge(ctx, user.ID, month)
+	if err != nil {
+		return fmt.Errorf("failed to claim goal nudge: %w", err)
+	}
+	if !claimed {
+		return nil
+	}
+
+	if err := n.sendNudge(ctx, user, goals, now, month); err != nil {
+		if releaseErr := n.goalRepo.ReleaseNudge(ctx, user.ID, month); releaseErr != nil {
+			n.logger.WithError(releaseErr).
+				With("user_id", user.ID).
+				Warn("failed to release goal nudge")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (n *Nudger) sendNudge(ctx context.Context, user *ent.User, goals []*models.Goal, now time.Time, month string) error {
+	localizer := i18n.New((&models.User{User: user}).GetLanguage())
+
+	currency, err := n.getCurrency(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	msg := n.formatter.NewMessage().Bold(localizer.Get(i18n.KeyGoalNudgeHeader))
+
+	var required int64
+	for _, goal := range goals {
+		monthly := goal.MonthlySaving(now)
+		required += monthly
+
+		converted, err := currency.convert(monthly)
+		if err != nil {
+			return fmt.Errorf("failed to convert monthly saving: %w", err)
+		}
+
+		msg.Line().Text(localizer.Getf(i18n.KeyGoalNudgeGoal, goal.Name, format.ProgressBar(goal.Percent()),
+			goal.Percent(), converted, goal.Deadline.Format(dateLayout)))
+	}
+
+	msg.Line().Line()
+	if user.Income == nil {
+		msg.Italic(localizer.Get(i18n.KeyGoalNoIncome))
+	} else if err := n.writeFreeMoney(ctx, msg, localizer, currency, user, required, now); err != nil {
+		return err
+	}
+
+	ctx = models.ContextWithIdempotencyKey(ctx, fmt.Sprintf("goal_nudge_%d_%s", user.ID, month))
+	ctx = models.ContextWithLanguage(ctx, localizer.Language())
+	_, err = n.tgClient.SendFormattedMessage(ctx, &models.OutgoingMessage{
+		UserID:    user.ID,
+		Text:      msg.String(),
+		ParseMode: n.formatter.ParseMode(),
+	}, enums.CommandTypeUnknown)
+	if err != nil {
+		return fmt.Errorf("failed to send goal nudge: %w", err)
+	}
+
+	return nil
+}
+
+// writeFreeMoney writes the income minus the wastes for the last month and whether it is enough for the goals.
+func (n *Nudger) writeFreeMoney(
+	ctx context.Context,
+	msg *format.Message,
+	localizer *i18n.Localizer,
+	currency *userCurrency,
+	user *ent.User,
+	required int64,
+	now time.Time,
+) error {
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	wastes, err := n.wasteRepo.SumOfFilteredWastes(ctx, user.ID, models.WasteFilter{
+		From: thisMonth.AddDate(0, -1, 0),
+		To:   thisMonth.Add(-time.Nanosecond),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get sum of wastes for last month: %w", err)
+	}
+
+	free := *user.Income - wastes
+
+	convertedFree, err := currency.convert(free)
+	if err != nil {
+		return fmt.Errorf("failed to convert free money: %w", err)
+	}
+	convertedRequired, err := currency.convert(required)
+	if err != nil {
+		return fmt.Errorf("failed to convert required saving: %w", err)
+	}
+
+	msg.Text(localizer.Getf(i18n.KeyGoalNudgeFree, convertedFree, convertedRequired)).Line()
+	if free >= required {
+		msg.Text(localizer.Get(i18n.KeyGoalNudgeEnough))
+
+		return nil
+	}
+
+	shortage, err := currency.convert(required - free)
+	if err != nil {
+		return fmt.Errorf("failed to convert shortage: %w", err)
+	}
+	msg.Text(localizer.Getf(i18n.KeyGoalNudgeShortage, shortage))
+
+	return nil
+}
+
+// userCurrency is the currency chosen by the user with its rate to the default currency.
+type userCurrency struct {
+	base        string
+	code        string
+	rate        money.Rate
+	designation string
+}
+
+// convert returns the stored amount in the currency of the user with its designation.
+func (c *userCurrency) convert(amount int64) (string, error) {
+	converted, err := money.New(amount, c.base).Exchange(c.rate, c.code, money.RoundHalfEven)
+	if err != nil {
+		return "", err
+	}
+
+	return converted.Decimal() + " " + c.designation, nil
+}
+
+// getCurrency returns the currency of the user, the default currency is used if there is no rate for it.
+func (n *Nudger) getCurrency(ctx context.Context, userID int64) (*userCurrency, error) {
+	code, err := n.userContextService.GetCurrency(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user currency: %w", err)
+	}
+
+	rate := money.IdentityRate
+	if code != n.config.DefaultCurrency {
+		rates, err := n.rateRepo.GetRates(ctx, n.config.DefaultCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get exchange rates: %w", err)
+		}
+
+		var ok bool
+		if rate, ok = rates.Rates[code]; !ok {
+			code, rate = n.config.DefaultCurrency, money.IdentityRate
+		}
+	}
+
+	designation := code
+	if currency, ok := money.LookupCurrency(code); ok {
+		designation = currency.Symbol
+	}
+
+	return &userCurrency{
+		base:        n.config.DefaultCurrency,
+		code:        code,
+		rate:        rate,
+		designation: designation,
+	}, nil
+}
@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/segmentio/kafka-go"
 
@@ -13,6 +14,8 @@ type ConsumerConfig struct {
 	BufferSize uint `yaml:"buffer_size"`
 }
 
+// Consumer fetches messages from kafka without committing them,
+// the receiver of the message must commit it after processing.
 type Consumer struct {
 	client *kafka.Reader
 	config ConsumerConfig
@@ -57,28 +60,37 @@ func (c *Consumer) Stop(ctx context.Context) error {
 }
 
 func (c *Consumer) run(ctx context.Context) {
+	defer func() {
+		c.logger.WithError(ctx.Err()).Info("kafka consumer has been closed")
+		close(c.messages)
+		close(c.done)
+	}()
+
 	for {
+		msg, err := c.client.FetchMessage(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			c.logger.
+				WithError(err).
+				Error("failed to fetch message from kafka")
+			continue
+		}
+
+		c.logger.With("kafka_message", msg).Debug("recieved message from Kafka")
+
 		select {
+		case c.messages <- &models.KafkaMessage{
+			Key:       msg.Key,
+			Message:   msg.Value,
+			Headers:   headersToMap(msg.Headers),
+			Topic:     msg.Topic,
+			Partition: msg.Partition,
+			Offset:    msg.Offset,
+		}:
 		case <-ctx.Done():
-			c.logger.WithError(ctx.Err()).Info("kafka consumer has been closed")
-			close(c.messages)
-			close(c.done)
-
-		default:
-			msg, err := c.client.ReadMessage(ctx)
-			if err != nil {
-				c.logger.
-					WithError(err).
-					Error("failed to read message from kafka")
-				continue
-			}
-
-			c.logger.With("kafka_message", msg).Debug("recieved message from Kafka")
-
-			c.messages <- &models.KafkaMessage{
-				Key:     msg.Key,
-				Message: msg.Value,
-			}
+			return
 		}
 	}
 }
@@ -86,3 +98,31 @@ func (c *Consumer) run(ctx context.Context) {
 func (c *Consumer) GetMessageChan() <-chan *models.KafkaMessage {
 	return c.messages
 }
+
+// CommitMessage commits the offset of the message for the consumer group,
+// does nothing if the reader is not a member of a consumer group.
+func (c *Consumer) CommitMessage(ctx context.Context, msg *models.KafkaMessage) error {
+	if c.client.Config().GroupID == "" {
+		return nil
+	}
+
+	err := c.client.CommitMessages(ctx, kafka.Message{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit the message to kafka: %w", err)
+	}
+
+	return nil
+}
+
+func headersToMap(headers []kafka.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+	for _, header := range headers {
+		result[header.Key] = string(header.Value)
+	}
+
+	return result
+}
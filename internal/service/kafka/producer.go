@@ -18,9 +18,22 @@ func NewProducer(client *kafka.Writer) *Producer {
 }
 
 func (p *Producer) SendMessage(ctx context.Context, key []byte, value []byte) error {
+	return p.SendMessageWithHeaders(ctx, key, value, nil)
+}
+
+func (p *Producer) SendMessageWithHeaders(ctx context.Context, key []byte, value []byte, headers map[string]string) error {
+	kafkaHeaders := make([]kafka.Header, 0, len(headers))
+	for k, v := range headers {
+		kafkaHeaders = append(kafkaHeaders, kafka.Header{
+			Key:   k,
+			Value: []byte(v),
+		})
+	}
+
 	err := p.client.WriteMessages(ctx, kafka.Message{
-		Key:   key,
-		Value: value,
+		Key:     key,
+		Value:   value,
+		Headers: kafkaHeaders,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to send the message to kafka: %w", err)
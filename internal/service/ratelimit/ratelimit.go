@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+)
+
+// tokenBucket takes a token from the bucket of KEYS[1] with capacity ARGV[1]
+// and refill rate ARGV[2] tokens per second at the time ARGV[3] in milliseconds.
+// Returns 1 and 0 if the token is taken, otherwise 0 and milliseconds to wait for the token.
+var tokenBucket = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local tokens = tonumber(redis.call("HGET", KEYS[1], "tokens"))
+local updated = tonumber(redis.call("HGET", KEYS[1], "updated"))
+if tokens == nil or updated == nil then
+	tokens = capacity
+	updated = now
+end
+
+tokens = math.min(capacity, tokens + math.max(0, now - updated) / 1000 * rate)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(capacity / rate * 1000))
+
+return {allowed, wait}
+`)
+
+type BucketConfig struct {
+	// Capacity is a maximum amount of commands in a burst.
+	Capacity int `yaml:"capacity"`
+	// Rate is an amount of commands per second restored in the bucket.
+	Rate float64 `yaml:"rate"`
+}
+
+type Config struct {
+	Buckets map[enums.CommandClass]BucketConfig `yaml:"buckets"`
+}
+
+// Service limits the rate of commands of every user by the token bucket
+// for every class of commands, buckets are stored in redis.
+type Service struct {
+	client *redis.Client
+	config Config
+}
+
+func NewService(client *redis.Client, config Config) *Service {
+	return &Service{
+		client: client,
+		config: config,
+	}
+}
+
+func getKey(userID int64, class enums.CommandClass) string {
+	return fmt.Sprintf("rate_limit_%d_%s", userID, class)
+}
+
+// Allow takes a token for the command of the user, if the limit is exceeded
+// returns false and the duration after which the command will be allowed.
+// Classes without configured bucket are not limited.
+func (s *Service) Allow(ctx context.Context, userID int64, class enums.CommandClass) (bool, time.Duration, error) {
+	bucket, ok := s.config.Buckets[class]
+	if !ok || bucket.Capacity <= 0 || bucket.Rate <= 0 {
+		return true, 0, nil
+	}
+
+	result, err := tokenBucket.Run(ctx, s.client,
+		[]string{getKey(userID, class)},
+		bucket.Capacity, bucket.Rate, time.Now().UnixMilli(),
+	).Int64Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to take token from the bucket: %w", err)
+	}
+
+	if len(result) != 2 {
+		return false, 0, fmt.Errorf("unexpected result of token bucket %v", result)
+	}
+
+	return result[0] == 1, time.Duration(result[1]) * time.Millisecond, nil
+}
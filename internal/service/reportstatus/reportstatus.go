@@ -0,0 +1,222 @@
+package reportstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/requests"
+)
+
+const (
+	fieldUserID       = "user_id"
+	fieldPeriod       = "period"
+	fieldStatus       = "status"
+	fieldLanguage     = "language"
+	fieldCreatedAt    = "created_at"
+	fieldUpdatedAt    = "updated_at"
+	fieldSlowNotified = "slow_notified"
+
+	pendingKey = "report_requests_pending"
+)
+
+var ErrRequestNotFound = errors.New("report request not found")
+
+// setExistingScript sets the fields of the request only if the request exists,
+// otherwise HSET would create the hash without expiration after the request has expired.
+var setExistingScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("HSET", KEYS[1], unpack(ARGV))
+return 1
+`)
+
+type Config struct {
+	Expiration time.Duration `yaml:"expiration"`
+}
+
+// Service stores statuses of the report requests in redis.
+//
+// Each request is a hash with expiration, requests are indexed by the user
+// and by the global set of pending requests which is checked by Watcher.
+type Service struct {
+	client *redis.Client
+	config Config
+}
+
+func NewService(client *redis.Client, config Config) *Service {
+	return &Service{
+		client: client,
+		config: config,
+	}
+}
+
+func getRequestKey(requestID string) string {
+	return fmt.Sprintf("report_request_%s", requestID)
+}
+
+func getUserKey(userID int64) string {
+	return fmt.Sprintf("report_requests_user_%d", userID)
+}
+
+// Create saves the new request with queued status.
+func (s *Service) Create(ctx context.Context, request *models.ReportRequest) error {
+	now := time.Now()
+	key := getRequestKey(request.ID)
+	userKey := getUserKey(request.UserID)
+
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			fieldUserID, request.UserID,
+			fieldPeriod, int(request.Period),
+			fieldStatus, string(enums.ReportStatusQueued),
+			fieldLanguage, string(request.Language),
+			fieldCreatedAt, now.Unix(),
+			fieldUpdatedAt, now.Unix(),
+		)
+		pipe.Expire(ctx, key, s.config.Expiration)
+		pipe.ZAdd(ctx, userKey, redis.Z{Score: float64(now.Unix()), Member: request.ID})
+		pipe.Expire(ctx, userKey, s.config.Expiration)
+		pipe.ZAdd(ctx, pendingKey, redis.Z{Score: float64(now.Unix()), Member: request.ID})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create report request: %w", err)
+	}
+
+	return nil
+}
+
+// SetStatus updates the status of the existing request.
+func (s *Service) SetStatus(ctx context.Context, requestID string, status enums.ReportStatus) error {
+	err := s.setExisting(ctx, requestID,
+		fieldStatus, string(status),
+		fieldUpdatedAt, time.Now().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set status of report request: %w", err)
+	}
+
+	return nil
+}
+
+// MarkSlowNotified remembers that the user has been notified about the slow report.
+func (s *Service) MarkSlowNotified(ctx context.Context, requestID string) error {
+	err := s.setExisting(ctx, requestID, fieldSlowNotified, 1)
+	if err != nil {
+		return fmt.Errorf("failed to mark report request as notified: %w", err)
+	}
+
+	return nil
+}
+
+// setExisting atomically sets the fields of the request, returns ErrRequestNotFound if it has expired.
+func (s *Service) setExisting(ctx context.Context, requestID string, values ...interface{}) error {
+	set, err := setExistingScript.Run(ctx, s.client, []string{getRequestKey(requestID)}, values...).Int()
+	if err != nil {
+		return err
+	}
+	if set == 0 {
+		return ErrRequestNotFound
+	}
+
+	return nil
+}
+
+func (s *Service) Get(ctx context.Context, requestID string) (*models.ReportRequest, error) {
+	values, err := s.client.HGetAll(ctx, getRequestKey(requestID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report request: %w", err)
+	}
+
+	if len(values) == 0 {
+		return nil, ErrRequestNotFound
+	}
+
+	return parseRequest(requestID, values)
+}
+
+// GetUserRequests returns the not expired requests of the user from the oldest to the newest.
+func (s *Service) GetUserRequests(ctx context.Context, userID int64) ([]*models.ReportRequest, error) {
+	userKey := getUserKey(userID)
+
+	ids, err := s.client.ZRange(ctx, userKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report requests of user: %w", err)
+	}
+
+	result := make([]*models.ReportRequest, 0, len(ids))
+	for _, id := range ids {
+		request, err := s.Get(ctx, id)
+		if errors.Is(err, ErrRequestNotFound) {
+			s.client.ZRem(ctx, userKey, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, request)
+	}
+
+	return result, nil
+}
+
+// GetPendingIDs returns identifiers of the requests which have not been checked by Watcher yet.
+func (s *Service) GetPendingIDs(ctx context.Context) ([]string, error) {
+	ids, err := s.client.ZRange(ctx, pendingKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending report requests: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (s *Service) RemovePending(ctx context.Context, requestID string) error {
+	err := s.client.ZRem(ctx, pendingKey, requestID).Err()
+	if err != nil {
+		return fmt.Errorf("failed to remove pending report request: %w", err)
+	}
+
+	return nil
+}
+
+func parseRequest(requestID string, values map[string]string) (*models.ReportRequest, error) {
+	userID, err := strconv.ParseInt(values[fieldUserID], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user of report request: %w", err)
+	}
+
+	period, err := strconv.Atoi(values[fieldPeriod])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse period of report request: %w", err)
+	}
+
+	createdAt, err := strconv.ParseInt(values[fieldCreatedAt], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse creation time of report request: %w", err)
+	}
+
+	updatedAt, err := strconv.ParseInt(values[fieldUpdatedAt], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse update time of report request: %w", err)
+	}
+
+	return &models.ReportRequest{
+		ID:           requestID,
+		UserID:       userID,
+		Period:       requests.Period(period),
+		Status:       enums.ReportStatus(values[fieldStatus]),
+		Language:     enums.Language(values[fieldLanguage]),
+		CreatedAt:    time.Unix(createdAt, 0),
+		UpdatedAt:    time.Unix(updatedAt, 0),
+		SlowNotified: values[fieldSlowNotified] == "1",
+	}, nil
+}
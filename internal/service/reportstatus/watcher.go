@@ -0,0 +1,182 @@
+package reportstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/requests"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
+)
+
+var periodDescriptions = map[requests.Period]i18n.Key{
+	requests.PeriodWeek:   i18n.KeyPeriodLastWeek,
+	requests.PeriodMonth:  i18n.KeyPeriodLastMonth,
+	requests.PeriodYear:   i18n.KeyPeriodLastYear,
+	requests.PeriodClaims: i18n.KeyPeriodClaims,
+}
+
+// defaultCheckInterval is used if the interval is not set in the config.
+const defaultCheckInterval = 10 * time.Second
+
+// defaultSlowThreshold is used if the threshold is not set in the config,
+// otherwise the user is told about the slow report as soon as it is queued.
+const defaultSlowThreshold = time.Minute
+
+type WatcherConfig struct {
+	CheckInterval time.Duration `yaml:"check_interval"`
+	SlowThreshold time.Duration `yaml:"slow_threshold"`
+}
+
+//go:generate mockery --name=statusService --dir . --output ./mocks --exported
+type statusService interface {
+	Get(ctx context.Context, requestID string) (*models.ReportRequest, error)
+	GetPendingIDs(ctx context.Context) ([]string, error)
+	RemovePending(ctx context.Context, requestID string) error
+	MarkSlowNotified(ctx context.Context, requestID string) error
+}
+
+//go:generate mockery --name=telegramClient --dir . --output ./mocks --exported
+type telegramClient interface {
+	SendMessage(ctx context.Context, userID int64, text string) error
+}
+
+// Watcher checks the pending report requests and notifies users
+// if their report failed or is taking too long.
+type Watcher struct {
+	config    WatcherConfig
+	service   statusService
+	tgClient  telegramClient
+	formatter *format.Formatter
+	logger    log.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewWatcher(
+	config WatcherConfig,
+	service statusService,
+	tgClient telegramClient,
+	formatter *format.Formatter,
+	logger log.Logger,
+) *Watcher {
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaultCheckInterval
+	}
+	if config.SlowThreshold <= 0 {
+		config.SlowThreshold = defaultSlowThreshold
+	}
+
+	return &Watcher{
+		config:    config,
+		service:   service,
+		tgClient:  tgClient,
+		formatter: formatter,
+		logger:    logger.With(log.ComponentKey, "Report status watcher"),
+	}
+}
+
+func (w *Watcher) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go w.run(ctx)
+
+	return nil
+}
+
+func (w *Watcher) Stop(ctx context.Context) error {
+	w.cancel()
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Watcher) run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := w.check(ctx); err != nil {
+				w.logger.WithError(err).Warn("failed to check pending report requests")
+			}
+
+		case <-ctx.Done():
+			w.logger.WithError(ctx.Err()).Info("report status watcher has been stopped")
+			close(w.done)
+
+			return
+		}
+	}
+}
+
+func (w *Watcher) check(ctx context.Context) error {
+	ids, err := w.service.GetPendingIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := w.checkRequest(ctx, id); err != nil {
+			w.logger.WithError(err).
+				With("request_id", id).
+				Warn("failed to check report request")
+		}
+	}
+
+	return nil
+}
+
+func (w *Watcher) checkRequest(ctx context.Context, id string) error {
+	request, err := w.service.Get(ctx, id)
+	if errors.Is(err, ErrRequestNotFound) {
+		return w.service.RemovePending(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	localizer := i18n.New(request.Language)
+	period := localizer.Get(periodDescriptions[request.Period])
+
+	switch request.Status {
+	case enums.ReportStatusDelivered:
+		return w.service.RemovePending(ctx, id)
+
+	case enums.ReportStatusFailed:
+		err := w.tgClient.SendMessage(ctx, request.UserID,
+			w.formatter.Text(localizer.Getf(i18n.KeyReportFailed, period)))
+		if err != nil {
+			return fmt.Errorf("failed to notify user about failed report: %w", err)
+		}
+
+		return w.service.RemovePending(ctx, id)
+
+	default:
+		if request.SlowNotified || time.Since(request.CreatedAt) < w.config.SlowThreshold {
+			return nil
+		}
+
+		err := w.tgClient.SendMessage(ctx, request.UserID,
+			w.formatter.Text(localizer.Getf(i18n.KeyReportSlow, period)))
+		if err != nil {
+			return fmt.Errorf("failed to notify user about slow report: %w", err)
+		}
+
+		return w.service.MarkSlowNotified(ctx, id)
+	}
+}
@@ -11,6 +11,7 @@ import (
 const (
 	userContext  = "usercontext"
 	userCurrency = "usercurrency"
+	searchQuery  = "searchquery"
 )
 
 type Service struct {
@@ -84,3 +85,26 @@ func (s *Service) GetCurrency(ctx context.Context, userID int64) (string, error)
 
 	return currency, nil
 }
+
+// SetSearchQuery saves the last search query of the user to show other pages of its results.
+func (s *Service) SetSearchQuery(ctx context.Context, userID int64, query string) error {
+	err := s.client.Set(ctx, getKey(userID, searchQuery), query, 0).Err()
+	if err != nil {
+		return fmt.Errorf("failed to set search query: %w", err)
+	}
+
+	return nil
+}
+
+// GetSearchQuery returns the last search query of the user, empty if the user has not searched yet.
+func (s *Service) GetSearchQuery(ctx context.Context, userID int64) (string, error) {
+	query, err := s.client.Get(ctx, getKey(userID, searchQuery)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get search query: %w", err)
+	}
+
+	return query, nil
+}
@@ -0,0 +1,166 @@
+package wastereport
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/requests"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
+)
+
+const (
+	claimDateLayout     = "02.01.2006"
+	claimFileDateLayout = "2006-01-02"
+)
+
+var claimStatusKeys = map[enums.ClaimStatus]i18n.Key{
+	enums.ClaimStatusPending:    i18n.KeyClaimStatusPending,
+	enums.ClaimStatusClaimed:    i18n.KeyClaimStatusClaimed,
+	enums.ClaimStatusReimbursed: i18n.KeyClaimStatusReimbursed,
+}
+
+// sendClaimReport sends the CSV document with reimbursable wastes between the dates of the request,
+// the document can be handed to accounting.
+func (s *Service) sendClaimReport(ctx context.Context, req requests.GetReport) error {
+	localizer := i18n.New(req.Language)
+
+	wastes, _, err := s.wasteRepo.ListWastes(ctx, req.UserID, models.WasteFilter{
+		From: req.From,
+		To:   req.To,
+		ClaimStatuses: []enums.ClaimStatus{
+			enums.ClaimStatusPending,
+			enums.ClaimStatusClaimed,
+			enums.ClaimStatusReimbursed,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get reimbursable wastes from repository: %w", err)
+	}
+
+	// the report is sent once even if the request is retried after lost response of the bot
+	if req.RequestID != "" {
+		ctx = models.ContextWithIdempotencyKey(ctx, "report_"+req.RequestID)
+	}
+	ctx = models.ContextWithLanguage(ctx, req.Language)
+
+	if len(wastes) == 0 {
+		_, err = s.tgClient.SendFormattedMessage(ctx, &models.OutgoingMessage{
+			UserID:    req.UserID,
+			Text:      s.formatter.Text(localizer.Get(i18n.KeyClaimsNotFound)),
+			ParseMode: s.formatter.ParseMode(),
+		}, enums.CommandTypeUnknown)
+		return wrapSendError(err)
+	}
+
+	rate, err := s.getRate(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	content, total, err := generateClaimCSV(wastes, rate, req, localizer)
+	if err != nil {
+		return fmt.Errorf("failed to generate claim report: %w", err)
+	}
+
+	from, to := req.From.Format(claimDateLayout), req.To.Format(claimDateLayout)
+	_, err = s.tgClient.SendDocument(ctx, &models.OutgoingFile{
+		UserID: req.UserID,
+		FileName: fmt.Sprintf("claims_%s_%s.csv",
+			req.From.Format(claimFileDateLayout), req.To.Format(claimFileDateLayout)),
+		Content: content,
+		Caption: s.formatter.Text(localizer.Getf(i18n.KeyClaimReportCaption,
+			from, to, total.Decimal()+" "+req.CurrencyDesignation)),
+		ParseMode: s.formatter.ParseMode(),
+	})
+
+	return wrapSendError(err)
+}
+
+// generateClaimCSV returns the CSV document from the oldest waste to the newest one
+// and the total amount in the currency of the report, the total is the sum of the amounts in the rows.
+func generateClaimCSV(
+	wastes []*models.Waste,
+	rate money.Rate,
+	req requests.GetReport,
+	localizer *i18n.Localizer,
+) ([]byte, money.Money, error) {
+	sort.SliceStable(wastes, func(i, j int) bool {
+		return wastes[i].Date.Before(wastes[j].Date)
+	})
+
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+
+	err := writer.Write([]string{
+		localizer.Get(i18n.KeyClaimColumnDate),
+		localizer.Get(i18n.KeyClaimColumnCategory),
+		localizer.Get(i18n.KeyClaimColumnNote),
+		localizer.Getf(i18n.KeyClaimColumnAmount, req.Currency),
+		localizer.Get(i18n.KeyClaimColumnEntered),
+		localizer.Get(i18n.KeyClaimColumnCurrency),
+		localizer.Get(i18n.KeyClaimColumnStatus),
+	})
+	if err != nil {
+		return nil, money.Money{}, err
+	}
+
+	total := money.New(0, req.Currency)
+	for _, waste := range wastes {
+		converted, err := convertToCurrency(waste.Cost, rate, req.BaseCurrency, req.Currency)
+		if err != nil {
+			return nil, money.Money{}, err
+		}
+		if total, err = total.Add(converted); err != nil {
+			return nil, money.Money{}, err
+		}
+
+		// the wastes without the original currency are entered in the default currency
+		entered := money.New(waste.Cost, req.BaseCurrency)
+		if waste.Currency != nil && waste.OriginalCost != nil {
+			entered = money.New(*waste.OriginalCost, *waste.Currency)
+		}
+
+		status := ""
+		if waste.ClaimStatus != nil {
+			status = localizer.Get(claimStatusKeys[*waste.ClaimStatus])
+		}
+
+		err = writer.Write([]string{
+			waste.Date.Format(claimDateLayout),
+			escapeCSVFormula(waste.Category),
+			escapeCSVFormula(waste.Note),
+			converted.Decimal(),
+			entered.Decimal(),
+			entered.Currency,
+			status,
+		})
+		if err != nil {
+			return nil, money.Money{}, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, money.Money{}, err
+	}
+
+	return buf.Bytes(), total, nil
+}
+
+// escapeCSVFormula prefixes the text entered by the user with the quote if the spreadsheet
+// would take it as the formula, the tab and the carriage return are escaped too,
+// because spreadsheets skip them before the formula.
+func escapeCSVFormula(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+
+	return text
+}
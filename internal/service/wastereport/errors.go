@@ -0,0 +1,52 @@
+package wastereport
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Permanent errors, the request with such errors will not be retried
+// and will be forwarded to the dead letter topic.
+var (
+	ErrInvalidMessage   = errors.New("invalid report request message")
+	ErrUnexpectedPeriod = errors.New("unexpected type of period")
+	ErrRejectedByBot    = errors.New("report is rejected by telegram bot")
+)
+
+// ErrRetriesExhausted is returned when transient error has been retried configured amount of times.
+var ErrRetriesExhausted = errors.New("retries are exhausted")
+
+func isPermanent(err error) bool {
+	return errors.Is(err, ErrInvalidMessage) ||
+		errors.Is(err, ErrUnexpectedPeriod) ||
+		errors.Is(err, ErrRejectedByBot)
+}
+
+// isPermanentGrpcCode returns true if the bot will respond with the same error for the same request.
+func isPermanentGrpcCode(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied,
+		codes.FailedPrecondition, codes.OutOfRange, codes.Unimplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+// failureReason is a short reason of failure which is written to the dead letter message headers.
+func failureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrInvalidMessage):
+		return "invalid_message"
+	case errors.Is(err, ErrUnexpectedPeriod):
+		return "unexpected_period"
+	case errors.Is(err, ErrRejectedByBot):
+		return "rejected_by_bot"
+	case errors.Is(err, ErrRetriesExhausted):
+		return "retries_exhausted"
+	default:
+		return "unknown"
+	}
+}
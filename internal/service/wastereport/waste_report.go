@@ -3,40 +3,91 @@ package wastereport
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
 
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/format"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/i18n"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/enums"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/models/requests"
+	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/internal/money"
 	"gitlab.ozon.dev/stepanov.ao.dev/telegram-bot/pkg/log"
 )
 
-const convertToMainCurrency = 100.0
-const messageWasteNotFound = "Траты за указанный период не найдены"
-
 //go:generate mockery --name=wasteRepository --dir . --output ./mocks --exported
 type wasteRepository interface {
 	GetReportLastWeek(ctx context.Context, userID int64) ([]*models.CategoryReport, error)
 	GetReportLastMonth(ctx context.Context, userID int64) ([]*models.CategoryReport, error)
 	GetReportLastYear(ctx context.Context, userID int64) ([]*models.CategoryReport, error)
+	GetCurrencyReportLastWeek(ctx context.Context, userID int64) ([]*models.CurrencyReport, error)
+	GetCurrencyReportLastMonth(ctx context.Context, userID int64) ([]*models.CurrencyReport, error)
+	GetCurrencyReportLastYear(ctx context.Context, userID int64) ([]*models.CurrencyReport, error)
+	GetReportByTagLastWeek(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error)
+	GetReportByTagLastMonth(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error)
+	GetReportByTagLastYear(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error)
+	GetTagReportLastWeek(ctx context.Context, userID int64) ([]*models.TagReport, error)
+	GetTagReportLastMonth(ctx context.Context, userID int64) ([]*models.TagReport, error)
+	GetTagReportLastYear(ctx context.Context, userID int64) ([]*models.TagReport, error)
+	ListWastes(ctx context.Context, userID int64, filter models.WasteFilter) ([]*models.Waste, int, error)
+}
+
+//go:generate mockery --name=rateRepository --dir . --output ./mocks --exported
+type rateRepository interface {
+	GetRates(ctx context.Context, base string) (*models.ExchangeRates, error)
 }
 
 //go:generate mockery --name=consumerMessages --dir . --output ./mocks --exported
 type consumerMessages interface {
 	GetMessageChan() <-chan *models.KafkaMessage
+	CommitMessage(ctx context.Context, msg *models.KafkaMessage) error
 }
 
 //go:generate mockery --name=telegramClient --dir . --output ./mocks --exported
 type telegramClient interface {
-	SendMessage(ctx context.Context, userID int64, text string, command enums.CommandType) error
+	SendFormattedMessage(ctx context.Context, message *models.OutgoingMessage, command enums.CommandType) (int, error)
+	SendDocument(ctx context.Context, file *models.OutgoingFile) (int, error)
+}
+
+//go:generate mockery --name=deadLetterProducer --dir . --output ./mocks --exported
+type deadLetterProducer interface {
+	SendMessageWithHeaders(ctx context.Context, key []byte, value []byte, headers map[string]string) error
+}
+
+//go:generate mockery --name=statusService --dir . --output ./mocks --exported
+type statusService interface {
+	SetStatus(ctx context.Context, requestID string, status enums.ReportStatus) error
+}
+
+// The defaults are used if the retries are not set in the config.
+const (
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 30 * time.Second
+)
+
+type Config struct {
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+	// ParseMode must be the same as the parse mode of the bot,
+	// because the cached report is sent by the bot again.
+	ParseMode enums.ParseMode `yaml:"parse_mode"`
 }
 
 type Service struct {
-	consumer  consumerMessages
-	wasteRepo wasteRepository
-	tgClient  telegramClient
+	config     Config
+	consumer   consumerMessages
+	wasteRepo  wasteRepository
+	rateRepo   rateRepository
+	tgClient   telegramClient
+	deadLetter deadLetterProducer
+	status     statusService
+	formatter  *format.Formatter
 
 	logger log.Logger
 
@@ -44,11 +95,39 @@ type Service struct {
 	done   chan struct{}
 }
 
-func NewService(consumer consumerMessages, wasteRepo wasteRepository, tgClient telegramClient, logger log.Logger) *Service {
+func NewService(
+	config Config,
+	consumer consumerMessages,
+	wasteRepo wasteRepository,
+	rateRepo rateRepository,
+	tgClient telegramClient,
+	deadLetter deadLetterProducer,
+	status statusService,
+	formatter *format.Formatter,
+	logger log.Logger,
+) *Service {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaultInitialBackoff
+	}
+	if config.MaxBackoff < config.InitialBackoff {
+		config.MaxBackoff = defaultMaxBackoff
+		if config.MaxBackoff < config.InitialBackoff {
+			config.MaxBackoff = config.InitialBackoff
+		}
+	}
+
 	return &Service{
-		consumer:  consumer,
-		wasteRepo: wasteRepo,
-		tgClient:  tgClient,
+		config:     config,
+		consumer:   consumer,
+		wasteRepo:  wasteRepo,
+		rateRepo:   rateRepo,
+		tgClient:   tgClient,
+		deadLetter: deadLetter,
+		status:     status,
+		formatter:  formatter,
 
 		logger: logger.With(log.ComponentKey, "Waste report"),
 	}
@@ -79,16 +158,12 @@ func (s *Service) Stop(ctx context.Context) error {
 func (s *Service) run(ctx context.Context) {
 	for {
 		select {
-		case msg := <-s.consumer.GetMessageChan():
-			var req requests.GetReport
-			err := req.UnmarshalJSON(msg.Message)
-			if err != nil {
-				s.logger.
-					WithError(err).
-					With("recieved message", msg).
-					Warn("failed to unmarshall message")
+		case msg, ok := <-s.consumer.GetMessageChan():
+			if !ok {
+				continue
 			}
-			s.sendReport(ctx, req)
+
+			s.processMessage(ctx, msg)
 
 		case <-ctx.Done():
 			s.logger.WithError(ctx.Err()).Info("waste report service has been closed")
@@ -102,85 +177,527 @@ func (s *Service) run(ctx context.Context) {
 	}
 }
 
-func (s *Service) sendReport(ctx context.Context, req requests.GetReport) {
-	var report []*models.CategoryReport
-	var err error
-	var command enums.CommandType
+// processMessage sends the report and commits the message,
+// the message which can not be processed is forwarded to the dead letter topic.
+func (s *Service) processMessage(ctx context.Context, msg *models.KafkaMessage) {
+	var req requests.GetReport
+	attempts := 0
+
+	err := req.UnmarshalJSON(msg.Message)
+	if err != nil {
+		err = fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	} else {
+		s.setStatus(ctx, req.RequestID, enums.ReportStatusProcessing)
+		attempts, err = s.sendReportWithRetries(ctx, req)
+	}
+
+	if ctx.Err() != nil {
+		// the message is not committed and will be received again after restart
+		return
+	}
+
+	if err != nil {
+		s.logger.
+			WithError(err).
+			With("recieved message", msg).
+			Error("failed to process the message, forwarding to the dead letter topic")
+
+		s.setStatus(ctx, req.RequestID, enums.ReportStatusFailed)
+
+		err = s.sendToDeadLetterWithRetries(ctx, msg, attempts, err)
+		if err != nil {
+			// the service is stopped, the message is not committed and will be received again after restart
+			return
+		}
+	} else {
+		s.setStatus(ctx, req.RequestID, enums.ReportStatusDelivered)
+	}
+
+	err = s.consumer.CommitMessage(ctx, msg)
+	if err != nil {
+		s.logger.
+			WithError(err).
+			With("recieved message", msg).
+			Error("failed to commit the message")
+	}
+}
+
+// sendReportWithRetries returns the amount of attempts to send the report and the last error.
+func (s *Service) sendReportWithRetries(ctx context.Context, req requests.GetReport) (int, error) {
+	backoff := s.config.InitialBackoff
+	attempt := 1
+	for ; ; attempt++ {
+		err := s.sendReport(ctx, req)
+		if err == nil || isPermanent(err) {
+			return attempt, err
+		}
+
+		if attempt >= s.config.MaxAttempts {
+			return attempt, fmt.Errorf("%w after %d attempts: %v", ErrRetriesExhausted, attempt, err)
+		}
+
+		s.logger.
+			WithError(err).
+			With("report request", req).
+			Warnf("failed to send the report, retry in %s", backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		}
+
+		backoff = s.nextBackoff(backoff)
+	}
+}
+
+// sendToDeadLetterWithRetries forwards the message to the dead letter topic until it succeeds,
+// because the next committed message would commit the offset of the lost one.
+// The error is returned only if the context is cancelled.
+func (s *Service) sendToDeadLetterWithRetries(
+	ctx context.Context, msg *models.KafkaMessage, attempts int, reason error,
+) error {
+	backoff := s.config.InitialBackoff
+	for {
+		err := s.sendToDeadLetter(ctx, msg, attempts, reason)
+		if err == nil {
+			return nil
+		}
 
-	switch req.Period {
+		s.logger.
+			WithError(err).
+			With("recieved message", msg).
+			Errorf("failed to forward the message to the dead letter topic, retry in %s", backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		backoff = s.nextBackoff(backoff)
+	}
+}
+
+// nextBackoff doubles the backoff up to the maximum one.
+func (s *Service) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > s.config.MaxBackoff {
+		backoff = s.config.MaxBackoff
+	}
+
+	return backoff
+}
+
+// setStatus updates the status of the request, the requests without identifier are not tracked.
+func (s *Service) setStatus(ctx context.Context, requestID string, status enums.ReportStatus) {
+	if requestID == "" {
+		return
+	}
+
+	err := s.status.SetStatus(ctx, requestID, status)
+	if err != nil {
+		s.logger.
+			WithError(err).
+			With("request_id", requestID).
+			With("status", status).
+			Warn("failed to update status of the report request")
+	}
+}
+
+func (s *Service) sendToDeadLetter(ctx context.Context, msg *models.KafkaMessage, attempts int, reason error) error {
+	headers := map[string]string{
+		models.HeaderFailureReason:     failureReason(reason),
+		models.HeaderFailureError:      reason.Error(),
+		models.HeaderAttempts:          strconv.Itoa(attempts),
+		models.HeaderOriginalTopic:     msg.Topic,
+		models.HeaderOriginalPartition: strconv.Itoa(msg.Partition),
+		models.HeaderOriginalOffset:    strconv.FormatInt(msg.Offset, 10),
+	}
+
+	return s.deadLetter.SendMessageWithHeaders(ctx, msg.Key, msg.Message, headers)
+}
+
+// periodRepository is the set of methods of the repository for the period of the report.
+type periodRepository struct {
+	command     enums.CommandType
+	report      func(ctx context.Context, userID int64) ([]*models.CategoryReport, error)
+	currencies  func(ctx context.Context, userID int64) ([]*models.CurrencyReport, error)
+	reportByTag func(ctx context.Context, userID int64, tag string) ([]*models.CategoryReport, error)
+	tags        func(ctx context.Context, userID int64) ([]*models.TagReport, error)
+}
+
+func (s *Service) getPeriodRepository(period requests.Period) (*periodRepository, error) {
+	switch period {
 	case requests.PeriodWeek:
-		report, err = s.wasteRepo.GetReportLastWeek(ctx, req.UserID)
-		command = enums.CommandTypeWeekReport
+		return &periodRepository{
+			command:     enums.CommandTypeWeekReport,
+			report:      s.wasteRepo.GetReportLastWeek,
+			currencies:  s.wasteRepo.GetCurrencyReportLastWeek,
+			reportByTag: s.wasteRepo.GetReportByTagLastWeek,
+			tags:        s.wasteRepo.GetTagReportLastWeek,
+		}, nil
 	case requests.PeriodMonth:
-		report, err = s.wasteRepo.GetReportLastMonth(ctx, req.UserID)
-		command = enums.CommandTypeMonthReport
+		return &periodRepository{
+			command:     enums.CommandTypeMonthReport,
+			report:      s.wasteRepo.GetReportLastMonth,
+			currencies:  s.wasteRepo.GetCurrencyReportLastMonth,
+			reportByTag: s.wasteRepo.GetReportByTagLastMonth,
+			tags:        s.wasteRepo.GetTagReportLastMonth,
+		}, nil
 	case requests.PeriodYear:
-		report, err = s.wasteRepo.GetReportLastYear(ctx, req.UserID)
-		command = enums.CommandTypeYearReport
+		return &periodRepository{
+			command:     enums.CommandTypeYearReport,
+			report:      s.wasteRepo.GetReportLastYear,
+			currencies:  s.wasteRepo.GetCurrencyReportLastYear,
+			reportByTag: s.wasteRepo.GetReportByTagLastYear,
+			tags:        s.wasteRepo.GetTagReportLastYear,
+		}, nil
 	default:
-		s.logger.With("report request", req).Warn("unexpected type of period")
-		return
+		return nil, fmt.Errorf("%w: %d", ErrUnexpectedPeriod, period)
+	}
+}
+
+func (s *Service) sendReport(ctx context.Context, req requests.GetReport) error {
+	if req.Period == requests.PeriodClaims {
+		return s.sendClaimReport(ctx, req)
 	}
 
+	repo, err := s.getPeriodRepository(req.Period)
 	if err != nil {
-		s.logger.WithError(err).Error("failed to get the report from repository")
+		return err
 	}
 
-	msg := ""
-	if len(report) == 0 {
-		msg = messageWasteNotFound
+	command := repo.command
+	if req.NotCached {
+		command = enums.CommandTypeUnknown
+	}
+
+	var msg string
+	if req.GroupByTag {
+		msg, err = s.generateTagReport(ctx, repo, req)
 	} else {
-		stringReport, err := s.generateStringReport(report, req.Period, req.CurrencyExchange, req.CurrencyDesignation)
+		msg, err = s.generateCategoryReport(ctx, repo, req)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the report is sent once even if the request is retried after lost response of the bot
+	if req.RequestID != "" {
+		ctx = models.ContextWithIdempotencyKey(ctx, "report_"+req.RequestID)
+	}
+	ctx = models.ContextWithLanguage(ctx, req.Language)
+
+	_, err = s.tgClient.SendFormattedMessage(ctx, &models.OutgoingMessage{
+		UserID:    req.UserID,
+		Text:      msg,
+		ParseMode: s.formatter.ParseMode(),
+	}, command)
+
+	return wrapSendError(err)
+}
+
+// wrapSendError marks the errors of the bot that must not be retried.
+func wrapSendError(err error) error {
+	if err != nil && isPermanentGrpcCode(err) {
+		return fmt.Errorf("%w: %v", ErrRejectedByBot, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to send the message: %w", err)
+	}
+
+	return nil
+}
+
+// generateCategoryReport returns the report grouped by categories,
+// only wastes with the tag are counted if the tag is set.
+func (s *Service) generateCategoryReport(ctx context.Context, repo *periodRepository, req requests.GetReport) (string, error) {
+	var report []*models.CategoryReport
+	var currencyReport []*models.CurrencyReport
+	var err error
+
+	if req.Tag != "" {
+		// the sums in the entered currencies are shown only for all wastes
+		report, err = repo.reportByTag(ctx, req.UserID, req.Tag)
+	} else {
+		report, err = repo.report(ctx, req.UserID)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get the report from repository: %w", err)
+	}
+
+	if len(report) == 0 {
+		return s.formatter.Text(i18n.New(req.Language).Get(i18n.KeyWasteNotFound)), nil
+	}
+
+	if req.Tag == "" {
+		currencyReport, err = repo.currencies(ctx, req.UserID)
 		if err != nil {
-			s.logger.WithError(err).Error("failed to generate string report")
+			return "", fmt.Errorf("failed to get the report by currencies from repository: %w", err)
 		}
+	}
 
-		msg = stringReport
+	rate, err := s.getRate(ctx, req)
+	if err != nil {
+		return "", err
 	}
 
-	err = s.tgClient.SendMessage(ctx, req.UserID, msg, command)
+	msg, err := s.generateStringReport(report, currencyReport, rate, req)
 	if err != nil {
-		s.logger.WithError(err).Error("failed to send the message")
+		return "", fmt.Errorf("failed to generate string report: %w", err)
 	}
+
+	return msg, nil
 }
 
-func (s *Service) generateStringReport(
-	report []*models.CategoryReport, period requests.Period, currencyExchange float64, currencyDesignation string,
-) (string, error) {
-	textMessageHeader := "Отчет по тратам за "
+// generateTagReport returns the report grouped by tags.
+func (s *Service) generateTagReport(ctx context.Context, repo *periodRepository, req requests.GetReport) (string, error) {
+	localizer := i18n.New(req.Language)
+
+	report, err := repo.tags(ctx, req.UserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the report by tags from repository: %w", err)
+	}
+
+	if len(report) == 0 {
+		return s.formatter.Text(localizer.Get(i18n.KeyWasteNotFound)), nil
+	}
+
+	periodKey, err := getPeriodKey(req.Period)
+	if err != nil {
+		return "", err
+	}
+
+	rate, err := s.getRate(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Tag < report[j].Tag
+	})
+
+	data := make([][]string, 0, len(report))
+	for _, item := range report {
+		converted, err := convertToCurrency(item.Sum, rate, req.BaseCurrency, req.Currency)
+		if err != nil {
+			return "", err
+		}
 
+		data = append(data, []string{
+			"#" + item.Tag,
+			converted.Decimal() + " " + req.CurrencyDesignation,
+		})
+	}
+
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{localizer.Get(i18n.KeyReportTag), localizer.Get(i18n.KeyReportSpent)})
+	table.AppendBulk(data)
+
+	table.Render()
+
+	return s.formatter.NewMessage().
+		Text(localizer.Getf(i18n.KeyReportTagsHeader, localizer.Get(periodKey))).Line().
+		Line().
+		Pre(tableString.String()).Line().
+		Italic(localizer.Get(i18n.KeyReportTagsNote)).
+		String(), nil
+}
+
+func getPeriodKey(period requests.Period) (i18n.Key, error) {
 	switch period {
 	case requests.PeriodWeek:
-		textMessageHeader += "последнюю неделю:\n\n```\n"
+		return i18n.KeyPeriodLastWeek, nil
 	case requests.PeriodMonth:
-		textMessageHeader += "последний месяц:\n\n```\n"
+		return i18n.KeyPeriodLastMonth, nil
 	case requests.PeriodYear:
-		textMessageHeader += "последний год:\n\n```\n"
+		return i18n.KeyPeriodLastYear, nil
 	default:
-		return "", fmt.Errorf("unexpected type of period: %d", period)
+		return "", fmt.Errorf("%w: %d", ErrUnexpectedPeriod, period)
 	}
+}
 
+func (s *Service) generateStringReport(
+	report []*models.CategoryReport,
+	currencyReport []*models.CurrencyReport,
+	rate money.Rate,
+	req requests.GetReport,
+) (string, error) {
+	localizer := i18n.New(req.Language)
+
+	periodKey, err := getPeriodKey(req.Period)
+	if err != nil {
+		return "", err
+	}
+
+	// the sums are converted separately from the total, so each of them is rounded once
 	data := make([][]string, 0)
-	sum := 0.0
+	var sum int64
 	for _, category := range report {
-		curr := float64(category.Sum) * currencyExchange / convertToMainCurrency
-		sum += curr
+		curr, err := convertToCurrency(category.Sum, rate, req.BaseCurrency, req.Currency)
+		if err != nil {
+			return "", err
+		}
+		sum += category.Sum
 
 		data = append(data, []string{
 			category.Category,
-			fmt.Sprintf("%.2f %s",
-				curr, currencyDesignation),
+			curr.Decimal() + " " + req.CurrencyDesignation,
 		})
 	}
 
+	total, err := convertToCurrency(sum, rate, req.BaseCurrency, req.Currency)
+	if err != nil {
+		return "", err
+	}
+
 	tableString := &strings.Builder{}
 	table := tablewriter.NewWriter(tableString)
 
-	table.SetHeader([]string{"КАТЕГОРИЯ", "ПОТРАЧЕНО"})
-	table.SetFooter([]string{"СУММА", fmt.Sprintf("%.2f %s", sum, currencyDesignation)})
+	table.SetHeader([]string{localizer.Get(i18n.KeyReportCategory), localizer.Get(i18n.KeyReportSpent)})
+	table.SetFooter([]string{localizer.Get(i18n.KeyReportTotal), total.Decimal() + " " + req.CurrencyDesignation})
 	table.AppendBulk(data)
 
 	table.Render()
 
-	return textMessageHeader + tableString.String() + "```", nil
+	header := localizer.Getf(i18n.KeyReportHeader, localizer.Get(periodKey))
+	if req.Tag != "" {
+		header = localizer.Getf(i18n.KeyReportTagHeader, req.Tag, localizer.Get(periodKey))
+	}
+
+	msg := s.formatter.NewMessage().
+		Text(header).Line().
+		Line().
+		Pre(tableString.String())
+
+	breakdown, err := generateCurrencyBreakdown(currencyReport, rate, req, localizer)
+	if err != nil {
+		return "", err
+	}
+	if breakdown != "" {
+		msg.Line().
+			Line().
+			Text(localizer.Get(i18n.KeyReportByCurrency)).Line().
+			Line().
+			Pre(breakdown)
+	}
+
+	return msg.String(), nil
+}
+
+// generateCurrencyBreakdown returns the table of sums in currencies entered by the user,
+// it is empty if all wastes are entered in the currency of the report.
+func generateCurrencyBreakdown(
+	currencyReport []*models.CurrencyReport,
+	rate money.Rate,
+	req requests.GetReport,
+	localizer *i18n.Localizer,
+) (string, error) {
+	if req.BaseCurrency == "" {
+		return "", nil
+	}
+
+	// the wastes without the original currency are entered in the default currency
+	merged := make(map[string]*models.CurrencyReport)
+	currencies := make([]string, 0, len(currencyReport))
+	for _, item := range currencyReport {
+		currency := item.Currency
+		if currency == "" {
+			currency = req.BaseCurrency
+		}
+
+		existing, ok := merged[currency]
+		if !ok {
+			existing = &models.CurrencyReport{Currency: currency}
+			merged[currency] = existing
+			currencies = append(currencies, currency)
+		}
+		existing.Sum += item.Sum
+		existing.OriginalSum += item.OriginalSum
+	}
+
+	if len(currencies) == 0 || len(currencies) == 1 && currencies[0] == req.Currency {
+		return "", nil
+	}
+	sort.Strings(currencies)
+
+	data := make([][]string, 0, len(currencies))
+	for _, currency := range currencies {
+		item := merged[currency]
+
+		converted, err := convertToCurrency(item.Sum, rate, req.BaseCurrency, req.Currency)
+		if err != nil {
+			return "", err
+		}
+
+		data = append(data, []string{
+			currency,
+			money.New(item.OriginalSum, currency).Decimal() + " " + designation(currency),
+			converted.Decimal() + " " + req.CurrencyDesignation,
+		})
+	}
+
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{
+		localizer.Get(i18n.KeyReportCurrency),
+		localizer.Get(i18n.KeyReportEntered),
+		localizer.Get(i18n.KeyReportConverted),
+	})
+	table.AppendBulk(data)
+
+	table.Render()
+
+	return tableString.String(), nil
+}
+
+// getRate returns the rate of the currency of the report to the default currency.
+// The rate stored by the bot is preferred, because the rate of the request is taken
+// when the request is sent and the requests of the previous version of the bot
+// have only the approximate rate.
+func (s *Service) getRate(ctx context.Context, req requests.GetReport) (money.Rate, error) {
+	if req.BaseCurrency != "" {
+		if req.Currency == req.BaseCurrency {
+			return money.IdentityRate, nil
+		}
+
+		stored, err := s.rateRepo.GetRates(ctx, req.BaseCurrency)
+		if err != nil {
+			return money.Rate{}, fmt.Errorf("failed to get stored rates: %w", err)
+		}
+
+		if rate, ok := stored.Rates[req.Currency]; ok {
+			return rate, nil
+		}
+	}
+
+	if req.CurrencyRate != "" {
+		return money.ParseRate(req.CurrencyRate)
+	}
+
+	return money.NewRate(req.CurrencyExchange)
+}
+
+// designation returns the symbol of the currency, the code if the currency is unknown.
+func designation(currency string) string {
+	if found, ok := money.LookupCurrency(currency); ok {
+		return found.Symbol
+	}
+
+	return currency
+}
+
+// convertToCurrency converts the amount stored in the default currency to the currency of the report,
+// the base currency is empty in the requests of the previous version of the bot.
+func convertToCurrency(amount int64, rate money.Rate, baseCurrency string, currency string) (money.Money, error) {
+	converted, err := money.New(amount, baseCurrency).Exchange(rate, currency, money.RoundHalfEven)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("failed to convert the amount to %s: %w", currency, err)
+	}
+
+	return converted, nil
 }